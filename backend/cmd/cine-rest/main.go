@@ -4,6 +4,7 @@ import (
 	"cine/config"
 	"cine/datastore/ent"
 	"cine/pkg/logger"
+	"cine/pkg/pubsub"
 	"cine/pkg/tmdb"
 	"cine/server"
 	"cine/server/controller"
@@ -21,6 +22,8 @@ func main() {
 
 			ent.NewStore,
 			tmdb.NewTheMovieDatabaseAPI,
+			tmdb.NewCachedAPI,
+			pubsub.NewHub,
 
 			service.NewAuthService,
 			service.NewUserService,
@@ -28,6 +31,13 @@ func main() {
 			service.NewListService,
 			service.NewCommentService,
 			service.NewReviewService,
+			service.NewSearchService,
+			service.NewProgressService,
+			service.NewDiaryService,
+			service.NewWatchlistService,
+			service.NewActivityService,
+			service.NewNotificationService,
+			service.NewStreamService,
 
 			middleware.NewMiddleware,
 
@@ -37,10 +47,22 @@ func main() {
 			controller.NewCommentController,
 			controller.NewReviewController,
 			controller.NewMediaController,
+			controller.NewPersonController,
+			controller.NewSearchController,
+			controller.NewProgressController,
+			controller.NewDiaryController,
+			controller.NewWatchlistController,
+			controller.NewFeedController,
+			controller.NewNotificationController,
+			controller.NewStreamController,
 			controller.NewControllers,
 		),
+		fx.Decorate(
+			tmdb.Cached,
+		),
 		fx.Invoke(
 			server.InvokeServer,
+			service.InvokeTombstoneRetention,
 		),
 	).Run()
 }
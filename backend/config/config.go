@@ -7,14 +7,19 @@ import (
 	"github.com/joho/godotenv"
 	"go.uber.org/fx"
 	"os"
+	"strconv"
 )
 
 type Config struct {
-	Port          string `z:"port"`
-	Datasource    string `z:"datasource"`
-	Environment   string `z:"environment"`
-	TMDBApiKey    string `z:"tmdb_api"`
-	TMDBReadToken string `z:"tmdb_read_token"`
+	Port           string `z:"port"`
+	Datasource     string `z:"datasource"`
+	Environment    string `z:"environment"`
+	TMDBApiKey     string `z:"tmdb_api"`
+	TMDBReadToken  string `z:"tmdb_read_token"`
+	TMDBURL        string `z:"tmdb_url"`
+	TMDBRateLimit  int    `z:"tmdb_rate_limit"`
+	TMDBMaxRetries int    `z:"tmdb_max_retries"`
+	PubSub         string `z:"pubsub"`
 }
 
 func NewConfig(shutdowner fx.Shutdowner, logger logger.Logger) *Config {
@@ -27,11 +32,15 @@ func NewConfig(shutdowner fx.Shutdowner, logger logger.Logger) *Config {
 	}
 
 	cfg := &Config{
-		Port:          os.Getenv("PORT"),
-		Datasource:    os.Getenv("DATASOURCE"),
-		Environment:   os.Getenv("ENVIRONMENT"),
-		TMDBApiKey:    os.Getenv("THE_MOVIE_DATABASE_API_KEY"),
-		TMDBReadToken: os.Getenv("THE_MOVIE_DATABASE_READ_TOKEN"),
+		Port:           os.Getenv("PORT"),
+		Datasource:     os.Getenv("DATASOURCE"),
+		Environment:    os.Getenv("ENVIRONMENT"),
+		TMDBApiKey:     os.Getenv("THE_MOVIE_DATABASE_API_KEY"),
+		TMDBReadToken:  os.Getenv("THE_MOVIE_DATABASE_READ_TOKEN"),
+		TMDBURL:        stringEnv("THE_MOVIE_DATABASE_URL", "https://api.themoviedb.org/3"),
+		TMDBRateLimit:  intEnv("THE_MOVIE_DATABASE_RATE_LIMIT", 40),
+		TMDBMaxRetries: intEnv("THE_MOVIE_DATABASE_MAX_RETRIES", 3),
+		PubSub:         stringEnv("PUBSUB", "memory"),
 	}
 
 	if errs := cfg.validate(); errs != nil {
@@ -60,6 +69,36 @@ func (c *Config) validate() z.Errors {
 			NotEmpty("tmdb_api must be set"),
 		"tmdb_read_token": z.String().
 			NotEmpty("tmdb_read_token must be set"),
+		"tmdb_url": z.String().
+			Regex(`^https?://`, "tmdb_url must be an http(s) url"),
+		"tmdb_rate_limit": z.Int().
+			Gt(0, "tmdb_rate_limit must be greater than 0"),
+		"tmdb_max_retries": z.Int().
+			Gte(0, "tmdb_max_retries must not be negative"),
+		"pubsub": z.String().
+			In([]string{"memory", "postgres"}, "pubsub must be either memory or postgres"),
 	}
 	return schema.Validate(c)
 }
+
+// stringEnv returns the environment variable for key, or fallback if it isn't set.
+func stringEnv(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// intEnv returns the environment variable for key as an int, or fallback if it isn't set.
+// A value that isn't an int is returned as -1 so validation reports it.
+func intEnv(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return -1
+	}
+	return n
+}
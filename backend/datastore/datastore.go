@@ -13,6 +13,14 @@ type Store interface {
 	Reviews() repository.ReviewRepository
 	Medias() repository.MediaRepository
 	Lists() repository.ListRepository
+	EpisodeProgresses() repository.EpisodeProgressRepository
+	DiaryEntries() repository.DiaryEntryRepository
+	WatchItems() repository.WatchItemRepository
+	Activities() repository.ActivityRepository
+	Notifications() repository.NotificationRepository
+	FollowRequests() repository.FollowRequestRepository
+	ReviewLikes() repository.ReviewLikeRepository
+	Revisions() repository.RevisionRepository
 
 	Transaction(ctx context.Context) (Transaction, error)
 }
@@ -25,6 +33,14 @@ type Transaction interface {
 	Reviews() repository.ReviewRepository
 	Medias() repository.MediaRepository
 	Lists() repository.ListRepository
+	EpisodeProgresses() repository.EpisodeProgressRepository
+	DiaryEntries() repository.DiaryEntryRepository
+	WatchItems() repository.WatchItemRepository
+	Activities() repository.ActivityRepository
+	Notifications() repository.NotificationRepository
+	FollowRequests() repository.FollowRequestRepository
+	ReviewLikes() repository.ReviewLikeRepository
+	Revisions() repository.RevisionRepository
 
 	Commit() error
 	Rollback() error
@@ -0,0 +1,225 @@
+package ent
+
+import (
+	"cine/datastore/ent/ent"
+	Activity "cine/datastore/ent/ent/activity"
+	List "cine/datastore/ent/ent/list"
+	"cine/datastore/ent/ent/predicate"
+	User "cine/datastore/ent/ent/user"
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type activityRepository struct {
+	client *ent.Client
+}
+
+func newActivityRepository(client *ent.Client) repository.ActivityRepository {
+	return &activityRepository{client: client}
+}
+
+func (ar *activityRepository) One(ctx context.Context, activityFs ...*model.ActivityF) (*model.Activity, error) {
+	q := ar.client.Activity.Query()
+	q = q.Where(ar.filters(activityFs)...)
+
+	activity, err := q.First(ctx)
+	return c.activity(activity), c.error(err)
+}
+
+func (ar *activityRepository) All(ctx context.Context, activityFs ...*model.ActivityF) ([]*model.Activity, error) {
+	q := ar.client.Activity.Query()
+	q = q.Where(ar.filters(activityFs)...).
+		Order(ent.Desc(Activity.FieldCreatedAt), ent.Desc(Activity.FieldID))
+
+	activities, err := q.All(ctx)
+	return c.activities(activities), c.error(err)
+}
+
+func (ar *activityRepository) Exists(ctx context.Context, activityFs ...*model.ActivityF) (bool, error) {
+	q := ar.client.Activity.Query()
+	q = q.Where(ar.filters(activityFs)...)
+
+	exists, err := q.Exist(ctx)
+	return exists, c.error(err)
+}
+
+func (ar *activityRepository) Count(ctx context.Context, activityFs ...*model.ActivityF) (int, error) {
+	q := ar.client.Activity.Query()
+	q = q.Where(ar.filters(activityFs)...)
+
+	count, err := q.Count(ctx)
+	return count, c.error(err)
+}
+
+func (ar *activityRepository) Insert(ctx context.Context, activity *model.Activity) (*model.Activity, error) {
+	i := ar.create(activity)
+
+	iActivity, err := i.Save(ctx)
+	return c.activity(iActivity), c.error(err)
+}
+
+func (ar *activityRepository) InsertBulk(ctx context.Context, activities []*model.Activity) ([]*model.Activity, error) {
+	i := ar.createBulk(activities)
+
+	iActivities, err := i.Save(ctx)
+	return c.activities(iActivities), c.error(err)
+}
+
+// Update is a no-op save, activities are immutable once recorded.
+func (ar *activityRepository) Update(ctx context.Context, id uuid.UUID, _ *model.ActivityU) (*model.Activity, error) {
+	q := ar.client.Activity.UpdateOneID(id)
+
+	activity, err := q.Save(ctx)
+	return c.activity(activity), c.error(err)
+}
+
+func (ar *activityRepository) UpdateExec(ctx context.Context, _ *model.ActivityU, activityFs ...*model.ActivityF) (int, error) {
+	q := ar.client.Activity.Update()
+	q = q.Where(ar.filters(activityFs)...)
+
+	affected, err := q.Save(ctx)
+	return affected, c.error(err)
+}
+
+func (ar *activityRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	q := ar.client.Activity.DeleteOneID(id)
+
+	err := q.Exec(ctx)
+	return c.error(err)
+}
+
+func (ar *activityRepository) DeleteExec(ctx context.Context, activityFs ...*model.ActivityF) (int, error) {
+	q := ar.client.Activity.Delete()
+	q = q.Where(ar.filters(activityFs)...)
+
+	affected, err := q.Exec(ctx)
+	return affected, c.error(err)
+}
+
+func (ar *activityRepository) AllDetailed(
+	ctx context.Context,
+	viewerID uuid.UUID,
+	after *model.Cursor,
+	limit int,
+	activityFs ...*model.ActivityF,
+) ([]*model.DetailedActivity, error) {
+	q := ar.client.Activity.Query()
+	q = q.Where(ar.filters(activityFs)...).
+		Where(
+			Activity.Or(
+				Activity.ListIDIsNil(),
+				Activity.HasListWith(List.Or(List.Public(true), List.HasMembersWith(User.ID(viewerID)))),
+			),
+			Activity.Not(Activity.HasUserWith(hiddenFrom(viewerID))),
+			Activity.HasUserWith(visibleTo(viewerID)),
+		)
+	if after != nil {
+		q = q.Where(
+			Activity.Or(
+				Activity.CreatedAtLT(after.CreatedAt),
+				Activity.And(Activity.CreatedAt(after.CreatedAt), Activity.IDLT(after.ID)),
+			),
+		)
+	}
+
+	selectUser := func(q *ent.UserQuery) {
+		q.Select(
+			User.FieldID,
+			User.FieldDisplayName,
+			User.FieldUsername,
+			User.FieldProfilePicture,
+		)
+	}
+	q = q.WithUser(selectUser).
+		WithFollowed(selectUser).
+		WithMedia().
+		WithList().
+		WithReview().
+		WithComment().
+		Order(ent.Desc(Activity.FieldCreatedAt), ent.Desc(Activity.FieldID)).
+		Limit(limit)
+
+	activities, err := q.All(ctx)
+	return ar.detailedActivities(activities), c.error(err)
+}
+
+func (ar *activityRepository) filters(activityFs []*model.ActivityF) []predicate.Activity {
+	var activityF *model.ActivityF
+	if len(activityFs) > 0 {
+		activityF = activityFs[0]
+	}
+	var filters []predicate.Activity
+	if activityF != nil {
+		if activityF.ID != nil {
+			filters = append(filters, Activity.ID(*activityF.ID))
+		}
+		if activityF.UserID != nil {
+			filters = append(filters, Activity.UserID(*activityF.UserID))
+		}
+		if activityF.Kind != nil {
+			filters = append(filters, Activity.KindEQ(Activity.Kind(*activityF.Kind)))
+		}
+		if activityF.MediaID != nil {
+			filters = append(filters, Activity.MediaID(*activityF.MediaID))
+		}
+		if activityF.ListID != nil {
+			filters = append(filters, Activity.ListID(*activityF.ListID))
+		}
+		if activityF.ReviewID != nil {
+			filters = append(filters, Activity.ReviewID(*activityF.ReviewID))
+		}
+		if activityF.CommentID != nil {
+			filters = append(filters, Activity.CommentID(*activityF.CommentID))
+		}
+		if activityF.FollowedID != nil {
+			filters = append(filters, Activity.FollowedID(*activityF.FollowedID))
+		}
+		if activityF.CreatedAt != nil {
+			filters = append(filters, Activity.CreatedAt(*activityF.CreatedAt))
+		}
+		if activityF.FollowedBy != nil {
+			filters = append(filters, Activity.HasUserWith(User.HasFollowersWith(User.ID(*activityF.FollowedBy))))
+		}
+	}
+	return filters
+}
+
+func (ar *activityRepository) create(activity *model.Activity) *ent.ActivityCreate {
+	return ar.client.Activity.Create().
+		SetID(uuid.New()).
+		SetUserID(activity.UserID).
+		SetKind(Activity.Kind(activity.Kind)).
+		SetNillableMediaID(activity.MediaID).
+		SetNillableListID(activity.ListID).
+		SetNillableReviewID(activity.ReviewID).
+		SetNillableCommentID(activity.CommentID).
+		SetNillableFollowedID(activity.FollowedID).
+		SetCreatedAt(time.Now())
+}
+
+func (ar *activityRepository) createBulk(activities []*model.Activity) *ent.ActivityCreateBulk {
+	builders := make([]*ent.ActivityCreate, 0, len(activities))
+	for _, activity := range activities {
+		builders = append(builders, ar.create(activity))
+	}
+	return ar.client.Activity.CreateBulk(builders...)
+}
+
+func (ar *activityRepository) detailedActivities(activities []*ent.Activity) []*model.DetailedActivity {
+	detailedActivities := make([]*model.DetailedActivity, 0, len(activities))
+	for _, activity := range activities {
+		detailedActivities = append(detailedActivities, &model.DetailedActivity{
+			Activity: c.activity(activity),
+			User:     c.user(activity.Edges.User),
+			Media:    c.media(activity.Edges.Media),
+			List:     c.list(activity.Edges.List),
+			Review:   c.review(activity.Edges.Review),
+			Comment:  c.comment(activity.Edges.Comment),
+			Followed: c.user(activity.Edges.Followed),
+		})
+	}
+	return detailedActivities
+}
@@ -5,6 +5,8 @@ import (
 	Comment "cine/datastore/ent/ent/comment"
 	Like "cine/datastore/ent/ent/like"
 	"cine/datastore/ent/ent/predicate"
+	Review "cine/datastore/ent/ent/review"
+	Revision "cine/datastore/ent/ent/revision"
 	"cine/entity/model"
 	"cine/repository"
 	"context"
@@ -71,6 +73,8 @@ func (cr *commentRepository) Update(ctx context.Context, id uuid.UUID, commentU
 
 	q.SetUpdatedAt(time.Now())
 	q.SetNillableContent(commentU.Content)
+	q.SetNillableSpoiler(commentU.Spoiler)
+	q.SetNillableDeletedAt(commentU.DeletedAt)
 
 	comment, err := q.Save(ctx)
 	return c.comment(comment), c.error(err)
@@ -82,6 +86,8 @@ func (cr *commentRepository) UpdateExec(ctx context.Context, commentU *model.Com
 
 	q.SetUpdatedAt(time.Now())
 	q.SetNillableContent(commentU.Content)
+	q.SetNillableSpoiler(commentU.Spoiler)
+	q.SetNillableDeletedAt(commentU.DeletedAt)
 
 	affected, err := q.Save(ctx)
 	return affected, c.error(err)
@@ -104,13 +110,48 @@ func (cr *commentRepository) DeleteExec(ctx context.Context, commentFs ...*model
 
 func (cr *commentRepository) AllAsDetailed(ctx context.Context, mediaID, userID uuid.UUID) ([]*model.DetailedComment, error) {
 	q := cr.client.Comment.Query()
-	q = q.Where(Comment.MediaID(mediaID), Comment.Not(Comment.HasReplyingTo())).
+	q = q.Where(
+		Comment.MediaID(mediaID),
+		Comment.ReviewIDIsNil(),
+		Comment.Not(Comment.HasReplyingTo()),
+		cr.shownTo(userID),
+	).
 		WithLikes(func(q *ent.LikeQuery) {
 			q.Select(Like.FieldUserID)
 		}).
 		WithReplies(func(q *ent.CommentQuery) {
 			q.Select(Comment.FieldID)
 		}).
+		WithRevisions(func(q *ent.RevisionQuery) {
+			q.Select(Revision.FieldID)
+		}).
+		WithUser()
+
+	comments, err := q.All(ctx)
+	if err != nil {
+		return nil, c.error(err)
+	}
+
+	return cr.detailedComments(comments, userID), nil
+}
+
+// AllOnReviewAsDetailed gets the top level comments of a review's thread.
+func (cr *commentRepository) AllOnReviewAsDetailed(ctx context.Context, reviewID, userID uuid.UUID) ([]*model.DetailedComment, error) {
+	q := cr.client.Comment.Query()
+	q = q.Where(
+		Comment.ReviewID(reviewID),
+		Comment.Not(Comment.HasReplyingTo()),
+		cr.shownTo(userID),
+	).
+		WithLikes(func(q *ent.LikeQuery) {
+			q.Select(Like.FieldUserID)
+		}).
+		WithReplies(func(q *ent.CommentQuery) {
+			q.Select(Comment.FieldID)
+		}).
+		WithRevisions(func(q *ent.RevisionQuery) {
+			q.Select(Revision.FieldID)
+		}).
 		WithUser()
 
 	comments, err := q.All(ctx)
@@ -125,12 +166,16 @@ func (cr *commentRepository) AllRepliesAsDetailed(ctx context.Context, comment *
 	q := cr.client.Comment.Query()
 	q = q.Where(Comment.ID(comment.ID)).
 		QueryReplies().
+		Where(cr.shownTo(userID)).
 		WithLikes(func(q *ent.LikeQuery) {
 			q.Select(Like.FieldUserID)
 		}).
 		WithReplies(func(q *ent.CommentQuery) {
 			q.Select(Comment.FieldID)
 		}).
+		WithRevisions(func(q *ent.RevisionQuery) {
+			q.Select(Revision.FieldID)
+		}).
 		WithUser()
 
 	replies, err := q.All(ctx)
@@ -141,6 +186,27 @@ func (cr *commentRepository) AllRepliesAsDetailed(ctx context.Context, comment *
 	return cr.detailedComments(replies, userID), nil
 }
 
+// DeleteTombstones hard deletes the tombstones of deleted comments that have no replies left.
+func (cr *commentRepository) DeleteTombstones(ctx context.Context) (int, error) {
+	q := cr.client.Comment.Delete()
+	q = q.Where(
+		Comment.DeletedAtNotNil(),
+		Comment.Not(Comment.HasReplies()),
+	)
+
+	affected, err := q.Exec(ctx)
+	return affected, c.error(err)
+}
+
+// shownTo leaves out comments by users the viewer muted, or who are on either side of a block with them.
+// Tombstones are kept whoever wrote them, they are anonymous and hold the replies others wrote under them.
+func (cr *commentRepository) shownTo(viewerID uuid.UUID) predicate.Comment {
+	return Comment.Or(
+		Comment.DeletedAtNotNil(),
+		Comment.Not(Comment.HasUserWith(hiddenFrom(viewerID))),
+	)
+}
+
 func (cr *commentRepository) filters(commentFs []*model.CommentF) []predicate.Comment {
 	var commentF *model.CommentF
 	if len(commentFs) > 0 {
@@ -160,15 +226,31 @@ func (cr *commentRepository) filters(commentFs []*model.CommentF) []predicate.Co
 		if commentF.ReplyingToID != nil {
 			filters = append(filters, Comment.ReplyingToID(*commentF.ReplyingToID))
 		}
+		if commentF.ReviewID != nil {
+			filters = append(filters, Comment.ReviewID(*commentF.ReviewID))
+		}
 		if commentF.Content != nil {
 			filters = append(filters, Comment.Content(*commentF.Content))
 		}
+		if commentF.Spoiler != nil {
+			filters = append(filters, Comment.Spoiler(*commentF.Spoiler))
+		}
 		if commentF.CreatedAt != nil {
 			filters = append(filters, Comment.CreatedAt(*commentF.CreatedAt))
 		}
 		if commentF.UpdatedAt != nil {
 			filters = append(filters, Comment.UpdatedAt(*commentF.UpdatedAt))
 		}
+		if commentF.VisibleTo != nil {
+			filters = append(filters, cr.shownTo(*commentF.VisibleTo))
+			filters = append(filters, Comment.Or(
+				Comment.ReviewIDIsNil(),
+				Comment.HasReviewWith(
+					Review.Not(Review.HasUserWith(hiddenFrom(*commentF.VisibleTo))),
+					Review.HasUserWith(visibleTo(*commentF.VisibleTo)),
+				),
+			))
+		}
 	}
 	return filters
 }
@@ -178,8 +260,10 @@ func (cr *commentRepository) create(comment *model.Comment) *ent.CommentCreate {
 		SetID(uuid.New()).
 		SetUserID(comment.UserID).
 		SetNillableReplyingToID(comment.ReplyingToID).
+		SetNillableReviewID(comment.ReviewID).
 		SetMediaID(comment.MediaID).
 		SetContent(comment.Content).
+		SetSpoiler(comment.Spoiler).
 		SetCreatedAt(time.Now())
 }
 
@@ -194,13 +278,20 @@ func (cr *commentRepository) createBulk(comments []*model.Comment) *ent.CommentC
 func (cr *commentRepository) detailedComments(comments []*ent.Comment, userID uuid.UUID) []*model.DetailedComment {
 	detailedComments := make([]*model.DetailedComment, 0, len(comments))
 	for _, comment := range comments {
-		detailedComments = append(detailedComments, &model.DetailedComment{
+		detailed := &model.DetailedComment{
 			Comment:      c.comment(comment),
 			User:         c.user(comment.Edges.User),
 			RepliesCount: len(comment.Edges.Replies),
 			LikesCount:   len(comment.Edges.Likes),
 			LikedByUser:  cr.likedByUser(comment, userID),
-		})
+			Edited:       len(comment.Edges.Revisions) > 0,
+		}
+		// tombstones don't say who wrote them
+		if comment.DeletedAt != nil {
+			detailed.Comment.UserID = uuid.Nil
+			detailed.User = nil
+		}
+		detailedComments = append(detailedComments, detailed)
 	}
 	return detailedComments
 }
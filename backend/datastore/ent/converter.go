@@ -14,14 +14,20 @@ type converter struct{}
 func (c converter) user(user *ent.User) *model.User {
 	if user != nil {
 		return &model.User{
-			ID:             user.ID,
-			DisplayName:    user.DisplayName,
-			Username:       user.Username,
-			Email:          user.Email,
-			Password:       user.Password,
-			ProfilePicture: user.ProfilePicture,
-			CreatedAt:      user.CreatedAt,
-			UpdatedAt:      user.UpdatedAt,
+			ID:                 user.ID,
+			DisplayName:        user.DisplayName,
+			Username:           user.Username,
+			Email:              user.Email,
+			Password:           user.Password,
+			ProfilePicture:     user.ProfilePicture,
+			Region:             user.Region,
+			Services:           user.StreamingServices,
+			MutedNotifications: c.notificationKinds(user.MutedNotifications),
+			Private:            user.Private,
+			HideSpoilers:       user.HideSpoilers,
+			Moderator:          user.Moderator,
+			CreatedAt:          user.CreatedAt,
+			UpdatedAt:          user.UpdatedAt,
 		}
 	}
 	return nil
@@ -65,9 +71,12 @@ func (c converter) comment(comment *ent.Comment) *model.Comment {
 			UserID:       comment.UserID,
 			MediaID:      comment.MediaID,
 			ReplyingToID: comment.ReplyingToID,
+			ReviewID:     comment.ReviewID,
 			Content:      comment.Content,
+			Spoiler:      comment.Spoiler,
 			CreatedAt:    comment.CreatedAt,
 			UpdatedAt:    comment.UpdatedAt,
+			DeletedAt:    comment.DeletedAt,
 		}
 	}
 	return nil
@@ -110,8 +119,10 @@ func (c converter) review(review *ent.Review) *model.Review {
 			MediaID:   review.MediaID,
 			Content:   review.Content,
 			Rating:    review.Rating,
+			Spoiler:   review.Spoiler,
 			CreatedAt: review.CreatedAt,
 			UpdatedAt: review.UpdatedAt,
+			DeletedAt: review.DeletedAt,
 		}
 	}
 	return nil
@@ -198,3 +209,205 @@ func (c converter) error(err error) error {
 	}
 	return nil
 }
+
+func (c converter) episodeProgress(progress *ent.EpisodeProgress) *model.EpisodeProgress {
+	if progress != nil {
+		return &model.EpisodeProgress{
+			ID:            progress.ID,
+			UserID:        progress.UserID,
+			MediaID:       progress.MediaID,
+			SeasonNumber:  progress.SeasonNumber,
+			EpisodeNumber: progress.EpisodeNumber,
+			CreatedAt:     progress.CreatedAt,
+			UpdatedAt:     progress.UpdatedAt,
+		}
+	}
+	return nil
+}
+
+func (c converter) diaryEntry(entry *ent.DiaryEntry) *model.DiaryEntry {
+	if entry != nil {
+		return &model.DiaryEntry{
+			ID:        entry.ID,
+			UserID:    entry.UserID,
+			MediaID:   entry.MediaID,
+			ReviewID:  entry.ReviewID,
+			WatchedOn: entry.WatchedOn,
+			Rewatch:   entry.Rewatch,
+			Rating:    entry.Rating,
+			CreatedAt: entry.CreatedAt,
+			UpdatedAt: entry.UpdatedAt,
+		}
+	}
+	return nil
+}
+
+func (c converter) diaryEntries(entries []*ent.DiaryEntry) []*model.DiaryEntry {
+	result := make([]*model.DiaryEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, c.diaryEntry(entry))
+	}
+	return result
+}
+
+func (c converter) watchItem(item *ent.WatchItem) *model.WatchItem {
+	if item != nil {
+		return &model.WatchItem{
+			ID:        item.ID,
+			UserID:    item.UserID,
+			MediaID:   item.MediaID,
+			Status:    model.WatchStatus(item.Status),
+			Priority:  item.Priority,
+			AddedAt:   item.AddedAt,
+			UpdatedAt: item.UpdatedAt,
+		}
+	}
+	return nil
+}
+
+func (c converter) watchItems(items []*ent.WatchItem) []*model.WatchItem {
+	result := make([]*model.WatchItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, c.watchItem(item))
+	}
+	return result
+}
+
+func (c converter) activity(activity *ent.Activity) *model.Activity {
+	if activity != nil {
+		return &model.Activity{
+			ID:         activity.ID,
+			UserID:     activity.UserID,
+			Kind:       model.ActivityKind(activity.Kind),
+			MediaID:    activity.MediaID,
+			ListID:     activity.ListID,
+			ReviewID:   activity.ReviewID,
+			CommentID:  activity.CommentID,
+			FollowedID: activity.FollowedID,
+			CreatedAt:  activity.CreatedAt,
+		}
+	}
+	return nil
+}
+
+func (c converter) activities(activities []*ent.Activity) []*model.Activity {
+	result := make([]*model.Activity, 0, len(activities))
+	for _, activity := range activities {
+		result = append(result, c.activity(activity))
+	}
+	return result
+}
+
+func (c converter) notification(notification *ent.Notification) *model.Notification {
+	if notification != nil {
+		return &model.Notification{
+			ID:        notification.ID,
+			UserID:    notification.UserID,
+			ActorID:   notification.ActorID,
+			Kind:      model.NotificationKind(notification.Kind),
+			CommentID: notification.CommentID,
+			ListID:    notification.ListID,
+			ReviewID:  notification.ReviewID,
+			Read:      notification.Read,
+			CreatedAt: notification.CreatedAt,
+		}
+	}
+	return nil
+}
+
+func (c converter) notifications(notifications []*ent.Notification) []*model.Notification {
+	result := make([]*model.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		result = append(result, c.notification(notification))
+	}
+	return result
+}
+
+func (c converter) followRequest(request *ent.FollowRequest) *model.FollowRequest {
+	if request != nil {
+		return &model.FollowRequest{
+			ID:          request.ID,
+			RequesterID: request.RequesterID,
+			UserID:      request.UserID,
+			CreatedAt:   request.CreatedAt,
+		}
+	}
+	return nil
+}
+
+func (c converter) followRequests(requests []*ent.FollowRequest) []*model.FollowRequest {
+	result := make([]*model.FollowRequest, 0, len(requests))
+	for _, request := range requests {
+		result = append(result, c.followRequest(request))
+	}
+	return result
+}
+
+func (c converter) notificationKinds(kinds []string) []model.NotificationKind {
+	result := make([]model.NotificationKind, 0, len(kinds))
+	for _, kind := range kinds {
+		result = append(result, model.NotificationKind(kind))
+	}
+	return result
+}
+
+func (c converter) notificationKindStrings(kinds []model.NotificationKind) []string {
+	result := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		result = append(result, string(kind))
+	}
+	return result
+}
+
+func (c converter) episodeProgresses(progresses []*ent.EpisodeProgress) []*model.EpisodeProgress {
+	result := make([]*model.EpisodeProgress, 0, len(progresses))
+	for _, progress := range progresses {
+		result = append(result, c.episodeProgress(progress))
+	}
+	return result
+}
+
+func (c converter) reviewLike(like *ent.ReviewLike) *model.ReviewLike {
+	if like != nil {
+		return &model.ReviewLike{
+			ID:        like.ID,
+			UserID:    like.UserID,
+			ReviewID:  like.ReviewID,
+			CreatedAt: like.CreatedAt,
+			UpdatedAt: like.UpdatedAt,
+		}
+	}
+	return nil
+}
+
+func (c converter) reviewLikes(likes []*ent.ReviewLike) []*model.ReviewLike {
+	result := make([]*model.ReviewLike, 0, len(likes))
+	for _, like := range likes {
+		result = append(result, c.reviewLike(like))
+	}
+	return result
+}
+
+func (c converter) revision(revision *ent.Revision) *model.Revision {
+	if revision != nil {
+		return &model.Revision{
+			ID:        revision.ID,
+			CommentID: revision.CommentID,
+			ReviewID:  revision.ReviewID,
+			Content:   revision.Content,
+			Rating:    revision.Rating,
+			Spoiler:   revision.Spoiler,
+			CreatedAt: revision.CreatedAt,
+			UpdatedAt: revision.UpdatedAt,
+		}
+	}
+	return nil
+}
+
+func (c converter) revisions(revisions []*ent.Revision) []*model.Revision {
+	result := make([]*model.Revision, 0, len(revisions))
+	for _, revision := range revisions {
+		result = append(result, c.revision(revision))
+	}
+	return result
+}
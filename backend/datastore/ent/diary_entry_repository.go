@@ -0,0 +1,206 @@
+package ent
+
+import (
+	"cine/datastore/ent/ent"
+	DiaryEntry "cine/datastore/ent/ent/diaryentry"
+	"cine/datastore/ent/ent/predicate"
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type diaryEntryRepository struct {
+	client *ent.Client
+}
+
+func newDiaryEntryRepository(client *ent.Client) repository.DiaryEntryRepository {
+	return &diaryEntryRepository{client: client}
+}
+
+func (der *diaryEntryRepository) One(ctx context.Context, entryFs ...*model.DiaryEntryF) (*model.DiaryEntry, error) {
+	q := der.client.DiaryEntry.Query()
+	q = q.Where(der.filters(entryFs)...)
+
+	entry, err := q.First(ctx)
+	return c.diaryEntry(entry), c.error(err)
+}
+
+func (der *diaryEntryRepository) All(ctx context.Context, entryFs ...*model.DiaryEntryF) ([]*model.DiaryEntry, error) {
+	q := der.client.DiaryEntry.Query()
+	q = q.Where(der.filters(entryFs)...).
+		Order(ent.Desc(DiaryEntry.FieldWatchedOn), ent.Desc(DiaryEntry.FieldCreatedAt))
+
+	entries, err := q.All(ctx)
+	return c.diaryEntries(entries), c.error(err)
+}
+
+func (der *diaryEntryRepository) Exists(ctx context.Context, entryFs ...*model.DiaryEntryF) (bool, error) {
+	q := der.client.DiaryEntry.Query()
+	q = q.Where(der.filters(entryFs)...)
+
+	exists, err := q.Exist(ctx)
+	return exists, c.error(err)
+}
+
+func (der *diaryEntryRepository) Count(ctx context.Context, entryFs ...*model.DiaryEntryF) (int, error) {
+	q := der.client.DiaryEntry.Query()
+	q = q.Where(der.filters(entryFs)...)
+
+	count, err := q.Count(ctx)
+	return count, c.error(err)
+}
+
+func (der *diaryEntryRepository) Insert(ctx context.Context, entry *model.DiaryEntry) (*model.DiaryEntry, error) {
+	i := der.create(entry)
+
+	iEntry, err := i.Save(ctx)
+	return c.diaryEntry(iEntry), c.error(err)
+}
+
+func (der *diaryEntryRepository) InsertBulk(ctx context.Context, entries []*model.DiaryEntry) ([]*model.DiaryEntry, error) {
+	i := der.createBulk(entries)
+
+	iEntries, err := i.Save(ctx)
+	return c.diaryEntries(iEntries), c.error(err)
+}
+
+func (der *diaryEntryRepository) Update(ctx context.Context, id uuid.UUID, entryU *model.DiaryEntryU) (*model.DiaryEntry, error) {
+	q := der.client.DiaryEntry.UpdateOneID(id)
+
+	q.SetUpdatedAt(time.Now())
+	q.SetNillableWatchedOn(entryU.WatchedOn)
+	q.SetNillableRewatch(entryU.Rewatch)
+	q.SetNillableRating(entryU.Rating)
+	if entryU.ClearRating {
+		q.ClearRating()
+	}
+	q.SetNillableReviewID(entryU.ReviewID)
+	if entryU.ClearReview {
+		q.ClearReview()
+	}
+
+	entry, err := q.Save(ctx)
+	return c.diaryEntry(entry), c.error(err)
+}
+
+func (der *diaryEntryRepository) UpdateExec(ctx context.Context, entryU *model.DiaryEntryU, entryFs ...*model.DiaryEntryF) (int, error) {
+	q := der.client.DiaryEntry.Update()
+	q = q.Where(der.filters(entryFs)...)
+
+	q.SetUpdatedAt(time.Now())
+	q.SetNillableWatchedOn(entryU.WatchedOn)
+	q.SetNillableRewatch(entryU.Rewatch)
+	q.SetNillableRating(entryU.Rating)
+	if entryU.ClearRating {
+		q.ClearRating()
+	}
+	q.SetNillableReviewID(entryU.ReviewID)
+	if entryU.ClearReview {
+		q.ClearReview()
+	}
+
+	affected, err := q.Save(ctx)
+	return affected, c.error(err)
+}
+
+func (der *diaryEntryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	q := der.client.DiaryEntry.DeleteOneID(id)
+
+	err := q.Exec(ctx)
+	return c.error(err)
+}
+
+func (der *diaryEntryRepository) DeleteExec(ctx context.Context, entryFs ...*model.DiaryEntryF) (int, error) {
+	q := der.client.DiaryEntry.Delete()
+	q = q.Where(der.filters(entryFs)...)
+
+	affected, err := q.Exec(ctx)
+	return affected, c.error(err)
+}
+
+func (der *diaryEntryRepository) AllWithMedia(ctx context.Context, entryFs ...*model.DiaryEntryF) ([]*model.DetailedDiaryEntry, error) {
+	q := der.client.DiaryEntry.Query()
+	q = q.Where(der.filters(entryFs)...).
+		WithMedia().
+		Order(ent.Desc(DiaryEntry.FieldWatchedOn), ent.Desc(DiaryEntry.FieldCreatedAt))
+
+	entries, err := q.All(ctx)
+	return der.detailedEntries(entries), c.error(err)
+}
+
+func (der *diaryEntryRepository) filters(entryFs []*model.DiaryEntryF) []predicate.DiaryEntry {
+	var entryF *model.DiaryEntryF
+	if len(entryFs) > 0 {
+		entryF = entryFs[0]
+	}
+	var filters []predicate.DiaryEntry
+	if entryF != nil {
+		if entryF.ID != nil {
+			filters = append(filters, DiaryEntry.ID(*entryF.ID))
+		}
+		if entryF.UserID != nil {
+			filters = append(filters, DiaryEntry.UserID(*entryF.UserID))
+		}
+		if entryF.MediaID != nil {
+			filters = append(filters, DiaryEntry.MediaID(*entryF.MediaID))
+		}
+		if entryF.ReviewID != nil {
+			filters = append(filters, DiaryEntry.ReviewID(*entryF.ReviewID))
+		}
+		if entryF.WatchedOn != nil {
+			filters = append(filters, DiaryEntry.WatchedOn(*entryF.WatchedOn))
+		}
+		if entryF.Rewatch != nil {
+			filters = append(filters, DiaryEntry.Rewatch(*entryF.Rewatch))
+		}
+		if entryF.Rating != nil {
+			filters = append(filters, DiaryEntry.Rating(*entryF.Rating))
+		}
+		if entryF.CreatedAt != nil {
+			filters = append(filters, DiaryEntry.CreatedAt(*entryF.CreatedAt))
+		}
+		if entryF.UpdatedAt != nil {
+			filters = append(filters, DiaryEntry.UpdatedAt(*entryF.UpdatedAt))
+		}
+		if entryF.WatchedFrom != nil {
+			filters = append(filters, DiaryEntry.WatchedOnGTE(*entryF.WatchedFrom))
+		}
+		if entryF.WatchedBefore != nil {
+			filters = append(filters, DiaryEntry.WatchedOnLT(*entryF.WatchedBefore))
+		}
+	}
+	return filters
+}
+
+func (der *diaryEntryRepository) create(entry *model.DiaryEntry) *ent.DiaryEntryCreate {
+	return der.client.DiaryEntry.Create().
+		SetID(uuid.New()).
+		SetUserID(entry.UserID).
+		SetMediaID(entry.MediaID).
+		SetNillableReviewID(entry.ReviewID).
+		SetWatchedOn(entry.WatchedOn).
+		SetRewatch(entry.Rewatch).
+		SetNillableRating(entry.Rating).
+		SetCreatedAt(time.Now())
+}
+
+func (der *diaryEntryRepository) createBulk(entries []*model.DiaryEntry) *ent.DiaryEntryCreateBulk {
+	builders := make([]*ent.DiaryEntryCreate, 0, len(entries))
+	for _, entry := range entries {
+		builders = append(builders, der.create(entry))
+	}
+	return der.client.DiaryEntry.CreateBulk(builders...)
+}
+
+func (der *diaryEntryRepository) detailedEntries(entries []*ent.DiaryEntry) []*model.DetailedDiaryEntry {
+	detailedEntries := make([]*model.DetailedDiaryEntry, 0, len(entries))
+	for _, entry := range entries {
+		detailedEntries = append(detailedEntries, &model.DetailedDiaryEntry{
+			Entry: c.diaryEntry(entry),
+			Media: c.media(entry.Edges.Media),
+		})
+	}
+	return detailedEntries
+}
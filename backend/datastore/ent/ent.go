@@ -12,14 +12,22 @@ import (
 )
 
 type store struct {
-	client      *ent.Client
-	userRepo    repository.UserRepository
-	sessionRepo repository.SessionRepository
-	commentRepo repository.CommentRepository
-	likeRepo    repository.LikeRepository
-	reviewRepo  repository.ReviewRepository
-	mediaRepo   repository.MediaRepository
-	listRepo    repository.ListRepository
+	client              *ent.Client
+	userRepo            repository.UserRepository
+	sessionRepo         repository.SessionRepository
+	commentRepo         repository.CommentRepository
+	likeRepo            repository.LikeRepository
+	reviewRepo          repository.ReviewRepository
+	mediaRepo           repository.MediaRepository
+	listRepo            repository.ListRepository
+	episodeProgressRepo repository.EpisodeProgressRepository
+	diaryEntryRepo      repository.DiaryEntryRepository
+	watchItemRepo       repository.WatchItemRepository
+	activityRepo        repository.ActivityRepository
+	notificationRepo    repository.NotificationRepository
+	followRequestRepo   repository.FollowRequestRepository
+	reviewLikeRepo      repository.ReviewLikeRepository
+	revisionRepo        repository.RevisionRepository
 }
 
 func NewStore(
@@ -46,14 +54,22 @@ func NewStore(
 	})
 
 	return &store{
-		client:      client,
-		userRepo:    newUserRepository(client),
-		sessionRepo: newSessionRepository(client),
-		commentRepo: newCommentRepository(client),
-		likeRepo:    newLikeRepository(client),
-		reviewRepo:  newReviewRepository(client),
-		mediaRepo:   newMediaRepository(client),
-		listRepo:    newListRepository(client),
+		client:              client,
+		userRepo:            newUserRepository(client),
+		sessionRepo:         newSessionRepository(client),
+		commentRepo:         newCommentRepository(client),
+		likeRepo:            newLikeRepository(client),
+		reviewRepo:          newReviewRepository(client),
+		mediaRepo:           newMediaRepository(client),
+		listRepo:            newListRepository(client),
+		episodeProgressRepo: newEpisodeProgressRepository(client),
+		diaryEntryRepo:      newDiaryEntryRepository(client),
+		watchItemRepo:       newWatchItemRepository(client),
+		activityRepo:        newActivityRepository(client),
+		notificationRepo:    newNotificationRepository(client),
+		followRequestRepo:   newFollowRequestRepository(client),
+		reviewLikeRepo:      newReviewLikeRepository(client),
+		revisionRepo:        newRevisionRepository(client),
 	}
 }
 
@@ -64,3 +80,21 @@ func (s *store) Likes() repository.LikeRepository       { return s.likeRepo }
 func (s *store) Reviews() repository.ReviewRepository   { return s.reviewRepo }
 func (s *store) Medias() repository.MediaRepository     { return s.mediaRepo }
 func (s *store) Lists() repository.ListRepository       { return s.listRepo }
+func (s *store) EpisodeProgresses() repository.EpisodeProgressRepository {
+	return s.episodeProgressRepo
+}
+func (s *store) DiaryEntries() repository.DiaryEntryRepository { return s.diaryEntryRepo }
+func (s *store) WatchItems() repository.WatchItemRepository    { return s.watchItemRepo }
+func (s *store) Activities() repository.ActivityRepository     { return s.activityRepo }
+func (s *store) Notifications() repository.NotificationRepository {
+	return s.notificationRepo
+}
+func (s *store) FollowRequests() repository.FollowRequestRepository {
+	return s.followRequestRepo
+}
+func (s *store) ReviewLikes() repository.ReviewLikeRepository {
+	return s.reviewLikeRepo
+}
+func (s *store) Revisions() repository.RevisionRepository {
+	return s.revisionRepo
+}
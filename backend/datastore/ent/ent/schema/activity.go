@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Activity holds the schema definition for the Activity entity.
+type Activity struct {
+	ent.Schema
+}
+
+// Fields of the Activity.
+func (Activity) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Unique().Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		field.Enum("kind").Values("review_created", "list_created", "list_updated", "comment_created", "user_followed").Immutable(),
+		field.UUID("media_id", uuid.UUID{}).Nillable().Optional().Immutable(),
+		field.UUID("list_id", uuid.UUID{}).Nillable().Optional().Immutable(),
+		field.UUID("review_id", uuid.UUID{}).Nillable().Optional().Immutable(),
+		field.UUID("comment_id", uuid.UUID{}).Nillable().Optional().Immutable(),
+		field.UUID("followed_id", uuid.UUID{}).Nillable().Optional().Immutable(),
+		field.Time("created_at").Immutable(),
+	}
+}
+
+// Edges of the Activity. Every subject cascades, an activity about something that was deleted is meaningless.
+func (Activity) Edges() []ent.Edge {
+	return []ent.Edge{
+		// O2M User (actor) <-- Activity
+		edge.From("user", User.Type).Ref("activities").Field("user_id").Unique().Required().Immutable(),
+		// O2M Media <-- Activity
+		edge.From("media", Media.Type).Ref("activities").Field("media_id").Unique().Immutable(),
+		// O2M List <-- Activity
+		edge.From("list", List.Type).Ref("activities").Field("list_id").Unique().Immutable(),
+		// O2M Review <-- Activity
+		edge.From("review", Review.Type).Ref("activities").Field("review_id").Unique().Immutable(),
+		// O2M Comment <-- Activity
+		edge.From("comment", Comment.Type).Ref("activities").Field("comment_id").Unique().Immutable(),
+		// O2M User (followed) <-- Activity
+		edge.From("followed", User.Type).Ref("followed_activities").Field("followed_id").Unique().Immutable(),
+	}
+}
+
+func (Activity) Indexes() []ent.Index {
+	return []ent.Index{
+		// feeds are read newest first per actor, the id breaks ties for cursors
+		index.Fields("user_id", "created_at", "id"),
+	}
+}
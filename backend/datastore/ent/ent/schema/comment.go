@@ -20,9 +20,14 @@ func (Comment) Fields() []ent.Field {
 		field.UUID("user_id", uuid.UUID{}).Immutable(),
 		field.UUID("media_id", uuid.UUID{}).Immutable(),
 		field.UUID("replying_to_id", uuid.UUID{}).Nillable().Optional().Immutable(),
+		// set when the comment is in a review's thread rather than the media's
+		field.UUID("review_id", uuid.UUID{}).Nillable().Optional().Immutable(),
 		field.String("content"),
+		field.Bool("spoiler").Default(false),
 		field.Time("created_at").Immutable(),
 		field.Time("updated_at").Nillable().Optional(),
+		// set when the comment was deleted by its author, it is kept as a tombstone while it has replies
+		field.Time("deleted_at").Nillable().Optional(),
 	}
 }
 
@@ -33,10 +38,19 @@ func (Comment) Edges() []ent.Edge {
 		edge.From("user", User.Type).Ref("comments").Field("user_id").Unique().Required().Immutable(),
 		// O2M Comment <-- Like
 		edge.To("likes", Like.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
-		// O2M Comment <-- Comment (Replies)
+		// O2M Comment <-- Comment (Replies), authors deleting a comment only leave a tombstone,
+		// so replies are only taken along when a moderator purges the comment
 		edge.To("replies", Comment.Type).Annotations(entsql.OnDelete(entsql.Cascade)).
 			From("replying_to").Field("replying_to_id").Immutable().Unique(),
 		// O2M Media <-- Comment
 		edge.From("media", Media.Type).Ref("comments").Field("media_id").Unique().Required().Immutable(),
+		// O2M Review <-- Comment
+		edge.From("review", Review.Type).Ref("comments").Field("review_id").Unique().Immutable(),
+		// O2M Comment <-- Activity
+		edge.To("activities", Activity.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M Comment <-- Notification
+		edge.To("notifications", Notification.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M Comment <-- Revision
+		edge.To("revisions", Revision.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DiaryEntry holds the schema definition for the DiaryEntry entity.
+type DiaryEntry struct {
+	ent.Schema
+}
+
+// Fields of the DiaryEntry.
+func (DiaryEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Unique().Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		field.UUID("media_id", uuid.UUID{}).Immutable(),
+		field.UUID("review_id", uuid.UUID{}).Nillable().Optional(),
+		field.Time("watched_on").SchemaType(map[string]string{dialect.Postgres: "date"}),
+		field.Bool("rewatch").Default(false),
+		field.Int("rating").Nillable().Optional(),
+		field.Time("created_at").Immutable(),
+		field.Time("updated_at").Nillable().Optional(),
+	}
+}
+
+// Edges of the DiaryEntry.
+func (DiaryEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		// O2M User <-- DiaryEntry
+		edge.From("user", User.Type).Ref("diary_entries").Field("user_id").Unique().Required().Immutable(),
+		// O2M Media <-- DiaryEntry
+		edge.From("media", Media.Type).Ref("diary_entries").Field("media_id").Unique().Required().Immutable(),
+		// O2M Review <-- DiaryEntry
+		edge.From("review", Review.Type).Ref("diary_entries").Field("review_id").Unique(),
+	}
+}
+
+func (DiaryEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		// unlike reviews, the same media can be logged any number of times, the diary is read by date
+		index.Fields("user_id", "watched_on"),
+	}
+}
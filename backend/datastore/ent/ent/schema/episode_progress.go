@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EpisodeProgress holds the schema definition for the EpisodeProgress entity.
+type EpisodeProgress struct {
+	ent.Schema
+}
+
+// Fields of the EpisodeProgress.
+func (EpisodeProgress) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Unique().Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		field.UUID("media_id", uuid.UUID{}).Immutable(),
+		field.Int("season_number").Immutable(),
+		field.Int("episode_number").Immutable(),
+		field.Time("created_at").Immutable(),
+		field.Time("updated_at").Nillable().Optional(),
+	}
+}
+
+// Edges of the EpisodeProgress.
+func (EpisodeProgress) Edges() []ent.Edge {
+	return []ent.Edge{
+		// O2M User <-- EpisodeProgress
+		edge.From("user", User.Type).Ref("episode_progress").Field("user_id").Unique().Required().Immutable(),
+		// O2M Media <-- EpisodeProgress
+		edge.From("media", Media.Type).Ref("episode_progress").Field("media_id").Unique().Required().Immutable(),
+	}
+}
+
+func (EpisodeProgress) Indexes() []ent.Index {
+	return []ent.Index{
+		// an episode is either watched or not, so it's only recorded once per user
+		index.Fields("user_id", "media_id", "season_number", "episode_number").Unique(),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Follow holds the schema definition for the Follow entity, the edge schema of User following User.
+type Follow struct {
+	ent.Schema
+}
+
+func (Follow) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// the join table and its columns are the ones ent generated for the follow edge before it had an edge schema,
+		// the created_at column is added to it by the auto migration
+		entsql.Annotation{Table: "user_following"},
+		field.ID("user_id", "follower_id"),
+	}
+}
+
+// Fields of the Follow.
+func (Follow) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		// follower_id is the followed user, ent names the column after the inverse edge
+		field.UUID("follower_id", uuid.UUID{}).Immutable(),
+		// follows made before the column existed are dated to the migration
+		field.Time("created_at").Default(time.Now).Immutable().Annotations(entsql.Default("CURRENT_TIMESTAMP")),
+	}
+}
+
+// Edges of the Follow.
+func (Follow) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Field("user_id").Unique().Required().Immutable(),
+		edge.To("following", User.Type).Field("follower_id").Unique().Required().Immutable(),
+	}
+}
+
+func (Follow) Indexes() []ent.Index {
+	return []ent.Index{
+		// followers of a user, most recent first
+		index.Fields("follower_id", "created_at"),
+		// users a user follows, most recent first
+		index.Fields("user_id", "created_at"),
+	}
+}
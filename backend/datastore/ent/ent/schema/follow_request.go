@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// FollowRequest holds the schema definition for the FollowRequest entity.
+type FollowRequest struct {
+	ent.Schema
+}
+
+// Fields of the FollowRequest.
+func (FollowRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Unique().Immutable(),
+		field.UUID("requester_id", uuid.UUID{}).Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		field.Time("created_at").Immutable(),
+	}
+}
+
+// Edges of the FollowRequest.
+func (FollowRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		// O2M User (requester) <-- FollowRequest
+		edge.From("requester", User.Type).Ref("sent_follow_requests").Field("requester_id").Unique().Required().Immutable(),
+		// O2M User (private account) <-- FollowRequest
+		edge.From("user", User.Type).Ref("follow_requests").Field("user_id").Unique().Required().Immutable(),
+	}
+}
+
+func (FollowRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		// a user can only have one pending request per account
+		index.Fields("requester_id", "user_id").Unique(),
+		// pending requests are paged newest first
+		index.Fields("user_id", "created_at", "id"),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -34,6 +35,10 @@ func (List) Edges() []ent.Edge {
 		edge.From("members", User.Type).Ref("lists"),
 		// M2M Media <--> List
 		edge.From("medias", Media.Type).Ref("lists"),
+		// O2M List <-- Activity
+		edge.To("activities", Activity.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M List <-- Notification
+		edge.To("notifications", Notification.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -40,6 +40,14 @@ func (Media) Edges() []ent.Edge {
 		edge.To("lists", List.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		// O2M Media <-- Review
 		edge.To("reviews", Review.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M Media <-- EpisodeProgress
+		edge.To("episode_progress", EpisodeProgress.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M Media <-- DiaryEntry
+		edge.To("diary_entries", DiaryEntry.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M Media <-- WatchItem
+		edge.To("watch_items", WatchItem.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M Media <-- Activity
+		edge.To("activities", Activity.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Notification holds the schema definition for the Notification entity.
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Unique().Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		field.UUID("actor_id", uuid.UUID{}).Immutable(),
+		field.Enum("kind").Values("comment_replied", "comment_liked", "user_followed", "list_member_added", "follow_requested", "follow_approved", "review_liked", "review_commented").Immutable(),
+		field.UUID("comment_id", uuid.UUID{}).Nillable().Optional().Immutable(),
+		field.UUID("list_id", uuid.UUID{}).Nillable().Optional().Immutable(),
+		field.UUID("review_id", uuid.UUID{}).Nillable().Optional().Immutable(),
+		field.Bool("read").Default(false),
+		field.Time("created_at").Immutable(),
+	}
+}
+
+// Edges of the Notification.
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		// O2M User (recipient) <-- Notification
+		edge.From("user", User.Type).Ref("notifications").Field("user_id").Unique().Required().Immutable(),
+		// O2M User (actor) <-- Notification
+		edge.From("actor", User.Type).Ref("caused_notifications").Field("actor_id").Unique().Required().Immutable(),
+		// O2M Comment <-- Notification
+		edge.From("comment", Comment.Type).Ref("notifications").Field("comment_id").Unique().Immutable(),
+		// O2M List <-- Notification
+		edge.From("list", List.Type).Ref("notifications").Field("list_id").Unique().Immutable(),
+		// O2M Review <-- Notification
+		edge.From("review", Review.Type).Ref("notifications").Field("review_id").Unique().Immutable(),
+	}
+}
+
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		// notifications are paged newest first, and unread ones are counted on every page load
+		index.Fields("user_id", "created_at", "id"),
+		index.Fields("user_id", "read"),
+	}
+}
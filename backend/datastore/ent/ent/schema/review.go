@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -20,9 +21,12 @@ func (Review) Fields() []ent.Field {
 		field.UUID("user_id", uuid.UUID{}).Immutable(),
 		field.UUID("media_id", uuid.UUID{}).Immutable(),
 		field.String("content"),
+		field.Bool("spoiler").Default(false),
 		field.Int("rating"),
 		field.Time("created_at").Immutable(),
 		field.Time("updated_at").Nillable().Optional(),
+		// set when the review was deleted by its author, it is kept as a tombstone while its thread has comments
+		field.Time("deleted_at").Nillable().Optional(),
 	}
 }
 
@@ -33,12 +37,25 @@ func (Review) Edges() []ent.Edge {
 		edge.From("user", User.Type).Ref("reviews").Field("user_id").Unique().Required().Immutable(),
 		// O2M Media <-- Review
 		edge.From("media", Media.Type).Ref("reviews").Field("media_id").Unique().Required().Immutable(),
+		// O2M Review <-- DiaryEntry, deleting a review keeps the diary entries that linked to it
+		edge.To("diary_entries", DiaryEntry.Type).Annotations(entsql.OnDelete(entsql.SetNull)),
+		// O2M Review <-- Activity
+		edge.To("activities", Activity.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M Review <-- ReviewLike
+		edge.To("likes", ReviewLike.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M Review <-- Comment, the review's thread. A review with a thread is never deleted while it has
+		// comments, it's left as a tombstone so the comments other users wrote stay
+		edge.To("comments", Comment.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M Review <-- Notification
+		edge.To("notifications", Notification.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M Review <-- Revision
+		edge.To("revisions", Revision.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
 func (Review) Indexes() []ent.Index {
 	return []ent.Index{
-		// a user can only review a movie/tv show once
-		index.Fields("user_id", "media_id").Unique(),
+		// a user can only review a movie/tv show once, the tombstone of a deleted review doesn't count
+		index.Fields("user_id", "media_id").Unique().Annotations(entsql.IndexWhere("deleted_at IS NULL")),
 	}
 }
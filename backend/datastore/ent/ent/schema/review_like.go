@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ReviewLike holds the schema definition for the ReviewLike entity.
+type ReviewLike struct {
+	ent.Schema
+}
+
+// Fields of the ReviewLike.
+func (ReviewLike) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Unique().Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		field.UUID("review_id", uuid.UUID{}).Immutable(),
+		field.Time("created_at").Immutable(),
+		field.Time("updated_at").Nillable().Optional(),
+	}
+}
+
+// Edges of the ReviewLike.
+func (ReviewLike) Edges() []ent.Edge {
+	return []ent.Edge{
+		// O2M User <-- ReviewLike
+		edge.From("user", User.Type).Ref("review_likes").Field("user_id").Unique().Required().Immutable(),
+		// O2M Review <-- ReviewLike
+		edge.From("review", Review.Type).Ref("likes").Field("review_id").Unique().Required().Immutable(),
+	}
+}
+
+func (ReviewLike) Indexes() []ent.Index {
+	return []ent.Index{
+		// a user can only like a review once
+		index.Fields("user_id", "review_id").Unique(),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Revision holds the schema definition for the Revision entity.
+type Revision struct {
+	ent.Schema
+}
+
+// Fields of the Revision.
+func (Revision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Unique().Immutable(),
+		// one of comment_id and review_id is set, to the comment or review that was edited
+		field.UUID("comment_id", uuid.UUID{}).Nillable().Optional().Immutable(),
+		field.UUID("review_id", uuid.UUID{}).Nillable().Optional().Immutable(),
+		field.String("content").Immutable(),
+		// only set for reviews
+		field.Int("rating").Nillable().Optional().Immutable(),
+		field.Bool("spoiler").Default(false).Immutable(),
+		field.Time("created_at").Immutable(),
+		field.Time("updated_at").Nillable().Optional(),
+	}
+}
+
+// Edges of the Revision.
+func (Revision) Edges() []ent.Edge {
+	return []ent.Edge{
+		// O2M Comment <-- Revision
+		edge.From("comment", Comment.Type).Ref("revisions").Field("comment_id").Unique().Immutable(),
+		// O2M Review <-- Revision
+		edge.From("review", Review.Type).Ref("revisions").Field("review_id").Unique().Immutable(),
+	}
+}
+
+func (Revision) Indexes() []ent.Index {
+	return []ent.Index{
+		// revisions of a comment, most recent first
+		index.Fields("comment_id", "created_at"),
+		// revisions of a review, most recent first
+		index.Fields("review_id", "created_at"),
+	}
+}
@@ -22,6 +22,15 @@ func (User) Fields() []ent.Field {
 		field.String("email").Unique(),
 		field.String("password").Sensitive(),
 		field.String("profile_picture"),
+		field.String("region").Nillable().Optional(),
+		field.Ints("streaming_services").Optional(),
+		field.Strings("muted_notifications").Optional(),
+		// private accounts only show their reviews, lists and activity to approved followers
+		field.Bool("private").Default(false),
+		// hide_spoilers redacts spoilers of media the user hasn't watched yet
+		field.Bool("hide_spoilers").Default(false),
+		// moderators can purge comments, it is only ever set in the database
+		field.Bool("moderator").Default(false),
 		field.Time("created_at").Immutable(),
 		field.Time("updated_at").Nillable().Optional(),
 	}
@@ -36,13 +45,37 @@ func (User) Edges() []ent.Edge {
 		edge.To("comments", Comment.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		// O2M User <-- Like
 		edge.To("likes", Like.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M User <-- ReviewLike
+		edge.To("review_likes", ReviewLike.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		// M2M User <--> User (Followers)
-		edge.To("following", User.Type).From("followers").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("following", User.Type).Through("follows", Follow.Type).From("followers").Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M User (private account) <-- FollowRequest
+		edge.To("follow_requests", FollowRequest.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M User (requester) <-- FollowRequest
+		edge.To("sent_follow_requests", FollowRequest.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// M2M User <--> User (Blocks)
+		edge.To("blocking", User.Type).From("blocked_by").Annotations(entsql.OnDelete(entsql.Cascade)),
+		// M2M User <--> User (Mutes)
+		edge.To("muting", User.Type).From("muted_by").Annotations(entsql.OnDelete(entsql.Cascade)),
 		// O2M User <-- Review
 		edge.To("reviews", Review.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		// M2M User (members) <--> List
 		edge.To("lists", List.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		// O2M User (owner) <-- List
 		edge.To("owned_lists", List.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M User <-- EpisodeProgress
+		edge.To("episode_progress", EpisodeProgress.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M User <-- DiaryEntry
+		edge.To("diary_entries", DiaryEntry.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M User <-- WatchItem
+		edge.To("watch_items", WatchItem.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M User (actor) <-- Activity
+		edge.To("activities", Activity.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M User (followed) <-- Activity
+		edge.To("followed_activities", Activity.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M User (recipient) <-- Notification
+		edge.To("notifications", Notification.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// O2M User (actor) <-- Notification
+		edge.To("caused_notifications", Notification.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
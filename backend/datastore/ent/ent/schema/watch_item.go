@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WatchItem holds the schema definition for the WatchItem entity.
+type WatchItem struct {
+	ent.Schema
+}
+
+// Fields of the WatchItem.
+func (WatchItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Unique().Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		field.UUID("media_id", uuid.UUID{}).Immutable(),
+		field.Enum("status").Values("watchlist", "watched"),
+		field.Int("priority").Default(0),
+		field.Time("added_at"),
+		field.Time("updated_at").Nillable().Optional(),
+	}
+}
+
+// Edges of the WatchItem.
+func (WatchItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		// O2M User <-- WatchItem
+		edge.From("user", User.Type).Ref("watch_items").Field("user_id").Unique().Required().Immutable(),
+		// O2M Media <-- WatchItem
+		edge.From("media", Media.Type).Ref("watch_items").Field("media_id").Unique().Required().Immutable(),
+	}
+}
+
+func (WatchItem) Indexes() []ent.Index {
+	return []ent.Index{
+		// a title is either on the watchlist or watched, never both, so marking it watched moves it
+		index.Fields("user_id", "media_id").Unique(),
+		index.Fields("user_id", "status"),
+	}
+}
@@ -0,0 +1,151 @@
+package ent
+
+import (
+	"cine/datastore/ent/ent"
+	EpisodeProgress "cine/datastore/ent/ent/episodeprogress"
+	"cine/datastore/ent/ent/predicate"
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type episodeProgressRepository struct {
+	client *ent.Client
+}
+
+func newEpisodeProgressRepository(client *ent.Client) repository.EpisodeProgressRepository {
+	return &episodeProgressRepository{client: client}
+}
+
+func (epr *episodeProgressRepository) One(ctx context.Context, progressFs ...*model.EpisodeProgressF) (*model.EpisodeProgress, error) {
+	q := epr.client.EpisodeProgress.Query()
+	q = q.Where(epr.filters(progressFs)...)
+
+	progress, err := q.First(ctx)
+	return c.episodeProgress(progress), c.error(err)
+}
+
+func (epr *episodeProgressRepository) All(ctx context.Context, progressFs ...*model.EpisodeProgressF) ([]*model.EpisodeProgress, error) {
+	q := epr.client.EpisodeProgress.Query()
+	q = q.Where(epr.filters(progressFs)...).
+		Order(ent.Asc(EpisodeProgress.FieldSeasonNumber), ent.Asc(EpisodeProgress.FieldEpisodeNumber))
+
+	progresses, err := q.All(ctx)
+	return c.episodeProgresses(progresses), c.error(err)
+}
+
+func (epr *episodeProgressRepository) Exists(ctx context.Context, progressFs ...*model.EpisodeProgressF) (bool, error) {
+	q := epr.client.EpisodeProgress.Query()
+	q = q.Where(epr.filters(progressFs)...)
+
+	exists, err := q.Exist(ctx)
+	return exists, c.error(err)
+}
+
+func (epr *episodeProgressRepository) Count(ctx context.Context, progressFs ...*model.EpisodeProgressF) (int, error) {
+	q := epr.client.EpisodeProgress.Query()
+	q = q.Where(epr.filters(progressFs)...)
+
+	count, err := q.Count(ctx)
+	return count, c.error(err)
+}
+
+func (epr *episodeProgressRepository) Insert(ctx context.Context, progress *model.EpisodeProgress) (*model.EpisodeProgress, error) {
+	i := epr.create(progress)
+
+	iProgress, err := i.Save(ctx)
+	return c.episodeProgress(iProgress), c.error(err)
+}
+
+func (epr *episodeProgressRepository) InsertBulk(ctx context.Context, progresses []*model.EpisodeProgress) ([]*model.EpisodeProgress, error) {
+	i := epr.createBulk(progresses)
+
+	iProgresses, err := i.Save(ctx)
+	return c.episodeProgresses(iProgresses), c.error(err)
+}
+
+func (epr *episodeProgressRepository) Update(ctx context.Context, id uuid.UUID, _ *model.EpisodeProgressU) (*model.EpisodeProgress, error) {
+	q := epr.client.EpisodeProgress.UpdateOneID(id)
+
+	q.SetUpdatedAt(time.Now())
+
+	progress, err := q.Save(ctx)
+	return c.episodeProgress(progress), c.error(err)
+}
+
+func (epr *episodeProgressRepository) UpdateExec(ctx context.Context, _ *model.EpisodeProgressU, progressFs ...*model.EpisodeProgressF) (int, error) {
+	q := epr.client.EpisodeProgress.Update()
+	q = q.Where(epr.filters(progressFs)...)
+
+	q.SetUpdatedAt(time.Now())
+
+	affected, err := q.Save(ctx)
+	return affected, c.error(err)
+}
+
+func (epr *episodeProgressRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	q := epr.client.EpisodeProgress.DeleteOneID(id)
+
+	err := q.Exec(ctx)
+	return c.error(err)
+}
+
+func (epr *episodeProgressRepository) DeleteExec(ctx context.Context, progressFs ...*model.EpisodeProgressF) (int, error) {
+	q := epr.client.EpisodeProgress.Delete()
+	q = q.Where(epr.filters(progressFs)...)
+
+	affected, err := q.Exec(ctx)
+	return affected, c.error(err)
+}
+
+func (epr *episodeProgressRepository) filters(progressFs []*model.EpisodeProgressF) []predicate.EpisodeProgress {
+	var progressF *model.EpisodeProgressF
+	if len(progressFs) > 0 {
+		progressF = progressFs[0]
+	}
+	var filters []predicate.EpisodeProgress
+	if progressF != nil {
+		if progressF.ID != nil {
+			filters = append(filters, EpisodeProgress.ID(*progressF.ID))
+		}
+		if progressF.UserID != nil {
+			filters = append(filters, EpisodeProgress.UserID(*progressF.UserID))
+		}
+		if progressF.MediaID != nil {
+			filters = append(filters, EpisodeProgress.MediaID(*progressF.MediaID))
+		}
+		if progressF.SeasonNumber != nil {
+			filters = append(filters, EpisodeProgress.SeasonNumber(*progressF.SeasonNumber))
+		}
+		if progressF.EpisodeNumber != nil {
+			filters = append(filters, EpisodeProgress.EpisodeNumber(*progressF.EpisodeNumber))
+		}
+		if progressF.CreatedAt != nil {
+			filters = append(filters, EpisodeProgress.CreatedAt(*progressF.CreatedAt))
+		}
+		if progressF.UpdatedAt != nil {
+			filters = append(filters, EpisodeProgress.UpdatedAt(*progressF.UpdatedAt))
+		}
+	}
+	return filters
+}
+
+func (epr *episodeProgressRepository) create(progress *model.EpisodeProgress) *ent.EpisodeProgressCreate {
+	return epr.client.EpisodeProgress.Create().
+		SetID(uuid.New()).
+		SetUserID(progress.UserID).
+		SetMediaID(progress.MediaID).
+		SetSeasonNumber(progress.SeasonNumber).
+		SetEpisodeNumber(progress.EpisodeNumber).
+		SetCreatedAt(time.Now())
+}
+
+func (epr *episodeProgressRepository) createBulk(progresses []*model.EpisodeProgress) *ent.EpisodeProgressCreateBulk {
+	builders := make([]*ent.EpisodeProgressCreate, 0, len(progresses))
+	for _, progress := range progresses {
+		builders = append(builders, epr.create(progress))
+	}
+	return epr.client.EpisodeProgress.CreateBulk(builders...)
+}
@@ -0,0 +1,181 @@
+package ent
+
+import (
+	"cine/datastore/ent/ent"
+	FollowRequest "cine/datastore/ent/ent/followrequest"
+	"cine/datastore/ent/ent/predicate"
+	User "cine/datastore/ent/ent/user"
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type followRequestRepository struct {
+	client *ent.Client
+}
+
+func newFollowRequestRepository(client *ent.Client) repository.FollowRequestRepository {
+	return &followRequestRepository{client: client}
+}
+
+func (fr *followRequestRepository) One(ctx context.Context, requestFs ...*model.FollowRequestF) (*model.FollowRequest, error) {
+	q := fr.client.FollowRequest.Query()
+	q = q.Where(fr.filters(requestFs)...)
+
+	request, err := q.First(ctx)
+	return c.followRequest(request), c.error(err)
+}
+
+func (fr *followRequestRepository) All(ctx context.Context, requestFs ...*model.FollowRequestF) ([]*model.FollowRequest, error) {
+	q := fr.client.FollowRequest.Query()
+	q = q.Where(fr.filters(requestFs)...).
+		Order(ent.Desc(FollowRequest.FieldCreatedAt), ent.Desc(FollowRequest.FieldID))
+
+	requests, err := q.All(ctx)
+	return c.followRequests(requests), c.error(err)
+}
+
+func (fr *followRequestRepository) Exists(ctx context.Context, requestFs ...*model.FollowRequestF) (bool, error) {
+	q := fr.client.FollowRequest.Query()
+	q = q.Where(fr.filters(requestFs)...)
+
+	exists, err := q.Exist(ctx)
+	return exists, c.error(err)
+}
+
+func (fr *followRequestRepository) Count(ctx context.Context, requestFs ...*model.FollowRequestF) (int, error) {
+	q := fr.client.FollowRequest.Query()
+	q = q.Where(fr.filters(requestFs)...)
+
+	count, err := q.Count(ctx)
+	return count, c.error(err)
+}
+
+func (fr *followRequestRepository) Insert(ctx context.Context, request *model.FollowRequest) (*model.FollowRequest, error) {
+	i := fr.create(request)
+
+	iRequest, err := i.Save(ctx)
+	return c.followRequest(iRequest), c.error(err)
+}
+
+func (fr *followRequestRepository) InsertBulk(ctx context.Context, requests []*model.FollowRequest) ([]*model.FollowRequest, error) {
+	i := fr.createBulk(requests)
+
+	iRequests, err := i.Save(ctx)
+	return c.followRequests(iRequests), c.error(err)
+}
+
+// Update is a no-op save, follow requests are only ever approved, denied or cancelled.
+func (fr *followRequestRepository) Update(ctx context.Context, id uuid.UUID, _ *model.FollowRequestU) (*model.FollowRequest, error) {
+	q := fr.client.FollowRequest.UpdateOneID(id)
+
+	request, err := q.Save(ctx)
+	return c.followRequest(request), c.error(err)
+}
+
+func (fr *followRequestRepository) UpdateExec(ctx context.Context, _ *model.FollowRequestU, requestFs ...*model.FollowRequestF) (int, error) {
+	q := fr.client.FollowRequest.Update()
+	q = q.Where(fr.filters(requestFs)...)
+
+	affected, err := q.Save(ctx)
+	return affected, c.error(err)
+}
+
+func (fr *followRequestRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	q := fr.client.FollowRequest.DeleteOneID(id)
+
+	err := q.Exec(ctx)
+	return c.error(err)
+}
+
+func (fr *followRequestRepository) DeleteExec(ctx context.Context, requestFs ...*model.FollowRequestF) (int, error) {
+	q := fr.client.FollowRequest.Delete()
+	q = q.Where(fr.filters(requestFs)...)
+
+	affected, err := q.Exec(ctx)
+	return affected, c.error(err)
+}
+
+func (fr *followRequestRepository) AllDetailed(
+	ctx context.Context,
+	after *model.Cursor,
+	limit int,
+	requestFs ...*model.FollowRequestF,
+) ([]*model.DetailedFollowRequest, error) {
+	q := fr.client.FollowRequest.Query()
+	q = q.Where(fr.filters(requestFs)...)
+	if after != nil {
+		q = q.Where(
+			FollowRequest.Or(
+				FollowRequest.CreatedAtLT(after.CreatedAt),
+				FollowRequest.And(FollowRequest.CreatedAt(after.CreatedAt), FollowRequest.IDLT(after.ID)),
+			),
+		)
+	}
+
+	q = q.WithRequester(func(q *ent.UserQuery) {
+		q.Select(
+			User.FieldID,
+			User.FieldDisplayName,
+			User.FieldUsername,
+			User.FieldProfilePicture,
+		)
+	}).
+		Order(ent.Desc(FollowRequest.FieldCreatedAt), ent.Desc(FollowRequest.FieldID)).
+		Limit(limit)
+
+	requests, err := q.All(ctx)
+	return fr.detailedFollowRequests(requests), c.error(err)
+}
+
+func (fr *followRequestRepository) filters(requestFs []*model.FollowRequestF) []predicate.FollowRequest {
+	var requestF *model.FollowRequestF
+	if len(requestFs) > 0 {
+		requestF = requestFs[0]
+	}
+	var filters []predicate.FollowRequest
+	if requestF != nil {
+		if requestF.ID != nil {
+			filters = append(filters, FollowRequest.ID(*requestF.ID))
+		}
+		if requestF.RequesterID != nil {
+			filters = append(filters, FollowRequest.RequesterID(*requestF.RequesterID))
+		}
+		if requestF.UserID != nil {
+			filters = append(filters, FollowRequest.UserID(*requestF.UserID))
+		}
+		if requestF.CreatedAt != nil {
+			filters = append(filters, FollowRequest.CreatedAt(*requestF.CreatedAt))
+		}
+	}
+	return filters
+}
+
+func (fr *followRequestRepository) create(request *model.FollowRequest) *ent.FollowRequestCreate {
+	return fr.client.FollowRequest.Create().
+		SetID(uuid.New()).
+		SetRequesterID(request.RequesterID).
+		SetUserID(request.UserID).
+		SetCreatedAt(time.Now())
+}
+
+func (fr *followRequestRepository) createBulk(requests []*model.FollowRequest) *ent.FollowRequestCreateBulk {
+	builders := make([]*ent.FollowRequestCreate, 0, len(requests))
+	for _, request := range requests {
+		builders = append(builders, fr.create(request))
+	}
+	return fr.client.FollowRequest.CreateBulk(builders...)
+}
+
+func (fr *followRequestRepository) detailedFollowRequests(requests []*ent.FollowRequest) []*model.DetailedFollowRequest {
+	detailedRequests := make([]*model.DetailedFollowRequest, 0, len(requests))
+	for _, request := range requests {
+		detailedRequests = append(detailedRequests, &model.DetailedFollowRequest{
+			Request:   c.followRequest(request),
+			Requester: c.user(request.Edges.Requester),
+		})
+	}
+	return detailedRequests
+}
@@ -181,6 +181,47 @@ func (lr *listRepository) AllMedia(ctx context.Context, list *model.List) ([]*mo
 	return c.medias(medias), c.error(err)
 }
 
+func (lr *listRepository) HasMedia(ctx context.Context, userID uuid.UUID, mediaType model.MediaType, refs []int) (map[int]bool, error) {
+	has := make(map[int]bool, len(refs))
+	if len(refs) == 0 {
+		return has, nil
+	}
+
+	q := lr.client.Media.Query().
+		Where(
+			Media.RefIn(refs...),
+			Media.MediaTypeEQ(Media.MediaType(mediaType)),
+			Media.HasListsWith(List.HasMembersWith(User.ID(userID))),
+		).
+		Select(Media.FieldRef)
+
+	found, err := q.Ints(ctx)
+	if err != nil {
+		return nil, c.error(err)
+	}
+
+	for _, ref := range found {
+		has[ref] = true
+	}
+	return has, nil
+}
+
+// SearchPublic matches query against the titles of public lists, case-insensitively. Only lists whose
+// owner the viewer may see are searched, leaving out owners the viewer muted or is on either side of a block with.
+func (lr *listRepository) SearchPublic(ctx context.Context, viewerID uuid.UUID, query string, limit int) ([]*model.List, error) {
+	q := lr.client.List.Query()
+	q = q.Where(
+		List.Public(true),
+		List.TitleContainsFold(query),
+		List.HasOwnerWith(visibleTo(viewerID), User.Not(hiddenFrom(viewerID))),
+	).
+		Order(ent.Asc(List.FieldTitle)).
+		Limit(limit)
+
+	lists, err := q.All(ctx)
+	return c.lists(lists), c.error(err)
+}
+
 func (lr *listRepository) filters(listFs []*model.ListF) []predicate.List {
 	var listF *model.ListF
 	if len(listFs) > 0 {
@@ -0,0 +1,213 @@
+package ent
+
+import (
+	"cine/datastore/ent/ent"
+	Notification "cine/datastore/ent/ent/notification"
+	"cine/datastore/ent/ent/predicate"
+	User "cine/datastore/ent/ent/user"
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type notificationRepository struct {
+	client *ent.Client
+}
+
+func newNotificationRepository(client *ent.Client) repository.NotificationRepository {
+	return &notificationRepository{client: client}
+}
+
+func (nr *notificationRepository) One(ctx context.Context, notificationFs ...*model.NotificationF) (*model.Notification, error) {
+	q := nr.client.Notification.Query()
+	q = q.Where(nr.filters(notificationFs)...)
+
+	notification, err := q.First(ctx)
+	return c.notification(notification), c.error(err)
+}
+
+func (nr *notificationRepository) All(ctx context.Context, notificationFs ...*model.NotificationF) ([]*model.Notification, error) {
+	q := nr.client.Notification.Query()
+	q = q.Where(nr.filters(notificationFs)...).
+		Order(ent.Desc(Notification.FieldCreatedAt), ent.Desc(Notification.FieldID))
+
+	notifications, err := q.All(ctx)
+	return c.notifications(notifications), c.error(err)
+}
+
+func (nr *notificationRepository) Exists(ctx context.Context, notificationFs ...*model.NotificationF) (bool, error) {
+	q := nr.client.Notification.Query()
+	q = q.Where(nr.filters(notificationFs)...)
+
+	exists, err := q.Exist(ctx)
+	return exists, c.error(err)
+}
+
+func (nr *notificationRepository) Count(ctx context.Context, notificationFs ...*model.NotificationF) (int, error) {
+	q := nr.client.Notification.Query()
+	q = q.Where(nr.filters(notificationFs)...)
+
+	count, err := q.Count(ctx)
+	return count, c.error(err)
+}
+
+func (nr *notificationRepository) Insert(ctx context.Context, notification *model.Notification) (*model.Notification, error) {
+	i := nr.create(notification)
+
+	iNotification, err := i.Save(ctx)
+	return c.notification(iNotification), c.error(err)
+}
+
+func (nr *notificationRepository) InsertBulk(ctx context.Context, notifications []*model.Notification) ([]*model.Notification, error) {
+	i := nr.createBulk(notifications)
+
+	iNotifications, err := i.Save(ctx)
+	return c.notifications(iNotifications), c.error(err)
+}
+
+func (nr *notificationRepository) Update(ctx context.Context, id uuid.UUID, notificationU *model.NotificationU) (*model.Notification, error) {
+	q := nr.client.Notification.UpdateOneID(id)
+
+	q.SetNillableRead(notificationU.Read)
+
+	notification, err := q.Save(ctx)
+	return c.notification(notification), c.error(err)
+}
+
+func (nr *notificationRepository) UpdateExec(ctx context.Context, notificationU *model.NotificationU, notificationFs ...*model.NotificationF) (int, error) {
+	q := nr.client.Notification.Update()
+	q = q.Where(nr.filters(notificationFs)...)
+
+	q.SetNillableRead(notificationU.Read)
+
+	affected, err := q.Save(ctx)
+	return affected, c.error(err)
+}
+
+func (nr *notificationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	q := nr.client.Notification.DeleteOneID(id)
+
+	err := q.Exec(ctx)
+	return c.error(err)
+}
+
+func (nr *notificationRepository) DeleteExec(ctx context.Context, notificationFs ...*model.NotificationF) (int, error) {
+	q := nr.client.Notification.Delete()
+	q = q.Where(nr.filters(notificationFs)...)
+
+	affected, err := q.Exec(ctx)
+	return affected, c.error(err)
+}
+
+func (nr *notificationRepository) AllDetailed(
+	ctx context.Context,
+	after *model.Cursor,
+	limit int,
+	notificationFs ...*model.NotificationF,
+) ([]*model.DetailedNotification, error) {
+	q := nr.client.Notification.Query()
+	q = q.Where(nr.filters(notificationFs)...)
+	if after != nil {
+		q = q.Where(
+			Notification.Or(
+				Notification.CreatedAtLT(after.CreatedAt),
+				Notification.And(Notification.CreatedAt(after.CreatedAt), Notification.IDLT(after.ID)),
+			),
+		)
+	}
+
+	q = q.WithActor(func(q *ent.UserQuery) {
+		q.Select(
+			User.FieldID,
+			User.FieldDisplayName,
+			User.FieldUsername,
+			User.FieldProfilePicture,
+		)
+	}).
+		WithComment().
+		WithList().
+		WithReview().
+		Order(ent.Desc(Notification.FieldCreatedAt), ent.Desc(Notification.FieldID)).
+		Limit(limit)
+
+	notifications, err := q.All(ctx)
+	return nr.detailedNotifications(notifications), c.error(err)
+}
+
+func (nr *notificationRepository) filters(notificationFs []*model.NotificationF) []predicate.Notification {
+	var notificationF *model.NotificationF
+	if len(notificationFs) > 0 {
+		notificationF = notificationFs[0]
+	}
+	var filters []predicate.Notification
+	if notificationF != nil {
+		if notificationF.ID != nil {
+			filters = append(filters, Notification.ID(*notificationF.ID))
+		}
+		if notificationF.UserID != nil {
+			filters = append(filters, Notification.UserID(*notificationF.UserID))
+		}
+		if notificationF.ActorID != nil {
+			filters = append(filters, Notification.ActorID(*notificationF.ActorID))
+		}
+		if notificationF.Kind != nil {
+			filters = append(filters, Notification.KindEQ(Notification.Kind(*notificationF.Kind)))
+		}
+		if notificationF.CommentID != nil {
+			filters = append(filters, Notification.CommentID(*notificationF.CommentID))
+		}
+		if notificationF.ListID != nil {
+			filters = append(filters, Notification.ListID(*notificationF.ListID))
+		}
+		if notificationF.ReviewID != nil {
+			filters = append(filters, Notification.ReviewID(*notificationF.ReviewID))
+		}
+		if notificationF.Read != nil {
+			filters = append(filters, Notification.Read(*notificationF.Read))
+		}
+		if notificationF.CreatedAt != nil {
+			filters = append(filters, Notification.CreatedAt(*notificationF.CreatedAt))
+		}
+		if notificationF.IDIn != nil {
+			filters = append(filters, Notification.IDIn(*notificationF.IDIn...))
+		}
+	}
+	return filters
+}
+
+func (nr *notificationRepository) create(notification *model.Notification) *ent.NotificationCreate {
+	return nr.client.Notification.Create().
+		SetID(uuid.New()).
+		SetUserID(notification.UserID).
+		SetActorID(notification.ActorID).
+		SetKind(Notification.Kind(notification.Kind)).
+		SetNillableCommentID(notification.CommentID).
+		SetNillableListID(notification.ListID).
+		SetNillableReviewID(notification.ReviewID).
+		SetRead(false).
+		SetCreatedAt(time.Now())
+}
+
+func (nr *notificationRepository) createBulk(notifications []*model.Notification) *ent.NotificationCreateBulk {
+	builders := make([]*ent.NotificationCreate, 0, len(notifications))
+	for _, notification := range notifications {
+		builders = append(builders, nr.create(notification))
+	}
+	return nr.client.Notification.CreateBulk(builders...)
+}
+
+func (nr *notificationRepository) detailedNotifications(notifications []*ent.Notification) []*model.DetailedNotification {
+	detailedNotifications := make([]*model.DetailedNotification, 0, len(notifications))
+	for _, notification := range notifications {
+		detailedNotifications = append(detailedNotifications, &model.DetailedNotification{
+			Notification: c.notification(notification),
+			Actor:        c.user(notification.Edges.Actor),
+			Comment:      c.comment(notification.Edges.Comment),
+			List:         c.list(notification.Edges.List),
+			Review:       c.review(notification.Edges.Review),
+		})
+	}
+	return detailedNotifications
+}
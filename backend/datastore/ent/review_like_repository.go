@@ -0,0 +1,142 @@
+package ent
+
+import (
+	"cine/datastore/ent/ent"
+	"cine/datastore/ent/ent/predicate"
+	ReviewLike "cine/datastore/ent/ent/reviewlike"
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type reviewLikeRepository struct {
+	client *ent.Client
+}
+
+func newReviewLikeRepository(client *ent.Client) repository.ReviewLikeRepository {
+	return &reviewLikeRepository{client: client}
+}
+
+func (rl *reviewLikeRepository) One(ctx context.Context, reviewLikeFs ...*model.ReviewLikeF) (*model.ReviewLike, error) {
+	q := rl.client.ReviewLike.Query()
+	q = q.Where(rl.filters(reviewLikeFs)...)
+
+	like, err := q.First(ctx)
+	return c.reviewLike(like), c.error(err)
+}
+
+func (rl *reviewLikeRepository) All(ctx context.Context, reviewLikeFs ...*model.ReviewLikeF) ([]*model.ReviewLike, error) {
+	q := rl.client.ReviewLike.Query()
+	q = q.Where(rl.filters(reviewLikeFs)...)
+
+	likes, err := q.All(ctx)
+	return c.reviewLikes(likes), c.error(err)
+}
+
+func (rl *reviewLikeRepository) Exists(ctx context.Context, reviewLikeFs ...*model.ReviewLikeF) (bool, error) {
+	q := rl.client.ReviewLike.Query()
+	q = q.Where(rl.filters(reviewLikeFs)...)
+
+	exists, err := q.Exist(ctx)
+	return exists, c.error(err)
+}
+
+func (rl *reviewLikeRepository) Count(ctx context.Context, reviewLikeFs ...*model.ReviewLikeF) (int, error) {
+	q := rl.client.ReviewLike.Query()
+	q = q.Where(rl.filters(reviewLikeFs)...)
+
+	count, err := q.Count(ctx)
+	return count, c.error(err)
+}
+
+func (rl *reviewLikeRepository) Insert(ctx context.Context, like *model.ReviewLike) (*model.ReviewLike, error) {
+	i := rl.create(like)
+
+	iReviewLike, err := i.Save(ctx)
+	return c.reviewLike(iReviewLike), c.error(err)
+}
+
+func (rl *reviewLikeRepository) InsertBulk(ctx context.Context, likes []*model.ReviewLike) ([]*model.ReviewLike, error) {
+	i := rl.createBulk(likes)
+
+	iReviewLikes, err := i.Save(ctx)
+	return c.reviewLikes(iReviewLikes), c.error(err)
+}
+
+func (rl *reviewLikeRepository) Update(ctx context.Context, id uuid.UUID, _ *model.ReviewLikeU) (*model.ReviewLike, error) {
+	q := rl.client.ReviewLike.UpdateOneID(id)
+
+	q.SetUpdatedAt(time.Now())
+
+	like, err := q.Save(ctx)
+	return c.reviewLike(like), c.error(err)
+}
+
+func (rl *reviewLikeRepository) UpdateExec(ctx context.Context, _ *model.ReviewLikeU, reviewLikeFs ...*model.ReviewLikeF) (int, error) {
+	q := rl.client.ReviewLike.Update()
+	q = q.Where(rl.filters(reviewLikeFs)...)
+
+	q.SetUpdatedAt(time.Now())
+
+	affected, err := q.Save(ctx)
+	return affected, c.error(err)
+}
+
+func (rl *reviewLikeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	q := rl.client.ReviewLike.DeleteOneID(id)
+
+	err := q.Exec(ctx)
+	return c.error(err)
+}
+
+func (rl *reviewLikeRepository) DeleteExec(ctx context.Context, reviewLikeFs ...*model.ReviewLikeF) (int, error) {
+	q := rl.client.ReviewLike.Delete()
+	q = q.Where(rl.filters(reviewLikeFs)...)
+
+	affected, err := q.Exec(ctx)
+	return affected, c.error(err)
+}
+
+func (rl *reviewLikeRepository) filters(reviewLikeFs []*model.ReviewLikeF) []predicate.ReviewLike {
+	var reviewLikeF *model.ReviewLikeF
+	if len(reviewLikeFs) > 0 {
+		reviewLikeF = reviewLikeFs[0]
+	}
+	var filters []predicate.ReviewLike
+	if reviewLikeF != nil {
+		if reviewLikeF.ID != nil {
+			filters = append(filters, ReviewLike.ID(*reviewLikeF.ID))
+		}
+		if reviewLikeF.UserID != nil {
+			filters = append(filters, ReviewLike.UserID(*reviewLikeF.UserID))
+		}
+		if reviewLikeF.ReviewID != nil {
+			filters = append(filters, ReviewLike.ReviewID(*reviewLikeF.ReviewID))
+		}
+		if reviewLikeF.CreatedAt != nil {
+			filters = append(filters, ReviewLike.CreatedAt(*reviewLikeF.CreatedAt))
+		}
+		if reviewLikeF.UpdatedAt != nil {
+			filters = append(filters, ReviewLike.UpdatedAt(*reviewLikeF.UpdatedAt))
+		}
+	}
+	return filters
+}
+
+func (rl *reviewLikeRepository) create(like *model.ReviewLike) *ent.ReviewLikeCreate {
+	return rl.client.ReviewLike.Create().
+		SetID(uuid.New()).
+		SetUserID(like.UserID).
+		SetReviewID(like.ReviewID).
+		SetCreatedAt(time.Now())
+}
+
+func (rl *reviewLikeRepository) createBulk(likes []*model.ReviewLike) *ent.ReviewLikeCreateBulk {
+	builders := make([]*ent.ReviewLikeCreate, 0, len(likes))
+	for _, like := range likes {
+		builders = append(builders, rl.create(like))
+	}
+	return rl.client.ReviewLike.CreateBulk(builders...)
+}
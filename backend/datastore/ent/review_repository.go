@@ -2,12 +2,15 @@ package ent
 
 import (
 	"cine/datastore/ent/ent"
+	Comment "cine/datastore/ent/ent/comment"
 	"cine/datastore/ent/ent/predicate"
 	Review "cine/datastore/ent/ent/review"
+	ReviewLike "cine/datastore/ent/ent/reviewlike"
 	User "cine/datastore/ent/ent/user"
 	"cine/entity/model"
 	"cine/repository"
 	"context"
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"time"
 )
@@ -71,7 +74,9 @@ func (rr *reviewRepository) Update(ctx context.Context, id uuid.UUID, reviewU *m
 
 	q.SetUpdatedAt(time.Now())
 	q.SetNillableContent(reviewU.Content)
+	q.SetNillableSpoiler(reviewU.Spoiler)
 	q.SetNillableRating(reviewU.Rating)
+	q.SetNillableDeletedAt(reviewU.DeletedAt)
 
 	review, err := q.Save(ctx)
 	return c.review(review), c.error(err)
@@ -83,7 +88,9 @@ func (rr *reviewRepository) UpdateExec(ctx context.Context, reviewU *model.Revie
 
 	q.SetUpdatedAt(time.Now())
 	q.SetNillableContent(reviewU.Content)
+	q.SetNillableSpoiler(reviewU.Spoiler)
 	q.SetNillableRating(reviewU.Rating)
+	q.SetNillableDeletedAt(reviewU.DeletedAt)
 
 	affected, err := q.Save(ctx)
 	return affected, c.error(err)
@@ -104,9 +111,89 @@ func (rr *reviewRepository) DeleteExec(ctx context.Context, reviewFs ...*model.R
 	return affected, c.error(err)
 }
 
-func (rr *reviewRepository) AllWithUser(ctx context.Context, reviewFs ...*model.ReviewF) ([]*model.DetailedReview, error) {
+// DeleteTombstones hard deletes the tombstones of deleted reviews that have no comments left in their thread.
+func (rr *reviewRepository) DeleteTombstones(ctx context.Context) (int, error) {
+	q := rr.client.Review.Delete()
+	q = q.Where(
+		Review.DeletedAtNotNil(),
+		Review.Not(Review.HasComments()),
+	)
+
+	affected, err := q.Exec(ctx)
+	return affected, c.error(err)
+}
+
+// AllDetailed pages through the reviews in the given sort, along with their author, media,
+// likes and replies. viewerID is who liked_by_user is about.
+func (rr *reviewRepository) AllDetailed(
+	ctx context.Context,
+	viewerID uuid.UUID,
+	sort model.ReviewSort,
+	after *model.RankedCursor,
+	limit int,
+	reviewFs ...*model.ReviewF,
+) ([]*model.DetailedReview, error) {
 	q := rr.client.Review.Query()
-	q = q.Where(rr.filters(reviewFs)...).
+	q = q.Where(rr.filters(reviewFs)...)
+	if after != nil {
+		q = q.Where(rr.after(sort, after))
+	}
+
+	q = rr.withDetails(q).
+		WithMedia().
+		Order(rr.order(sort)...).
+		Limit(limit)
+
+	reviews, err := q.All(ctx)
+	return rr.detailedReviews(reviews, viewerID), c.error(err)
+}
+
+// Summary aggregates the ratings of the reviews, the average and count in one query and the histogram in another.
+func (rr *reviewRepository) Summary(ctx context.Context, reviewFs ...*model.ReviewF) (*model.ReviewSummary, error) {
+	var totals []struct {
+		Average *float64 `json:"average"`
+		Count   int      `json:"count"`
+	}
+	err := rr.client.Review.Query().
+		Where(rr.filters(reviewFs)...).
+		Aggregate(ent.As(ent.Mean(Review.FieldRating), "average"), ent.As(ent.Count(), "count")).
+		Scan(ctx, &totals)
+	if err != nil {
+		return nil, c.error(err)
+	}
+
+	var buckets []struct {
+		Rating int `json:"rating"`
+		Count  int `json:"count"`
+	}
+	err = rr.client.Review.Query().
+		Where(rr.filters(reviewFs)...).
+		GroupBy(Review.FieldRating).
+		Aggregate(ent.Count()).
+		Scan(ctx, &buckets)
+	if err != nil {
+		return nil, c.error(err)
+	}
+
+	summary := &model.ReviewSummary{}
+	if len(totals) > 0 {
+		summary.Count = totals[0].Count
+		// AVG is null when there is nothing to average
+		if totals[0].Average != nil {
+			summary.Average = *totals[0].Average
+		}
+	}
+	for _, bucket := range buckets {
+		if bucket.Rating >= 1 && bucket.Rating <= len(summary.Histogram) {
+			summary.Histogram[bucket.Rating-1] = bucket.Count
+		}
+	}
+	return summary, nil
+}
+
+// withDetails loads the author, likes and top level replies of the reviews.
+func (rr *reviewRepository) withDetails(q *ent.ReviewQuery) *ent.ReviewQuery {
+	return q.
 		WithUser(func(q *ent.UserQuery) {
 			q.Select(
 				User.FieldID,
@@ -114,10 +201,59 @@ func (rr *reviewRepository) AllWithUser(ctx context.Context, reviewFs ...*model.
 				User.FieldUsername,
 				User.FieldProfilePicture,
 			)
+		}).
+		WithLikes(func(q *ent.ReviewLikeQuery) {
+			q.Select(ReviewLike.FieldUserID)
+		}).
+		WithComments(func(q *ent.CommentQuery) {
+			q.Where(Comment.Not(Comment.HasReplyingTo())).Select(Comment.FieldID)
 		})
+}
 
-	reviews, err := q.All(ctx)
-	return rr.detailedReviews(reviews), c.error(err)
+// order is the ordering of sort, ties always being broken newest first so pages are stable.
+func (rr *reviewRepository) order(sort model.ReviewSort) []Review.OrderOption {
+	newest := []Review.OrderOption{ent.Desc(Review.FieldCreatedAt), ent.Desc(Review.FieldID)}
+	switch sort {
+	case model.ReviewSortHighest:
+		return append([]Review.OrderOption{ent.Desc(Review.FieldRating)}, newest...)
+	case model.ReviewSortLowest:
+		return append([]Review.OrderOption{ent.Asc(Review.FieldRating)}, newest...)
+	case model.ReviewSortLiked:
+		return append([]Review.OrderOption{Review.ByLikesCount(sql.OrderDesc())}, newest...)
+	default:
+		return newest
+	}
+}
+
+// after matches the reviews that come after the cursor in the ordering of sort.
+func (rr *reviewRepository) after(sort model.ReviewSort, after *model.RankedCursor) predicate.Review {
+	older := Review.Or(
+		Review.CreatedAtLT(after.CreatedAt),
+		Review.And(Review.CreatedAt(after.CreatedAt), Review.IDLT(after.ID)),
+	)
+	switch sort {
+	case model.ReviewSortHighest:
+		return Review.Or(Review.RatingLT(after.Rank), Review.And(Review.Rating(after.Rank), older))
+	case model.ReviewSortLowest:
+		return Review.Or(Review.RatingGT(after.Rank), Review.And(Review.Rating(after.Rank), older))
+	case model.ReviewSortLiked:
+		return Review.Or(rr.likesCount(sql.OpLT, after.Rank), Review.And(rr.likesCount(sql.OpEQ, after.Rank), older))
+	default:
+		return older
+	}
+}
+
+// likesCount compares the number of likes of the review to count with op.
+func (rr *reviewRepository) likesCount(op sql.Op, count int) predicate.Review {
+	return func(s *sql.Selector) {
+		t := sql.Table(ReviewLike.Table)
+		likes := sql.Select(sql.Count("*")).
+			From(t).
+			Where(sql.ColumnsEQ(t.C(ReviewLike.FieldReviewID), s.C(Review.FieldID)))
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Wrap(func(b *sql.Builder) { b.Join(likes) }).WriteOp(op).Arg(count)
+		}))
+	}
 }
 
 func (rr *reviewRepository) filters(reviewFs []*model.ReviewF) []predicate.Review {
@@ -139,9 +275,23 @@ func (rr *reviewRepository) filters(reviewFs []*model.ReviewF) []predicate.Revie
 		if reviewF.Content != nil {
 			filters = append(filters, Review.Content(*reviewF.Content))
 		}
+		if reviewF.Spoiler != nil {
+			filters = append(filters, Review.Spoiler(*reviewF.Spoiler))
+		}
 		if reviewF.Rating != nil {
 			filters = append(filters, Review.Rating(*reviewF.Rating))
 		}
+		if reviewF.Deleted != nil {
+			if *reviewF.Deleted {
+				filters = append(filters, Review.DeletedAtNotNil())
+			} else {
+				filters = append(filters, Review.DeletedAtIsNil())
+			}
+		}
+		if reviewF.VisibleTo != nil {
+			filters = append(filters, Review.Not(Review.HasUserWith(hiddenFrom(*reviewF.VisibleTo))))
+			filters = append(filters, Review.HasUserWith(visibleTo(*reviewF.VisibleTo)))
+		}
 		if reviewF.CreatedAt != nil {
 			filters = append(filters, Review.CreatedAt(*reviewF.CreatedAt))
 		}
@@ -158,6 +308,7 @@ func (rr *reviewRepository) create(review *model.Review) *ent.ReviewCreate {
 		SetUserID(review.UserID).
 		SetMediaID(review.MediaID).
 		SetContent(review.Content).
+		SetSpoiler(review.Spoiler).
 		SetRating(review.Rating).
 		SetCreatedAt(time.Now())
 }
@@ -170,13 +321,28 @@ func (rr *reviewRepository) createBulk(reviews []*model.Review) *ent.ReviewCreat
 	return rr.client.Review.CreateBulk(builders...)
 }
 
-func (rr *reviewRepository) detailedReviews(reviews []*ent.Review) []*model.DetailedReview {
+func (rr *reviewRepository) detailedReviews(reviews []*ent.Review, viewerID uuid.UUID) []*model.DetailedReview {
 	detailedReviews := make([]*model.DetailedReview, 0, len(reviews))
 	for _, review := range reviews {
 		detailedReviews = append(detailedReviews, &model.DetailedReview{
-			Review: c.review(review),
-			User:   c.user(review.Edges.User),
+			Review:       c.review(review),
+			User:         c.user(review.Edges.User),
+			Media:        c.media(review.Edges.Media),
+			RepliesCount: len(review.Edges.Comments),
+			LikesCount:   len(review.Edges.Likes),
+			LikedByUser:  rr.likedByUser(review, viewerID),
 		})
 	}
 	return detailedReviews
 }
+
+func (rr *reviewRepository) likedByUser(review *ent.Review, viewerID uuid.UUID) bool {
+	if viewerID != uuid.Nil {
+		for _, like := range review.Edges.Likes {
+			if like.UserID == viewerID {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+package ent
+
+import (
+	"cine/datastore/ent/ent"
+	"cine/datastore/ent/ent/predicate"
+	Revision "cine/datastore/ent/ent/revision"
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type revisionRepository struct {
+	client *ent.Client
+}
+
+func newRevisionRepository(client *ent.Client) repository.RevisionRepository {
+	return &revisionRepository{client: client}
+}
+
+func (rr *revisionRepository) One(ctx context.Context, revisionFs ...*model.RevisionF) (*model.Revision, error) {
+	q := rr.client.Revision.Query()
+	q = q.Where(rr.filters(revisionFs)...)
+
+	revision, err := q.First(ctx)
+	return c.revision(revision), c.error(err)
+}
+
+func (rr *revisionRepository) All(ctx context.Context, revisionFs ...*model.RevisionF) ([]*model.Revision, error) {
+	q := rr.client.Revision.Query()
+	q = q.Where(rr.filters(revisionFs)...).
+		Order(ent.Desc(Revision.FieldCreatedAt), ent.Desc(Revision.FieldID))
+
+	revisions, err := q.All(ctx)
+	return c.revisions(revisions), c.error(err)
+}
+
+func (rr *revisionRepository) Exists(ctx context.Context, revisionFs ...*model.RevisionF) (bool, error) {
+	q := rr.client.Revision.Query()
+	q = q.Where(rr.filters(revisionFs)...)
+
+	exists, err := q.Exist(ctx)
+	return exists, c.error(err)
+}
+
+func (rr *revisionRepository) Count(ctx context.Context, revisionFs ...*model.RevisionF) (int, error) {
+	q := rr.client.Revision.Query()
+	q = q.Where(rr.filters(revisionFs)...)
+
+	count, err := q.Count(ctx)
+	return count, c.error(err)
+}
+
+func (rr *revisionRepository) Insert(ctx context.Context, revision *model.Revision) (*model.Revision, error) {
+	i := rr.create(revision)
+
+	iRevision, err := i.Save(ctx)
+	return c.revision(iRevision), c.error(err)
+}
+
+func (rr *revisionRepository) InsertBulk(ctx context.Context, revisions []*model.Revision) ([]*model.Revision, error) {
+	i := rr.createBulk(revisions)
+
+	iRevisions, err := i.Save(ctx)
+	return c.revisions(iRevisions), c.error(err)
+}
+
+func (rr *revisionRepository) Update(ctx context.Context, id uuid.UUID, _ *model.RevisionU) (*model.Revision, error) {
+	q := rr.client.Revision.UpdateOneID(id)
+
+	q.SetUpdatedAt(time.Now())
+
+	revision, err := q.Save(ctx)
+	return c.revision(revision), c.error(err)
+}
+
+func (rr *revisionRepository) UpdateExec(ctx context.Context, _ *model.RevisionU, revisionFs ...*model.RevisionF) (int, error) {
+	q := rr.client.Revision.Update()
+	q = q.Where(rr.filters(revisionFs)...)
+
+	q.SetUpdatedAt(time.Now())
+
+	affected, err := q.Save(ctx)
+	return affected, c.error(err)
+}
+
+func (rr *revisionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	q := rr.client.Revision.DeleteOneID(id)
+
+	err := q.Exec(ctx)
+	return c.error(err)
+}
+
+func (rr *revisionRepository) DeleteExec(ctx context.Context, revisionFs ...*model.RevisionF) (int, error) {
+	q := rr.client.Revision.Delete()
+	q = q.Where(rr.filters(revisionFs)...)
+
+	affected, err := q.Exec(ctx)
+	return affected, c.error(err)
+}
+
+func (rr *revisionRepository) filters(revisionFs []*model.RevisionF) []predicate.Revision {
+	var revisionF *model.RevisionF
+	if len(revisionFs) > 0 {
+		revisionF = revisionFs[0]
+	}
+	var filters []predicate.Revision
+	if revisionF != nil {
+		if revisionF.ID != nil {
+			filters = append(filters, Revision.ID(*revisionF.ID))
+		}
+		if revisionF.CommentID != nil {
+			filters = append(filters, Revision.CommentID(*revisionF.CommentID))
+		}
+		if revisionF.ReviewID != nil {
+			filters = append(filters, Revision.ReviewID(*revisionF.ReviewID))
+		}
+		if revisionF.CreatedAt != nil {
+			filters = append(filters, Revision.CreatedAt(*revisionF.CreatedAt))
+		}
+		if revisionF.UpdatedAt != nil {
+			filters = append(filters, Revision.UpdatedAt(*revisionF.UpdatedAt))
+		}
+	}
+	return filters
+}
+
+func (rr *revisionRepository) create(revision *model.Revision) *ent.RevisionCreate {
+	return rr.client.Revision.Create().
+		SetID(uuid.New()).
+		SetNillableCommentID(revision.CommentID).
+		SetNillableReviewID(revision.ReviewID).
+		SetContent(revision.Content).
+		SetNillableRating(revision.Rating).
+		SetSpoiler(revision.Spoiler).
+		SetCreatedAt(time.Now())
+}
+
+func (rr *revisionRepository) createBulk(revisions []*model.Revision) *ent.RevisionCreateBulk {
+	builders := make([]*ent.RevisionCreate, 0, len(revisions))
+	for _, revision := range revisions {
+		builders = append(builders, rr.create(revision))
+	}
+	return rr.client.Revision.CreateBulk(builders...)
+}
@@ -10,14 +10,22 @@ import (
 )
 
 type transaction struct {
-	tx          *ent.Tx
-	userRepo    repository.UserRepository
-	sessionRepo repository.SessionRepository
-	commentRepo repository.CommentRepository
-	likeRepo    repository.LikeRepository
-	reviewRepo  repository.ReviewRepository
-	mediaRepo   repository.MediaRepository
-	listRepo    repository.ListRepository
+	tx                  *ent.Tx
+	userRepo            repository.UserRepository
+	sessionRepo         repository.SessionRepository
+	commentRepo         repository.CommentRepository
+	likeRepo            repository.LikeRepository
+	reviewRepo          repository.ReviewRepository
+	mediaRepo           repository.MediaRepository
+	listRepo            repository.ListRepository
+	episodeProgressRepo repository.EpisodeProgressRepository
+	diaryEntryRepo      repository.DiaryEntryRepository
+	watchItemRepo       repository.WatchItemRepository
+	activityRepo        repository.ActivityRepository
+	notificationRepo    repository.NotificationRepository
+	followRequestRepo   repository.FollowRequestRepository
+	reviewLikeRepo      repository.ReviewLikeRepository
+	revisionRepo        repository.RevisionRepository
 }
 
 func (s *store) Transaction(ctx context.Context) (datastore.Transaction, error) {
@@ -27,14 +35,22 @@ func (s *store) Transaction(ctx context.Context) (datastore.Transaction, error)
 	}
 	client := tx.Client()
 	return &transaction{
-		tx:          tx,
-		userRepo:    newUserRepository(client),
-		sessionRepo: newSessionRepository(client),
-		commentRepo: newCommentRepository(client),
-		likeRepo:    newLikeRepository(client),
-		reviewRepo:  newReviewRepository(client),
-		mediaRepo:   newMediaRepository(client),
-		listRepo:    newListRepository(client),
+		tx:                  tx,
+		userRepo:            newUserRepository(client),
+		sessionRepo:         newSessionRepository(client),
+		commentRepo:         newCommentRepository(client),
+		likeRepo:            newLikeRepository(client),
+		reviewRepo:          newReviewRepository(client),
+		mediaRepo:           newMediaRepository(client),
+		listRepo:            newListRepository(client),
+		episodeProgressRepo: newEpisodeProgressRepository(client),
+		diaryEntryRepo:      newDiaryEntryRepository(client),
+		watchItemRepo:       newWatchItemRepository(client),
+		activityRepo:        newActivityRepository(client),
+		notificationRepo:    newNotificationRepository(client),
+		followRequestRepo:   newFollowRequestRepository(client),
+		reviewLikeRepo:      newReviewLikeRepository(client),
+		revisionRepo:        newRevisionRepository(client),
 	}, nil
 }
 
@@ -45,6 +61,24 @@ func (t *transaction) Likes() repository.LikeRepository       { return t.likeRep
 func (t *transaction) Reviews() repository.ReviewRepository   { return t.reviewRepo }
 func (t *transaction) Medias() repository.MediaRepository     { return t.mediaRepo }
 func (t *transaction) Lists() repository.ListRepository       { return t.listRepo }
+func (t *transaction) EpisodeProgresses() repository.EpisodeProgressRepository {
+	return t.episodeProgressRepo
+}
+func (t *transaction) DiaryEntries() repository.DiaryEntryRepository { return t.diaryEntryRepo }
+func (t *transaction) WatchItems() repository.WatchItemRepository    { return t.watchItemRepo }
+func (t *transaction) Activities() repository.ActivityRepository     { return t.activityRepo }
+func (t *transaction) Notifications() repository.NotificationRepository {
+	return t.notificationRepo
+}
+func (t *transaction) FollowRequests() repository.FollowRequestRepository {
+	return t.followRequestRepo
+}
+func (t *transaction) ReviewLikes() repository.ReviewLikeRepository {
+	return t.reviewLikeRepo
+}
+func (t *transaction) Revisions() repository.RevisionRepository {
+	return t.revisionRepo
+}
 
 func (t *transaction) Commit() error {
 	err := t.tx.Commit()
@@ -3,6 +3,8 @@ package ent
 import (
 	"cine/datastore/ent/ent"
 	Comment "cine/datastore/ent/ent/comment"
+	Follow "cine/datastore/ent/ent/follow"
+	FollowRequest "cine/datastore/ent/ent/followrequest"
 	Like "cine/datastore/ent/ent/like"
 	List "cine/datastore/ent/ent/list"
 	"cine/datastore/ent/ent/predicate"
@@ -11,6 +13,7 @@ import (
 	"cine/entity/model"
 	"cine/repository"
 	"context"
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"time"
 )
@@ -78,6 +81,15 @@ func (ur *userRepository) Update(ctx context.Context, id uuid.UUID, userU *model
 	q.SetNillableEmail(userU.Email)
 	q.SetNillablePassword(userU.Password)
 	q.SetNillableProfilePicture(userU.ProfilePicture)
+	q.SetNillableRegion(userU.Region)
+	if userU.Services != nil {
+		q.SetStreamingServices(*userU.Services)
+	}
+	if userU.MutedNotifications != nil {
+		q.SetMutedNotifications(c.notificationKindStrings(*userU.MutedNotifications))
+	}
+	q.SetNillablePrivate(userU.Private)
+	q.SetNillableHideSpoilers(userU.HideSpoilers)
 
 	user, err := q.Save(ctx)
 	return c.user(user), c.error(err)
@@ -93,6 +105,15 @@ func (ur *userRepository) UpdateExec(ctx context.Context, userU *model.UserU, us
 	q.SetNillableEmail(userU.Email)
 	q.SetNillablePassword(userU.Password)
 	q.SetNillableProfilePicture(userU.ProfilePicture)
+	q.SetNillableRegion(userU.Region)
+	if userU.Services != nil {
+		q.SetStreamingServices(*userU.Services)
+	}
+	if userU.MutedNotifications != nil {
+		q.SetMutedNotifications(c.notificationKindStrings(*userU.MutedNotifications))
+	}
+	q.SetNillablePrivate(userU.Private)
+	q.SetNillableHideSpoilers(userU.HideSpoilers)
 
 	affected, err := q.Save(ctx)
 	return affected, c.error(err)
@@ -121,7 +142,10 @@ func (ur *userRepository) OneDetailed(ctx context.Context, id, userID uuid.UUID)
 		WithFollowing(func(q *ent.UserQuery) { q.Select(User.FieldID) }).
 		WithLikes(func(q *ent.LikeQuery) { q.Select(Like.FieldID) }).
 		WithLists(func(q *ent.ListQuery) { q.Select(List.FieldID) }).
-		WithReviews(func(q *ent.ReviewQuery) { q.Select(Review.FieldID) })
+		WithReviews(func(q *ent.ReviewQuery) { q.Where(Review.DeletedAtIsNil()).Select(Review.FieldID) }).
+		WithBlockedBy(func(q *ent.UserQuery) { q.Where(User.ID(userID)).Select(User.FieldID) }).
+		WithMutedBy(func(q *ent.UserQuery) { q.Where(User.ID(userID)).Select(User.FieldID) }).
+		WithFollowRequests(func(q *ent.FollowRequestQuery) { q.Where(FollowRequest.RequesterID(userID)) })
 
 	user, err := q.First(ctx)
 	return ur.detailedUser(user, userID), c.error(err)
@@ -182,6 +206,142 @@ func (ur *userRepository) AllFollowers(ctx context.Context, user *model.User) ([
 	return c.users(followers), c.error(err)
 }
 
+func (ur *userRepository) AllFollowedDetailed(ctx context.Context, id, viewerID uuid.UUID, after *model.Cursor, limit int) ([]*model.DetailedFollow, error) {
+	q := ur.client.Follow.Query()
+	q = q.Where(Follow.UserID(id)).
+		WithFollowing().
+		Order(ent.Desc(Follow.FieldCreatedAt), ent.Desc(Follow.FieldFollowerID)).
+		Limit(limit)
+	if after != nil {
+		q = q.Where(Follow.Or(
+			Follow.CreatedAtLT(after.CreatedAt),
+			Follow.And(Follow.CreatedAt(after.CreatedAt), predicate.Follow(sql.FieldLT(Follow.FieldFollowerID, after.ID))),
+		))
+	}
+
+	follows, err := q.All(ctx)
+	if err != nil {
+		return nil, c.error(err)
+	}
+
+	users := make([]*ent.User, 0, len(follows))
+	for _, follow := range follows {
+		users = append(users, follow.Edges.Following)
+	}
+	return ur.detailedFollows(ctx, follows, users, viewerID)
+}
+
+func (ur *userRepository) AllFollowersDetailed(ctx context.Context, id, viewerID uuid.UUID, after *model.Cursor, limit int) ([]*model.DetailedFollow, error) {
+	q := ur.client.Follow.Query()
+	q = q.Where(Follow.FollowerID(id)).
+		WithUser().
+		Order(ent.Desc(Follow.FieldCreatedAt), ent.Desc(Follow.FieldUserID)).
+		Limit(limit)
+	if after != nil {
+		q = q.Where(Follow.Or(
+			Follow.CreatedAtLT(after.CreatedAt),
+			Follow.And(Follow.CreatedAt(after.CreatedAt), predicate.Follow(sql.FieldLT(Follow.FieldUserID, after.ID))),
+		))
+	}
+
+	follows, err := q.All(ctx)
+	if err != nil {
+		return nil, c.error(err)
+	}
+
+	users := make([]*ent.User, 0, len(follows))
+	for _, follow := range follows {
+		users = append(users, follow.Edges.User)
+	}
+	return ur.detailedFollows(ctx, follows, users, viewerID)
+}
+
+func (ur *userRepository) Blocked(ctx context.Context, userID, otherID uuid.UUID) (bool, error) {
+	q := ur.client.User.Query()
+	q = q.Where(
+		User.ID(userID),
+		User.Or(User.HasBlockingWith(User.ID(otherID)), User.HasBlockedByWith(User.ID(otherID))),
+	)
+
+	blocked, err := q.Exist(ctx)
+	return blocked, c.error(err)
+}
+
+func (ur *userRepository) BlockUser(ctx context.Context, user *model.User, blockedID uuid.UUID) error {
+	q := ur.client.User.UpdateOneID(user.ID)
+	q = q.AddBlockingIDs(blockedID).
+		RemoveFollowingIDs(blockedID).
+		RemoveFollowerIDs(blockedID)
+
+	_, err := q.Save(ctx)
+	return c.error(err)
+}
+
+func (ur *userRepository) UnblockUser(ctx context.Context, user *model.User, blockedID uuid.UUID) error {
+	q := ur.client.User.UpdateOneID(user.ID)
+	q = q.RemoveBlockingIDs(blockedID)
+
+	_, err := q.Save(ctx)
+	return c.error(err)
+}
+
+func (ur *userRepository) AllBlocked(ctx context.Context, user *model.User) ([]*model.User, error) {
+	q := ur.client.User.Query()
+	q = q.Where(User.ID(user.ID)).
+		QueryBlocking()
+
+	blocked, err := q.All(ctx)
+	return c.users(blocked), c.error(err)
+}
+
+func (ur *userRepository) MuteUser(ctx context.Context, user *model.User, mutedID uuid.UUID) error {
+	q := ur.client.User.UpdateOneID(user.ID)
+	q = q.AddMutingIDs(mutedID)
+
+	_, err := q.Save(ctx)
+	return c.error(err)
+}
+
+func (ur *userRepository) UnmuteUser(ctx context.Context, user *model.User, mutedID uuid.UUID) error {
+	q := ur.client.User.UpdateOneID(user.ID)
+	q = q.RemoveMutingIDs(mutedID)
+
+	_, err := q.Save(ctx)
+	return c.error(err)
+}
+
+func (ur *userRepository) AllMuted(ctx context.Context, user *model.User) ([]*model.User, error) {
+	q := ur.client.User.Query()
+	q = q.Where(User.ID(user.ID)).
+		QueryMuting()
+
+	muted, err := q.All(ctx)
+	return c.users(muted), c.error(err)
+}
+
+func (ur *userRepository) CanView(ctx context.Context, viewerID, userID uuid.UUID) (bool, error) {
+	q := ur.client.User.Query()
+	q = q.Where(User.ID(userID), visibleTo(viewerID))
+
+	visible, err := q.Exist(ctx)
+	return visible, c.error(err)
+}
+
+// Search matches query against usernames and display names, case-insensitively. Users the viewer muted,
+// or who are on either side of a block with them, are left out.
+func (ur *userRepository) Search(ctx context.Context, viewerID uuid.UUID, query string, limit int) ([]*model.User, error) {
+	q := ur.client.User.Query()
+	q = q.Where(
+		User.Or(User.UsernameContainsFold(query), User.DisplayNameContainsFold(query)),
+		User.Not(hiddenFrom(viewerID)),
+	).
+		Order(ent.Asc(User.FieldUsername)).
+		Limit(limit)
+
+	users, err := q.All(ctx)
+	return c.users(users), c.error(err)
+}
+
 func (ur *userRepository) filters(userFs []*model.UserF) []predicate.User {
 	var userF *model.UserF
 	if len(userFs) > 0 {
@@ -213,6 +373,9 @@ func (ur *userRepository) filters(userFs []*model.UserF) []predicate.User {
 		if userF.UpdatedAt != nil {
 			filters = append(filters, User.UpdatedAt(*userF.UpdatedAt))
 		}
+		if userF.HiddenFrom != nil {
+			filters = append(filters, hiddenFrom(*userF.HiddenFrom))
+		}
 	}
 	return filters
 }
@@ -225,6 +388,11 @@ func (ur *userRepository) create(user *model.User) *ent.UserCreate {
 		SetEmail(user.Email).
 		SetPassword(user.Password).
 		SetProfilePicture(user.ProfilePicture).
+		SetNillableRegion(user.Region).
+		SetStreamingServices(user.Services).
+		SetMutedNotifications(c.notificationKindStrings(user.MutedNotifications)).
+		SetPrivate(user.Private).
+		SetHideSpoilers(user.HideSpoilers).
 		SetCreatedAt(time.Now())
 }
 
@@ -246,7 +414,74 @@ func (ur *userRepository) detailedUser(user *ent.User, userID uuid.UUID) *model.
 		ReviewsCount:   len(user.Edges.Reviews),
 		ListsCount:     len(user.Edges.Lists),
 		Followed:       ur.followed(user.Edges.Followers, userID),
+		Blocked:        len(user.Edges.BlockedBy) > 0,
+		Muted:          len(user.Edges.MutedBy) > 0,
+		Requested:      len(user.Edges.FollowRequests) > 0,
+	}
+}
+
+// detailedFollows relates users, listed by follows, to the viewer. Both directions are
+// looked up for the whole page in a single query rather than once per user.
+func (ur *userRepository) detailedFollows(ctx context.Context, follows []*ent.Follow, users []*ent.User, viewerID uuid.UUID) ([]*model.DetailedFollow, error) {
+	ids := make([]uuid.UUID, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.ID)
+	}
+
+	followed := make(map[uuid.UUID]bool)
+	followsViewer := make(map[uuid.UUID]bool)
+	if len(ids) > 0 {
+		q := ur.client.Follow.Query()
+		q = q.Where(Follow.Or(
+			Follow.And(Follow.UserID(viewerID), Follow.FollowerIDIn(ids...)),
+			Follow.And(Follow.UserIDIn(ids...), Follow.FollowerID(viewerID)),
+		))
+
+		relations, err := q.All(ctx)
+		if err != nil {
+			return nil, c.error(err)
+		}
+		for _, relation := range relations {
+			if relation.UserID == viewerID {
+				followed[relation.FollowerID] = true
+			} else {
+				followsViewer[relation.UserID] = true
+			}
+		}
+	}
+
+	result := make([]*model.DetailedFollow, 0, len(follows))
+	for i, follow := range follows {
+		id := users[i].ID
+		result = append(result, &model.DetailedFollow{
+			User:          c.user(users[i]),
+			FollowedAt:    follow.CreatedAt,
+			Followed:      followed[id],
+			FollowsViewer: followsViewer[id],
+			Mutual:        followed[id] && followsViewer[id],
+		})
 	}
+	return result, nil
+}
+
+// hiddenFrom matches the users whose content the viewer doesn't see: the users they muted,
+// and the users on either side of a block with them.
+func hiddenFrom(viewerID uuid.UUID) predicate.User {
+	return User.Or(
+		User.HasMutedByWith(User.ID(viewerID)),
+		User.HasBlockedByWith(User.ID(viewerID)),
+		User.HasBlockingWith(User.ID(viewerID)),
+	)
+}
+
+// visibleTo matches the users whose reviews, lists and activity the viewer may see: public accounts,
+// the viewer's own, and private accounts the viewer was approved to follow.
+func visibleTo(viewerID uuid.UUID) predicate.User {
+	return User.Or(
+		User.Private(false),
+		User.ID(viewerID),
+		User.HasFollowersWith(User.ID(viewerID)),
+	)
 }
 
 func (ur *userRepository) followed(followers []*ent.User, userID uuid.UUID) bool {
@@ -0,0 +1,197 @@
+package ent
+
+import (
+	"cine/datastore/ent/ent"
+	Media "cine/datastore/ent/ent/media"
+	"cine/datastore/ent/ent/predicate"
+	WatchItem "cine/datastore/ent/ent/watchitem"
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type watchItemRepository struct {
+	client *ent.Client
+}
+
+func newWatchItemRepository(client *ent.Client) repository.WatchItemRepository {
+	return &watchItemRepository{client: client}
+}
+
+func (wir *watchItemRepository) One(ctx context.Context, itemFs ...*model.WatchItemF) (*model.WatchItem, error) {
+	q := wir.client.WatchItem.Query()
+	q = q.Where(wir.filters(itemFs)...)
+
+	item, err := q.First(ctx)
+	return c.watchItem(item), c.error(err)
+}
+
+func (wir *watchItemRepository) All(ctx context.Context, itemFs ...*model.WatchItemF) ([]*model.WatchItem, error) {
+	q := wir.client.WatchItem.Query()
+	q = q.Where(wir.filters(itemFs)...).
+		Order(ent.Desc(WatchItem.FieldPriority), ent.Desc(WatchItem.FieldAddedAt))
+
+	items, err := q.All(ctx)
+	return c.watchItems(items), c.error(err)
+}
+
+func (wir *watchItemRepository) Exists(ctx context.Context, itemFs ...*model.WatchItemF) (bool, error) {
+	q := wir.client.WatchItem.Query()
+	q = q.Where(wir.filters(itemFs)...)
+
+	exists, err := q.Exist(ctx)
+	return exists, c.error(err)
+}
+
+func (wir *watchItemRepository) Count(ctx context.Context, itemFs ...*model.WatchItemF) (int, error) {
+	q := wir.client.WatchItem.Query()
+	q = q.Where(wir.filters(itemFs)...)
+
+	count, err := q.Count(ctx)
+	return count, c.error(err)
+}
+
+func (wir *watchItemRepository) Insert(ctx context.Context, item *model.WatchItem) (*model.WatchItem, error) {
+	i := wir.create(item)
+
+	iItem, err := i.Save(ctx)
+	return c.watchItem(iItem), c.error(err)
+}
+
+func (wir *watchItemRepository) InsertBulk(ctx context.Context, items []*model.WatchItem) ([]*model.WatchItem, error) {
+	i := wir.createBulk(items)
+
+	iItems, err := i.Save(ctx)
+	return c.watchItems(iItems), c.error(err)
+}
+
+func (wir *watchItemRepository) Update(ctx context.Context, id uuid.UUID, itemU *model.WatchItemU) (*model.WatchItem, error) {
+	q := wir.client.WatchItem.UpdateOneID(id)
+
+	q.SetUpdatedAt(time.Now())
+	if itemU.Status != nil {
+		q.SetStatus(WatchItem.Status(*itemU.Status))
+	}
+	q.SetNillablePriority(itemU.Priority)
+	q.SetNillableAddedAt(itemU.AddedAt)
+
+	item, err := q.Save(ctx)
+	return c.watchItem(item), c.error(err)
+}
+
+func (wir *watchItemRepository) UpdateExec(ctx context.Context, itemU *model.WatchItemU, itemFs ...*model.WatchItemF) (int, error) {
+	q := wir.client.WatchItem.Update()
+	q = q.Where(wir.filters(itemFs)...)
+
+	q.SetUpdatedAt(time.Now())
+	if itemU.Status != nil {
+		q.SetStatus(WatchItem.Status(*itemU.Status))
+	}
+	q.SetNillablePriority(itemU.Priority)
+	q.SetNillableAddedAt(itemU.AddedAt)
+
+	affected, err := q.Save(ctx)
+	return affected, c.error(err)
+}
+
+func (wir *watchItemRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	q := wir.client.WatchItem.DeleteOneID(id)
+
+	err := q.Exec(ctx)
+	return c.error(err)
+}
+
+func (wir *watchItemRepository) DeleteExec(ctx context.Context, itemFs ...*model.WatchItemF) (int, error) {
+	q := wir.client.WatchItem.Delete()
+	q = q.Where(wir.filters(itemFs)...)
+
+	affected, err := q.Exec(ctx)
+	return affected, c.error(err)
+}
+
+func (wir *watchItemRepository) AllWithMedia(ctx context.Context, itemFs ...*model.WatchItemF) ([]*model.DetailedWatchItem, error) {
+	q := wir.client.WatchItem.Query()
+	q = q.Where(wir.filters(itemFs)...).
+		WithMedia().
+		Order(ent.Desc(WatchItem.FieldPriority), ent.Desc(WatchItem.FieldAddedAt))
+
+	items, err := q.All(ctx)
+	return wir.detailedItems(items), c.error(err)
+}
+
+func (wir *watchItemRepository) OneByRef(ctx context.Context, userID uuid.UUID, mediaType model.MediaType, ref int) (*model.WatchItem, error) {
+	q := wir.client.WatchItem.Query()
+	q = q.Where(
+		WatchItem.UserID(userID),
+		WatchItem.HasMediaWith(Media.Ref(ref), Media.MediaTypeEQ(Media.MediaType(mediaType))),
+	)
+
+	item, err := q.First(ctx)
+	return c.watchItem(item), c.error(err)
+}
+
+func (wir *watchItemRepository) filters(itemFs []*model.WatchItemF) []predicate.WatchItem {
+	var itemF *model.WatchItemF
+	if len(itemFs) > 0 {
+		itemF = itemFs[0]
+	}
+	var filters []predicate.WatchItem
+	if itemF != nil {
+		if itemF.ID != nil {
+			filters = append(filters, WatchItem.ID(*itemF.ID))
+		}
+		if itemF.UserID != nil {
+			filters = append(filters, WatchItem.UserID(*itemF.UserID))
+		}
+		if itemF.MediaID != nil {
+			filters = append(filters, WatchItem.MediaID(*itemF.MediaID))
+		}
+		if itemF.Status != nil {
+			filters = append(filters, WatchItem.StatusEQ(WatchItem.Status(*itemF.Status)))
+		}
+		if itemF.Priority != nil {
+			filters = append(filters, WatchItem.Priority(*itemF.Priority))
+		}
+		if itemF.AddedAt != nil {
+			filters = append(filters, WatchItem.AddedAt(*itemF.AddedAt))
+		}
+		if itemF.UpdatedAt != nil {
+			filters = append(filters, WatchItem.UpdatedAt(*itemF.UpdatedAt))
+		}
+		if itemF.MediaIDIn != nil {
+			filters = append(filters, WatchItem.MediaIDIn(*itemF.MediaIDIn...))
+		}
+	}
+	return filters
+}
+
+func (wir *watchItemRepository) create(item *model.WatchItem) *ent.WatchItemCreate {
+	return wir.client.WatchItem.Create().
+		SetID(uuid.New()).
+		SetUserID(item.UserID).
+		SetMediaID(item.MediaID).
+		SetStatus(WatchItem.Status(item.Status)).
+		SetPriority(item.Priority).
+		SetAddedAt(time.Now())
+}
+
+func (wir *watchItemRepository) createBulk(items []*model.WatchItem) *ent.WatchItemCreateBulk {
+	builders := make([]*ent.WatchItemCreate, 0, len(items))
+	for _, item := range items {
+		builders = append(builders, wir.create(item))
+	}
+	return wir.client.WatchItem.CreateBulk(builders...)
+}
+
+func (wir *watchItemRepository) detailedItems(items []*ent.WatchItem) []*model.DetailedWatchItem {
+	detailedItems := make([]*model.DetailedWatchItem, 0, len(items))
+	for _, item := range items {
+		detailedItems = append(detailedItems, &model.DetailedWatchItem{
+			Item:  c.watchItem(item),
+			Media: c.media(item.Edges.Media),
+		})
+	}
+	return detailedItems
+}
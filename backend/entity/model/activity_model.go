@@ -0,0 +1,58 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ActivityKind string
+
+const (
+	ActivityReviewCreated  ActivityKind = "review_created"
+	ActivityListCreated    ActivityKind = "list_created"
+	ActivityListUpdated    ActivityKind = "list_updated"
+	ActivityCommentCreated ActivityKind = "comment_created"
+	ActivityUserFollowed   ActivityKind = "user_followed"
+)
+
+// Activity is something a user did that shows up in their followers' feeds.
+// Which of the subject ids is set depends on Kind.
+type Activity struct {
+	ID         uuid.UUID    `json:"id"`
+	UserID     uuid.UUID    `json:"user_id"`
+	Kind       ActivityKind `json:"kind"`
+	MediaID    *uuid.UUID   `json:"media_id"`
+	ListID     *uuid.UUID   `json:"list_id"`
+	ReviewID   *uuid.UUID   `json:"review_id"`
+	CommentID  *uuid.UUID   `json:"comment_id"`
+	FollowedID *uuid.UUID   `json:"followed_id"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+type ActivityU struct{}
+
+type ActivityF struct {
+	ID         *uuid.UUID
+	UserID     *uuid.UUID
+	Kind       *ActivityKind
+	MediaID    *uuid.UUID
+	ListID     *uuid.UUID
+	ReviewID   *uuid.UUID
+	CommentID  *uuid.UUID
+	FollowedID *uuid.UUID
+	CreatedAt  *time.Time
+
+	// FollowedBy keeps the activities of users followed by this user.
+	FollowedBy *uuid.UUID
+}
+
+// DetailedActivity is an activity with its actor and subjects loaded, the subjects that don't apply to its kind are nil.
+type DetailedActivity struct {
+	Activity *Activity `json:"activity"`
+	User     *User     `json:"user"`
+	Media    *Media    `json:"media"`
+	List     *List     `json:"list"`
+	Review   *Review   `json:"review"`
+	Comment  *Comment  `json:"comment"`
+	Followed *User     `json:"followed"`
+}
@@ -5,18 +5,27 @@ import (
 	"time"
 )
 
+// CommentTombstone replaces the content of a deleted comment.
+const CommentTombstone = "[deleted]"
+
 type Comment struct {
 	ID           uuid.UUID  `json:"id"`
 	UserID       uuid.UUID  `json:"user_id"`
 	MediaID      uuid.UUID  `json:"media_id"`
 	ReplyingToID *uuid.UUID `json:"replying_to_id"`
+	ReviewID     *uuid.UUID `json:"review_id"`
 	Content      string     `json:"content"`
+	Spoiler      bool       `json:"spoiler"`
 	CreatedAt    time.Time  `json:"created_at"`
 	UpdatedAt    *time.Time `json:"updated_at"`
+	// DeletedAt is set when the comment is a tombstone, kept so its replies stay in the thread.
+	DeletedAt *time.Time `json:"deleted_at"`
 }
 
 type CommentU struct {
-	Content *string
+	Content   *string
+	Spoiler   *bool
+	DeletedAt *time.Time
 }
 
 type CommentF struct {
@@ -24,9 +33,14 @@ type CommentF struct {
 	UserID       *uuid.UUID
 	MediaID      *uuid.UUID
 	ReplyingToID *uuid.UUID
+	ReviewID     *uuid.UUID
 	Content      *string
+	Spoiler      *bool
 	CreatedAt    *time.Time
 	UpdatedAt    *time.Time
+	// VisibleTo leaves out comments by users the viewer muted, or who are on either side of a block with the viewer,
+	// tombstones aside, and comments in the threads of reviews the viewer can't see.
+	VisibleTo *uuid.UUID
 }
 
 type DetailedComment struct {
@@ -35,4 +49,9 @@ type DetailedComment struct {
 	RepliesCount int      `json:"replies_count"`
 	LikesCount   int      `json:"likes_count"`
 	LikedByUser  bool     `json:"liked_by_user"`
+	// Edited is whether the comment has revisions, which are the versions its edits replaced.
+	Edited bool `json:"edited"`
+	// Redacted is whether spoilers were taken out of the content, because the viewer hides
+	// spoilers of media they haven't watched.
+	Redacted bool `json:"redacted"`
 }
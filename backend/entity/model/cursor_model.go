@@ -0,0 +1,96 @@
+package model
+
+import (
+	"encoding/base64"
+	"errors"
+	"github.com/google/uuid"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor marks the last item of a page ordered newest first. The next page starts strictly
+// after it, with ID breaking ties between items created at the same instant.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// String encodes the cursor into the opaque token handed to clients.
+func (c Cursor) String() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseCursor decodes a token made by Cursor.String. An empty token means the first page, and gives a nil cursor.
+func ParseCursor(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &Cursor{CreatedAt: time.Unix(0, n).UTC(), ID: parsedID}, nil
+}
+
+// RankedCursor marks the last item of a page ordered by a rank, such as a rating, before newest first.
+// Items of equal rank are paged through like a Cursor.
+type RankedCursor struct {
+	Rank int
+	Cursor
+}
+
+// String encodes the cursor into the opaque token handed to clients.
+func (c RankedCursor) String() string {
+	raw := strconv.Itoa(c.Rank) + ":" + c.Cursor.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseRankedCursor decodes a token made by RankedCursor.String. An empty token means the first page, and gives a nil cursor.
+func ParseRankedCursor(token string) (*RankedCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	rank, rest, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+	r, err := strconv.Atoi(rank)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	cursor, err := ParseCursor(rest)
+	if err != nil || cursor == nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &RankedCursor{Rank: r, Cursor: *cursor}, nil
+}
+
+// Page is one page of a cursor paginated collection. NextCursor is nil on the last page.
+type Page[T any] struct {
+	Items      []T     `json:"items"`
+	NextCursor *string `json:"next_cursor"`
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// DiaryEntry logs a single watch of a media. WatchedOn is a date, its time is always midnight UTC.
+type DiaryEntry struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	MediaID   uuid.UUID  `json:"media_id"`
+	ReviewID  *uuid.UUID `json:"review_id"`
+	WatchedOn time.Time  `json:"watched_on"`
+	Rewatch   bool       `json:"rewatch"`
+	Rating    *int       `json:"rating"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+type DiaryEntryU struct {
+	ReviewID    *uuid.UUID
+	ClearReview bool
+	WatchedOn   *time.Time
+	Rewatch     *bool
+	Rating      *int
+	ClearRating bool
+}
+
+type DiaryEntryF struct {
+	ID        *uuid.UUID
+	UserID    *uuid.UUID
+	MediaID   *uuid.UUID
+	ReviewID  *uuid.UUID
+	WatchedOn *time.Time
+	Rewatch   *bool
+	Rating    *int
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+
+	WatchedFrom   *time.Time
+	WatchedBefore *time.Time
+}
+
+type DetailedDiaryEntry struct {
+	Entry *DiaryEntry `json:"entry"`
+	Media *Media      `json:"media"`
+}
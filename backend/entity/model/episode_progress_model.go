@@ -0,0 +1,29 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// EpisodeProgress records that a user has watched an episode of a show. CreatedAt is when it was marked as watched.
+type EpisodeProgress struct {
+	ID            uuid.UUID  `json:"id"`
+	UserID        uuid.UUID  `json:"user_id"`
+	MediaID       uuid.UUID  `json:"media_id"`
+	SeasonNumber  int        `json:"season_number"`
+	EpisodeNumber int        `json:"episode_number"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     *time.Time `json:"updated_at"`
+}
+
+type EpisodeProgressU struct{}
+
+type EpisodeProgressF struct {
+	ID            *uuid.UUID
+	UserID        *uuid.UUID
+	MediaID       *uuid.UUID
+	SeasonNumber  *int
+	EpisodeNumber *int
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// FollowRequest is a pending request of the requester to follow the private account of the user.
+type FollowRequest struct {
+	ID          uuid.UUID `json:"id"`
+	RequesterID uuid.UUID `json:"requester_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type FollowRequestU struct{}
+
+type FollowRequestF struct {
+	ID          *uuid.UUID
+	RequesterID *uuid.UUID
+	UserID      *uuid.UUID
+	CreatedAt   *time.Time
+}
+
+type DetailedFollowRequest struct {
+	Request   *FollowRequest `json:"request"`
+	Requester *User          `json:"requester"`
+}
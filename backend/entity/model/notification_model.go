@@ -0,0 +1,60 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type NotificationKind string
+
+const (
+	NotificationCommentReplied  NotificationKind = "comment_replied"
+	NotificationCommentLiked    NotificationKind = "comment_liked"
+	NotificationUserFollowed    NotificationKind = "user_followed"
+	NotificationListMemberAdded NotificationKind = "list_member_added"
+	NotificationFollowRequested NotificationKind = "follow_requested"
+	NotificationFollowApproved  NotificationKind = "follow_approved"
+	NotificationReviewLiked     NotificationKind = "review_liked"
+	NotificationReviewCommented NotificationKind = "review_commented"
+)
+
+// Notification tells a user that someone else did something involving them.
+// CommentID is set for comment notifications, ListID for list ones, and ReviewID for review ones.
+// A comment on a review sets both.
+type Notification struct {
+	ID        uuid.UUID        `json:"id"`
+	UserID    uuid.UUID        `json:"user_id"`
+	ActorID   uuid.UUID        `json:"actor_id"`
+	Kind      NotificationKind `json:"kind"`
+	CommentID *uuid.UUID       `json:"comment_id"`
+	ListID    *uuid.UUID       `json:"list_id"`
+	ReviewID  *uuid.UUID       `json:"review_id"`
+	Read      bool             `json:"read"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+type NotificationU struct {
+	Read *bool
+}
+
+type NotificationF struct {
+	ID        *uuid.UUID
+	UserID    *uuid.UUID
+	ActorID   *uuid.UUID
+	Kind      *NotificationKind
+	CommentID *uuid.UUID
+	ListID    *uuid.UUID
+	ReviewID  *uuid.UUID
+	Read      *bool
+	CreatedAt *time.Time
+
+	IDIn *[]uuid.UUID
+}
+
+type DetailedNotification struct {
+	Notification *Notification `json:"notification"`
+	Actor        *User         `json:"actor"`
+	Comment      *Comment      `json:"comment"`
+	List         *List         `json:"list"`
+	Review       *Review       `json:"review"`
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ReviewLike struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	ReviewID  uuid.UUID  `json:"review_id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+type ReviewLikeU struct{}
+
+type ReviewLikeF struct {
+	ID        *uuid.UUID
+	UserID    *uuid.UUID
+	ReviewID  *uuid.UUID
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
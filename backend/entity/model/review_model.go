@@ -5,19 +5,27 @@ import (
 	"time"
 )
 
+// ReviewTombstone replaces the content of a deleted review.
+const ReviewTombstone = "[deleted]"
+
 type Review struct {
 	ID        uuid.UUID  `json:"id"`
 	UserID    uuid.UUID  `json:"user_id"`
 	MediaID   uuid.UUID  `json:"media_id"`
 	Content   string     `json:"content"`
 	Rating    int        `json:"rating"`
+	Spoiler   bool       `json:"spoiler"`
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt *time.Time `json:"updated_at"`
+	// DeletedAt is set when the review is a tombstone, kept so the comments in its thread stay.
+	DeletedAt *time.Time `json:"deleted_at"`
 }
 
 type ReviewU struct {
-	Content *string
-	Rating  *int
+	Content   *string
+	Rating    *int
+	Spoiler   *bool
+	DeletedAt *time.Time
 }
 
 type ReviewF struct {
@@ -26,11 +34,42 @@ type ReviewF struct {
 	MediaID   *uuid.UUID
 	Content   *string
 	Rating    *int
+	Spoiler   *bool
 	CreatedAt *time.Time
 	UpdatedAt *time.Time
+	// Deleted picks tombstones when true, and leaves them out when false.
+	Deleted *bool
+	// VisibleTo leaves out reviews by users the viewer muted, or who are on either side of a block with the viewer,
+	// and reviews by private accounts the viewer doesn't follow.
+	VisibleTo *uuid.UUID
 }
 
 type DetailedReview struct {
-	Review *Review `json:"review"`
-	User   *User   `json:"user"`
+	Review       *Review `json:"review"`
+	User         *User   `json:"user"`
+	Media        *Media  `json:"media,omitempty"`
+	RepliesCount int     `json:"replies_count"`
+	LikesCount   int     `json:"likes_count"`
+	LikedByUser  bool    `json:"liked_by_user"`
+	// Redacted is whether spoilers were taken out of the content, because the viewer hides
+	// spoilers of media they haven't watched.
+	Redacted bool `json:"redacted"`
+}
+
+// ReviewSort is the order reviews are paged through in, ties are broken newest first.
+type ReviewSort string
+
+const (
+	ReviewSortNewest  ReviewSort = "newest"
+	ReviewSortHighest ReviewSort = "highest"
+	ReviewSortLowest  ReviewSort = "lowest"
+	ReviewSortLiked   ReviewSort = "liked"
+)
+
+// ReviewSummary aggregates every review of a media. Histogram counts the reviews of each rating,
+// Histogram[0] being the count of 1s. Average is 0 when there are no reviews.
+type ReviewSummary struct {
+	Average   float64 `json:"average"`
+	Count     int     `json:"count"`
+	Histogram [10]int `json:"histogram"`
 }
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Revision is a version of a comment or review that was replaced by an edit.
+type Revision struct {
+	ID        uuid.UUID  `json:"id"`
+	CommentID *uuid.UUID `json:"comment_id"`
+	ReviewID  *uuid.UUID `json:"review_id"`
+	Content   string     `json:"content"`
+	Rating    *int       `json:"rating"`
+	Spoiler   bool       `json:"spoiler"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+type RevisionU struct{}
+
+type RevisionF struct {
+	ID        *uuid.UUID
+	CommentID *uuid.UUID
+	ReviewID  *uuid.UUID
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
@@ -6,22 +6,34 @@ import (
 )
 
 type User struct {
-	ID             uuid.UUID  `json:"id"`
-	DisplayName    string     `json:"display_name"`
-	Username       string     `json:"username"`
-	Email          string     `json:"-"`
-	Password       string     `json:"-"`
-	ProfilePicture string     `json:"profile_picture"`
-	CreatedAt      time.Time  `json:"created_at"`
-	UpdatedAt      *time.Time `json:"updated_at"`
+	ID             uuid.UUID `json:"id"`
+	DisplayName    string    `json:"display_name"`
+	Username       string    `json:"username"`
+	Email          string    `json:"-"`
+	Password       string    `json:"-"`
+	ProfilePicture string    `json:"profile_picture"`
+	Region         *string   `json:"region"`
+	Services       []int     `json:"streaming_services"`
+	Private        bool      `json:"private"`
+	HideSpoilers   bool      `json:"hide_spoilers"`
+	Moderator      bool      `json:"-"`
+	// MutedNotifications are the kinds of notification the user doesn't want to receive.
+	MutedNotifications []NotificationKind `json:"-"`
+	CreatedAt          time.Time          `json:"created_at"`
+	UpdatedAt          *time.Time         `json:"updated_at"`
 }
 
 type UserU struct {
-	DisplayName    *string
-	Username       *string
-	Email          *string
-	Password       *string
-	ProfilePicture *string
+	DisplayName        *string
+	Username           *string
+	Email              *string
+	Password           *string
+	ProfilePicture     *string
+	Region             *string
+	Services           *[]int
+	MutedNotifications *[]NotificationKind
+	Private            *bool
+	HideSpoilers       *bool
 }
 
 type UserF struct {
@@ -34,6 +46,8 @@ type UserF struct {
 	ProfilePicture *string
 	CreatedAt      *time.Time
 	UpdatedAt      *time.Time
+	// HiddenFrom picks the users the given user muted, or who are on either side of a block with them.
+	HiddenFrom *uuid.UUID
 }
 
 type DetailedUser struct {
@@ -45,4 +59,19 @@ type DetailedUser struct {
 	ReviewsCount   int   `json:"reviews_count"`
 	ListsCount     int   `json:"lists_count"`
 	Followed       bool  `json:"followed"`
+	Blocked        bool  `json:"blocked"`
+	Muted          bool  `json:"muted"`
+	// Requested is whether the viewer has a pending request to follow the user.
+	Requested bool `json:"requested"`
+}
+
+// DetailedFollow is a user in a followers or following list, related to the viewer of the list.
+type DetailedFollow struct {
+	User       *User     `json:"user"`
+	FollowedAt time.Time `json:"followed_at"`
+	// Followed is whether the viewer follows the user.
+	Followed bool `json:"followed"`
+	// FollowsViewer is whether the user follows the viewer.
+	FollowsViewer bool `json:"follows_viewer"`
+	Mutual        bool `json:"mutual"`
 }
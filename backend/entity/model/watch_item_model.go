@@ -0,0 +1,48 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type WatchStatus string
+
+const (
+	WatchStatusWatchlist WatchStatus = "watchlist"
+	WatchStatusWatched   WatchStatus = "watched"
+)
+
+// WatchItem places a media on a user's built-in watchlist or in their watched set.
+// A media has at most one item per user, so its status says which of the two it is in.
+type WatchItem struct {
+	ID        uuid.UUID   `json:"id"`
+	UserID    uuid.UUID   `json:"user_id"`
+	MediaID   uuid.UUID   `json:"media_id"`
+	Status    WatchStatus `json:"status"`
+	Priority  int         `json:"priority"`
+	AddedAt   time.Time   `json:"added_at"`
+	UpdatedAt *time.Time  `json:"updated_at"`
+}
+
+type WatchItemU struct {
+	Status   *WatchStatus
+	Priority *int
+	AddedAt  *time.Time
+}
+
+type WatchItemF struct {
+	ID        *uuid.UUID
+	UserID    *uuid.UUID
+	MediaID   *uuid.UUID
+	Status    *WatchStatus
+	Priority  *int
+	AddedAt   *time.Time
+	UpdatedAt *time.Time
+
+	MediaIDIn *[]uuid.UUID
+}
+
+type DetailedWatchItem struct {
+	Item  *WatchItem `json:"item"`
+	Media *Media     `json:"media"`
+}
@@ -0,0 +1,30 @@
+package schemas
+
+import (
+	"github.com/MarcusSanchez/go-z"
+	"github.com/google/uuid"
+	"time"
+)
+
+var DiaryWatchedOnSchema = z.String().
+	Custom(func(s string) bool {
+		_, err := time.Parse(time.DateOnly, s)
+		return err == nil
+	}, "watched_on must be a date formatted as YYYY-MM-DD")
+
+var DiaryRatingSchema = z.Int().
+	Optional().
+	Range(1, 10, "rating must be between 1 and 10")
+
+var DiaryReviewIDSchema = z.String().
+	Optional().
+	Custom(func(s string) bool {
+		_, err := uuid.Parse(s)
+		return err == nil
+	}, "review_id must be a valid UUID")
+
+var DiaryYearSchema = z.Int().
+	Range(1870, 2100, "year must be between 1870 and 2100")
+
+var DiaryMonthSchema = z.Int().
+	Range(1, 12, "month must be between 1 and 12")
@@ -0,0 +1,69 @@
+package schemas
+
+import (
+	"cine/pkg/tmdb"
+	"github.com/MarcusSanchez/go-z"
+	"strconv"
+	"strings"
+)
+
+var DiscoverGenresSchema = z.String().
+	Optional().
+	Custom(func(s string) bool {
+		for _, id := range strings.Split(s, ",") {
+			genre, err := strconv.Atoi(id)
+			if err != nil || !tmdb.Genre(genre).Valid() {
+				return false
+			}
+		}
+		return true
+	}, "genres must be a comma separated list of valid genre ids")
+
+var DiscoverYearSchema = z.Int().
+	Optional().
+	Range(1870, 2100, "years must be between 1870 and 2100")
+
+var DiscoverMinRatingSchema = z.Float64().
+	Optional().
+	Range(0, 10, "min_rating must be between 0 and 10")
+
+var DiscoverMinVotesSchema = z.Int().
+	Optional().
+	NonNegative("min_votes must not be negative")
+
+var DiscoverRuntimeSchema = z.Int().
+	Optional().
+	Range(0, 1000, "runtimes must be between 0 and 1000 minutes")
+
+var DiscoverLanguageSchema = z.String().
+	Optional().
+	Regex(`^[a-z]{2}$`, "language must be a two letter ISO 639-1 code")
+
+var DiscoverSortSchema = z.String().
+	Optional().
+	In(
+		[]string{"popularity", "rating", "voteCount", "releaseDate", "title"},
+		"sort_by must be either 'popularity', 'rating', 'voteCount', 'releaseDate', or 'title'",
+	)
+
+var DiscoverOrderSchema = z.String().
+	Optional().
+	In([]string{"asc", "desc"}, "order must be either 'asc' or 'desc'")
+
+var DiscoverProvidersSchema = z.String().
+	Optional().
+	Custom(func(s string) bool {
+		for _, id := range strings.Split(s, ",") {
+			if provider, err := strconv.Atoi(id); err != nil || provider <= 0 {
+				return false
+			}
+		}
+		return true
+	}, "providers must be a comma separated list of provider ids")
+
+var DiscoverRegionSchema = z.String().
+	Optional().
+	Regex(`^[A-Z]{2}$`, "region must be a two letter ISO 3166-1 code")
+
+var PageSchema = z.Int().
+	Range(1, tmdb.MaxPage, "page must be between 1 and "+strconv.Itoa(tmdb.MaxPage))
@@ -1,6 +1,10 @@
 package schemas
 
-import "github.com/MarcusSanchez/go-z"
+import (
+	"cine/pkg/tmdb"
+	"github.com/MarcusSanchez/go-z"
+	"strings"
+)
 
 var MediaTypeSchema = z.String().
 	In([]string{"movie", "show"}, "type must be either 'movie' or 'show'")
@@ -16,3 +20,23 @@ var ShowListSchema = z.String().
 		[]string{"popular", "topRated", "onTheAir", "airingToday"},
 		"list must be either 'popular', 'topRated', 'onTheAir', or 'airingToday",
 	)
+
+var TrendingTypeSchema = z.String().
+	In(
+		[]string{"all", "movie", "show", "person"},
+		"type must be either 'all', 'movie', 'show', or 'person'",
+	)
+
+var TrendingWindowSchema = z.String().
+	In([]string{"day", "week"}, "window must be either 'day' or 'week'")
+
+var AppendSchema = z.String().
+	Optional().
+	Custom(func(s string) bool {
+		for _, value := range strings.Split(s, ",") {
+			if !tmdb.Append(value).Valid() {
+				return false
+			}
+		}
+		return true
+	}, "append must be a comma separated list of 'credits', 'videos', or 'images'")
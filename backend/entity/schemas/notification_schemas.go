@@ -0,0 +1,29 @@
+package schemas
+
+import (
+	"github.com/MarcusSanchez/go-z"
+	"github.com/google/uuid"
+	"strconv"
+)
+
+// MaxMarkRead bounds how many notifications can be marked as read by id at once.
+const MaxMarkRead = 100
+
+var NotificationKindSchema = z.String().
+	In(
+		[]string{
+			"comment_replied", "comment_liked", "user_followed", "list_member_added",
+			"follow_requested", "follow_approved", "review_liked", "review_commented",
+		},
+		"kind must be either 'comment_replied', 'comment_liked', 'user_followed', 'list_member_added', "+
+			"'follow_requested', 'follow_approved', 'review_liked', or 'review_commented'",
+	)
+
+var NotificationIDSchema = z.String().
+	Custom(func(s string) bool {
+		_, err := uuid.Parse(s)
+		return err == nil
+	}, "ids must be valid UUIDs")
+
+var MarkReadCountSchema = z.Int().
+	Lte(MaxMarkRead, "at most "+strconv.Itoa(MaxMarkRead)+" notifications can be marked at once")
@@ -0,0 +1,14 @@
+package schemas
+
+import (
+	"github.com/MarcusSanchez/go-z"
+	"strconv"
+)
+
+// DefaultLimit is the page size of cursor paginated routes when the client doesn't ask for one.
+const DefaultLimit = 20
+
+const MaxLimit = 50
+
+var LimitSchema = z.Int().
+	Range(1, MaxLimit, "limit must be between 1 and "+strconv.Itoa(MaxLimit))
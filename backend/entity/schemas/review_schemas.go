@@ -9,3 +9,6 @@ var ReviewContentSchema = z.String().
 var ReviewRatingSchema = z.Int().
 	Gt(0, "rating must be greater than 0").
 	Lte(10, "rating must be less than or equal to 10")
+
+var ReviewSortSchema = z.String().
+	In([]string{"newest", "highest", "lowest", "liked"}, "sort must be either 'newest', 'highest', 'lowest', or 'liked'")
@@ -0,0 +1,7 @@
+package schemas
+
+import "github.com/MarcusSanchez/go-z"
+
+var SearchQuerySchema = z.String().
+	Min(1, "q must be at least 1 character long").
+	Max(100, "q must be at most 100 characters long")
@@ -46,3 +46,11 @@ var ProfilePictureSchema = z.String().Custom(
 		}
 	}, "profile picture must be a valid image URL",
 )
+
+var RegionSchema = z.String().
+	Regex(`^[A-Z]{2}$`, "region must be a two letter ISO 3166-1 code")
+
+const MaxStreamingServices = 50
+
+var StreamingServiceSchema = z.Int().
+	Positive("streaming services must be valid provider ids")
@@ -0,0 +1,7 @@
+package schemas
+
+import "github.com/MarcusSanchez/go-z"
+
+var WatchPrioritySchema = z.Int().
+	Optional().
+	Range(0, 5, "priority must be between 0 and 5")
@@ -13,6 +13,7 @@ const (
 	CodeForbidden
 	CodeInternal
 	CodeNotImplemented
+	CodeUnavailable
 )
 
 func (e Code) String() string {
@@ -33,6 +34,8 @@ func (e Code) String() string {
 		return "internal"
 	case CodeNotImplemented:
 		return "not implemented"
+	case CodeUnavailable:
+		return "unavailable"
 	default:
 		return "unknown"
 	}
@@ -52,6 +55,8 @@ func (e Code) Status() int {
 		return http.StatusForbidden
 	case CodeInternal:
 		return http.StatusInternalServerError
+	case CodeUnavailable:
+		return http.StatusServiceUnavailable
 	default:
 		return http.StatusInternalServerError
 	}
@@ -49,3 +49,7 @@ func Forbidden(message string) Error {
 func Internal(message string) Error {
 	return Error{Code: CodeInternal, Message: message}
 }
+
+func Unavailable(message string) Error {
+	return Error{Code: CodeUnavailable, Message: message}
+}
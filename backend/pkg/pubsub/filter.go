@@ -0,0 +1,45 @@
+package pubsub
+
+import "sync"
+
+type filtered struct {
+	sub    Subscription
+	events chan *Event
+	done   chan struct{}
+	once   sync.Once
+}
+
+// Filter passes the events of sub through fn before they are received, fn may return a changed event,
+// or nil to drop it. Events are filtered one at a time on a goroutine of the subscription.
+func Filter(sub Subscription, fn func(event *Event) *Event) Subscription {
+	f := &filtered{
+		sub:    sub,
+		events: make(chan *Event, subscriptionBuffer),
+		done:   make(chan struct{}),
+	}
+	go f.run(fn)
+	return f
+}
+
+func (f *filtered) run(fn func(event *Event) *Event) {
+	defer close(f.events)
+	for event := range f.sub.Events() {
+		if event = fn(event); event == nil {
+			continue
+		}
+		select {
+		case f.events <- event:
+		case <-f.done:
+			return
+		}
+	}
+}
+
+func (f *filtered) Events() <-chan *Event {
+	return f.events
+}
+
+func (f *filtered) Close() {
+	f.once.Do(func() { close(f.done) })
+	f.sub.Close()
+}
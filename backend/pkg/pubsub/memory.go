@@ -0,0 +1,129 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// subscriptionBuffer is how many events a subscriber may lag behind before it starts missing them.
+const subscriptionBuffer = 32
+
+type memoryHub struct {
+	mu     sync.RWMutex
+	topics map[string]map[*subscription]struct{}
+	closed bool
+}
+
+type subscription struct {
+	hub    *memoryHub
+	topics []string
+	events chan *Event
+	closed bool
+}
+
+// NewMemoryHub returns a hub that only reaches subscribers within this process.
+func NewMemoryHub() Hub {
+	return newMemoryHub()
+}
+
+func newMemoryHub() *memoryHub {
+	return &memoryHub{topics: make(map[string]map[*subscription]struct{})}
+}
+
+func (h *memoryHub) Publish(_ context.Context, event *Event) error {
+	h.mu.RLock()
+	closed := h.closed
+	h.mu.RUnlock()
+	if closed {
+		return ErrClosed
+	}
+
+	h.dispatch(event)
+	return nil
+}
+
+// dispatch hands event to the subscribers of its topic without ever blocking on them.
+func (h *memoryHub) dispatch(event *Event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for sub := range h.topics[event.Topic] {
+		select {
+		case sub.events <- event:
+		default: // the subscriber is behind, so it misses this event
+		}
+	}
+}
+
+func (h *memoryHub) Subscribe(topics ...string) Subscription {
+	sub := &subscription{
+		hub:    h,
+		topics: topics,
+		events: make(chan *Event, subscriptionBuffer),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		sub.closed = true
+		close(sub.events)
+		return sub
+	}
+
+	for _, topic := range topics {
+		subs, ok := h.topics[topic]
+		if !ok {
+			subs = make(map[*subscription]struct{})
+			h.topics[topic] = subs
+		}
+		subs[sub] = struct{}{}
+	}
+
+	return sub
+}
+
+func (h *memoryHub) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil
+	}
+	h.closed = true
+
+	for _, subs := range h.topics {
+		for sub := range subs {
+			h.unsubscribe(sub)
+		}
+	}
+
+	return nil
+}
+
+// unsubscribe removes sub from all of its topics and closes its events, the caller must hold the write lock.
+func (h *memoryHub) unsubscribe(sub *subscription) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+
+	for _, topic := range sub.topics {
+		subs := h.topics[topic]
+		delete(subs, sub)
+		if len(subs) == 0 {
+			delete(h.topics, topic)
+		}
+	}
+	close(sub.events)
+}
+
+func (s *subscription) Events() <-chan *Event {
+	return s.events
+}
+
+func (s *subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.unsubscribe(s)
+}
@@ -0,0 +1,107 @@
+package pubsub
+
+import (
+	"cine/pkg/logger"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/lib/pq"
+	"time"
+)
+
+const (
+	// postgresChannel is the NOTIFY channel every instance relays events through.
+	postgresChannel = "cine_events"
+	// maxNotifyPayload is postgres' limit on the size of a NOTIFY payload.
+	maxNotifyPayload = 8000
+
+	listenerMinReconnect = 10 * time.Second
+	listenerMaxReconnect = time.Minute
+)
+
+var ErrPayloadTooLarge = errors.New("pubsub: event is too large to notify")
+
+// postgresHub publishes with pg_notify and delivers what it LISTENs to through a local memory hub,
+// so subscribers on every instance sharing the database receive events no matter where they were published.
+type postgresHub struct {
+	local    *memoryHub
+	db       *sql.DB
+	listener *pq.Listener
+	logger   logger.Logger
+	done     chan struct{}
+}
+
+// NewPostgresHub returns a hub relaying events between instances through LISTEN/NOTIFY.
+// It requires the postgres driver to be registered.
+func NewPostgresHub(datasource string, logger logger.Logger) (Hub, error) {
+	db, err := sql.Open("postgres", datasource)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := pq.NewListener(datasource, listenerMinReconnect, listenerMaxReconnect, func(_ pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Error("pubsub listener connection event", err)
+		}
+	})
+	if err = listener.Listen(postgresChannel); err != nil {
+		_ = listener.Close()
+		_ = db.Close()
+		return nil, err
+	}
+
+	hub := &postgresHub{
+		local:    newMemoryHub(),
+		db:       db,
+		listener: listener,
+		logger:   logger,
+		done:     make(chan struct{}),
+	}
+	go hub.listen()
+
+	return hub, nil
+}
+
+func (h *postgresHub) Publish(ctx context.Context, event *Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if len(payload) > maxNotifyPayload {
+		return ErrPayloadTooLarge
+	}
+
+	_, err = h.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", postgresChannel, string(payload))
+	return err
+}
+
+func (h *postgresHub) Subscribe(topics ...string) Subscription {
+	return h.local.Subscribe(topics...)
+}
+
+func (h *postgresHub) Close() error {
+	err := h.listener.Close()
+	<-h.done
+	_ = h.local.Close()
+	return errors.Join(err, h.db.Close())
+}
+
+// listen dispatches notifications until the listener is closed.
+func (h *postgresHub) listen() {
+	defer close(h.done)
+
+	for notification := range h.listener.Notify {
+		// a nil notification means the connection was re-established, anything sent meanwhile is lost
+		if notification == nil {
+			continue
+		}
+
+		var event Event
+		if err := json.Unmarshal([]byte(notification.Extra), &event); err != nil {
+			h.logger.Error("failed decoding pubsub notification", err)
+			continue
+		}
+		h.local.dispatch(&event)
+	}
+}
@@ -0,0 +1,74 @@
+package pubsub
+
+import (
+	"cine/config"
+	"cine/pkg/logger"
+	"context"
+	"encoding/json"
+	"errors"
+	"go.uber.org/fx"
+)
+
+var ErrClosed = errors.New("pubsub: hub is closed")
+
+// Event is a message published on a topic. Data is already encoded as json so events
+// can be handed to subscribers, or across processes, without being re-encoded.
+type Event struct {
+	Topic string          `json:"topic"`
+	Type  string          `json:"type"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// Hub fans events published on a topic out to every subscriber of that topic.
+// Delivery is best-effort: events published while nobody is subscribed are not kept,
+// and a subscriber that falls too far behind misses events instead of stalling publishers.
+type Hub interface {
+	Publish(ctx context.Context, event *Event) error
+	Subscribe(topics ...string) Subscription
+	Close() error
+}
+
+// Subscription receives the events of the topics it was created with until it is closed.
+// Events is closed once the subscription or its hub is closed.
+type Subscription interface {
+	Events() <-chan *Event
+	Close()
+}
+
+// NewHub returns the hub selected by the PUBSUB config: "memory" only reaches subscribers
+// of this process, while "postgres" relays events through LISTEN/NOTIFY so every instance
+// sharing the database receives them.
+func NewHub(
+	lc fx.Lifecycle,
+	shutdowner fx.Shutdowner,
+	config *config.Config,
+	logger logger.Logger,
+) Hub {
+	var hub Hub = NewMemoryHub()
+	if config.PubSub == "postgres" {
+		postgres, err := NewPostgresHub(config.Datasource, logger)
+		if err != nil {
+			logger.Error("failed listening on postgresql", err)
+			_ = shutdowner.Shutdown()
+		} else {
+			hub = postgres
+		}
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return hub.Close()
+		},
+	})
+
+	return hub
+}
+
+// NewEvent encodes data into an event of the given type on topic.
+func NewEvent(topic string, kind string, data any) (*Event, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Event{Topic: topic, Type: kind, Data: encoded}, nil
+}
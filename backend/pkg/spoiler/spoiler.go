@@ -0,0 +1,44 @@
+package spoiler
+
+import "strings"
+
+const (
+	// Marker opens and closes an inline spoiler, as in "the butler ||did it||".
+	Marker = "||"
+	// Placeholder takes the place of a redacted inline spoiler.
+	Placeholder = "[spoiler]"
+)
+
+// Has reports whether text has an inline spoiler. A marker that is never closed isn't one.
+func Has(text string) bool {
+	_, _, ok := next(text)
+	return ok
+}
+
+// Redact replaces every inline spoiler of text, markers included, with Placeholder.
+func Redact(text string) string {
+	var b strings.Builder
+	for {
+		start, end, ok := next(text)
+		if !ok {
+			b.WriteString(text)
+			return b.String()
+		}
+		b.WriteString(text[:start])
+		b.WriteString(Placeholder)
+		text = text[end:]
+	}
+}
+
+// next finds the first inline spoiler of text, from the start of its opening marker to the end of its closing one.
+func next(text string) (start, end int, ok bool) {
+	start = strings.Index(text, Marker)
+	if start < 0 {
+		return 0, 0, false
+	}
+	length := strings.Index(text[start+len(Marker):], Marker)
+	if length < 0 {
+		return 0, 0, false
+	}
+	return start, start + len(Marker) + length + len(Marker), true
+}
@@ -0,0 +1,506 @@
+package tmdb
+
+import (
+	"cine/pkg/logger"
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"go.uber.org/fx"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	cacheCapacity      = 2048
+	cacheStatsInterval = 15 * time.Minute
+	// cacheFetchTimeout bounds a shared fetch, which no longer follows any caller's context.
+	// It leaves room for the client's retries.
+	cacheFetchTimeout = time.Minute
+
+	ttlDetails  = 6 * time.Hour
+	ttlCredits  = 24 * time.Hour
+	ttlSeason   = 6 * time.Hour
+	ttlList     = 30 * time.Minute
+	ttlSearch   = 10 * time.Minute
+	ttlNotFound = 5 * time.Minute
+)
+
+// CacheStats is a snapshot of the cache counters, used to tune capacity and TTLs.
+type CacheStats struct {
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Evictions uint64 `json:"evictions"`
+	Coalesced uint64 `json:"coalesced"`
+	Entries   int    `json:"entries"`
+}
+
+// CachedAPI is an API that keeps responses in memory and can report how well it is doing so.
+type CachedAPI interface {
+	API
+	Stats() CacheStats
+}
+
+type cacheEntry struct {
+	key     string
+	value   any
+	err     error
+	expires time.Time
+}
+
+type inflightCall struct {
+	done  chan struct{}
+	value any
+	err   error
+}
+
+// cachedAPI decorates an API with an LRU cache, per-endpoint TTLs, negative caching of
+// not found responses, and coalescing of identical requests that are in flight at the same time.
+type cachedAPI struct {
+	api      API
+	capacity int
+
+	mu       sync.Mutex
+	entries  map[string]*list.Element
+	order    *list.List
+	inflight map[string]*inflightCall
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+	coalesced atomic.Uint64
+}
+
+// NewCachedAPI wraps api with an in-memory cache. It's provided as CachedAPI so its stats can be read,
+// and handed to everything depending on API by decorating it with Cached.
+func NewCachedAPI(lc fx.Lifecycle, api API, logger logger.Logger) CachedAPI {
+	cache := newCachedAPI(api, cacheCapacity)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go cache.logStats(ctx, logger, cacheStatsInterval)
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			logger.Info("tmdb cache " + cache.Stats().String())
+			return nil
+		},
+	})
+
+	return cache
+}
+
+// Cached is meant to be used with fx.Decorate, so anything depending on API receives the cached version
+// without knowing about it. The cache itself still gets the undecorated API.
+func Cached(cache CachedAPI) API {
+	return cache
+}
+
+func newCachedAPI(api API, capacity int) *cachedAPI {
+	return &cachedAPI{
+		api:      api,
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		inflight: make(map[string]*inflightCall),
+	}
+}
+
+// NewCache is NewCachedAPI without the lifecycle hooks, for use outside of fx.
+func NewCache(api API, capacity int) CachedAPI {
+	return newCachedAPI(api, capacity)
+}
+
+func (s CacheStats) String() string {
+	return fmt.Sprintf(
+		"hits: %d | misses: %d | evictions: %d | coalesced: %d | entries: %d",
+		s.Hits, s.Misses, s.Evictions, s.Coalesced, s.Entries,
+	)
+}
+
+func (c *cachedAPI) Stats() CacheStats {
+	c.mu.Lock()
+	entries := c.order.Len()
+	c.mu.Unlock()
+
+	return CacheStats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Coalesced: c.coalesced.Load(),
+		Entries:   entries,
+	}
+}
+
+func (c *cachedAPI) logStats(ctx context.Context, logger logger.Logger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			logger.Info("tmdb cache " + c.Stats().String())
+		}
+	}
+}
+
+func (c *cachedAPI) SearchMovies(ctx context.Context, query string, filters ...SearchMovieFilter) (*Page[Movie], error) {
+	key := "/search/movie?query=" + query + filterKey(filters)
+	return cached(ctx, c, key, ttlSearch, func(ctx context.Context) (*Page[Movie], error) {
+		return c.api.SearchMovies(ctx, query, filters...)
+	})
+}
+
+func (c *cachedAPI) SearchShows(ctx context.Context, query string, filters ...SearchShowFilter) (*Page[Show], error) {
+	key := "/search/tv?query=" + query + filterKey(filters)
+	return cached(ctx, c, key, ttlSearch, func(ctx context.Context) (*Page[Show], error) {
+		return c.api.SearchShows(ctx, query, filters...)
+	})
+}
+
+func (c *cachedAPI) SearchPeople(ctx context.Context, query string, filters ...SearchPeopleFilter) (*Page[Person], error) {
+	key := "/search/person?query=" + query + filterKey(filters)
+	return cached(ctx, c, key, ttlSearch, func(ctx context.Context) (*Page[Person], error) {
+		return c.api.SearchPeople(ctx, query, filters...)
+	})
+}
+
+func (c *cachedAPI) SearchMulti(ctx context.Context, query string, filters ...SearchMultiFilter) (*Page[MultiResult], error) {
+	key := "/search/multi?query=" + query + filterKey(filters)
+	return cached(ctx, c, key, ttlSearch, func(ctx context.Context) (*Page[MultiResult], error) {
+		return c.api.SearchMulti(ctx, query, filters...)
+	})
+}
+
+func (c *cachedAPI) GetMovie(ctx context.Context, ref int) (*DetailedMovie, error) {
+	key := "/movie/" + strconv.Itoa(ref)
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*DetailedMovie, error) {
+		return c.api.GetMovie(ctx, ref)
+	})
+}
+
+func (c *cachedAPI) GetMovieCredits(ctx context.Context, ref int) (*MovieCredits, error) {
+	key := "/movie/" + strconv.Itoa(ref) + "/credits"
+	return cached(ctx, c, key, ttlCredits, func(ctx context.Context) (*MovieCredits, error) {
+		return c.api.GetMovieCredits(ctx, ref)
+	})
+}
+
+func (c *cachedAPI) GetMovieRecommendations(ctx context.Context, ref int, page int) (*Page[Movie], error) {
+	key := "/movie/" + strconv.Itoa(ref) + "/recommendations?page=" + strconv.Itoa(page)
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*Page[Movie], error) {
+		return c.api.GetMovieRecommendations(ctx, ref, page)
+	})
+}
+
+func (c *cachedAPI) GetSimilarMovies(ctx context.Context, ref int, page int) (*Page[Movie], error) {
+	key := "/movie/" + strconv.Itoa(ref) + "/similar?page=" + strconv.Itoa(page)
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*Page[Movie], error) {
+		return c.api.GetSimilarMovies(ctx, ref, page)
+	})
+}
+
+func (c *cachedAPI) ListNowPlayingMovies(ctx context.Context, page int) (*Page[Movie], error) {
+	key := "/movie/now_playing?page=" + strconv.Itoa(page)
+	return cached(ctx, c, key, ttlList, func(ctx context.Context) (*Page[Movie], error) {
+		return c.api.ListNowPlayingMovies(ctx, page)
+	})
+}
+
+func (c *cachedAPI) ListPopularMovies(ctx context.Context, page int) (*Page[Movie], error) {
+	key := "/movie/popular?page=" + strconv.Itoa(page)
+	return cached(ctx, c, key, ttlList, func(ctx context.Context) (*Page[Movie], error) {
+		return c.api.ListPopularMovies(ctx, page)
+	})
+}
+
+func (c *cachedAPI) ListTopRatedMovies(ctx context.Context, page int) (*Page[Movie], error) {
+	key := "/movie/top_rated?page=" + strconv.Itoa(page)
+	return cached(ctx, c, key, ttlList, func(ctx context.Context) (*Page[Movie], error) {
+		return c.api.ListTopRatedMovies(ctx, page)
+	})
+}
+
+func (c *cachedAPI) ListUpcomingMovies(ctx context.Context, page int) (*Page[Movie], error) {
+	key := "/movie/upcoming?page=" + strconv.Itoa(page)
+	return cached(ctx, c, key, ttlList, func(ctx context.Context) (*Page[Movie], error) {
+		return c.api.ListUpcomingMovies(ctx, page)
+	})
+}
+
+func (c *cachedAPI) GetShow(ctx context.Context, ref int) (*DetailedShow, error) {
+	key := "/tv/" + strconv.Itoa(ref)
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*DetailedShow, error) {
+		return c.api.GetShow(ctx, ref)
+	})
+}
+
+func (c *cachedAPI) GetShowCredits(ctx context.Context, ref int) (*ShowCredits, error) {
+	key := "/tv/" + strconv.Itoa(ref) + "/aggregate_credits"
+	return cached(ctx, c, key, ttlCredits, func(ctx context.Context) (*ShowCredits, error) {
+		return c.api.GetShowCredits(ctx, ref)
+	})
+}
+
+func (c *cachedAPI) GetShowSeasonDetails(ctx context.Context, ref int, seasonNumber int) (*DetailedSeason, error) {
+	key := "/tv/" + strconv.Itoa(ref) + "/season/" + strconv.Itoa(seasonNumber)
+	return cached(ctx, c, key, ttlSeason, func(ctx context.Context) (*DetailedSeason, error) {
+		return c.api.GetShowSeasonDetails(ctx, ref, seasonNumber)
+	})
+}
+
+func (c *cachedAPI) GetShowRecommendations(ctx context.Context, ref int, page int) (*Page[Show], error) {
+	key := "/tv/" + strconv.Itoa(ref) + "/recommendations?page=" + strconv.Itoa(page)
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*Page[Show], error) {
+		return c.api.GetShowRecommendations(ctx, ref, page)
+	})
+}
+
+func (c *cachedAPI) GetSimilarShows(ctx context.Context, ref int, page int) (*Page[Show], error) {
+	key := "/tv/" + strconv.Itoa(ref) + "/similar?page=" + strconv.Itoa(page)
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*Page[Show], error) {
+		return c.api.GetSimilarShows(ctx, ref, page)
+	})
+}
+
+func (c *cachedAPI) ListAiringTodayShows(ctx context.Context, page int) (*Page[Show], error) {
+	key := "/tv/airing_today?page=" + strconv.Itoa(page)
+	return cached(ctx, c, key, ttlList, func(ctx context.Context) (*Page[Show], error) {
+		return c.api.ListAiringTodayShows(ctx, page)
+	})
+}
+
+func (c *cachedAPI) ListPopularShows(ctx context.Context, page int) (*Page[Show], error) {
+	key := "/tv/popular?page=" + strconv.Itoa(page)
+	return cached(ctx, c, key, ttlList, func(ctx context.Context) (*Page[Show], error) {
+		return c.api.ListPopularShows(ctx, page)
+	})
+}
+
+func (c *cachedAPI) ListTopRatedShows(ctx context.Context, page int) (*Page[Show], error) {
+	key := "/tv/top_rated?page=" + strconv.Itoa(page)
+	return cached(ctx, c, key, ttlList, func(ctx context.Context) (*Page[Show], error) {
+		return c.api.ListTopRatedShows(ctx, page)
+	})
+}
+
+func (c *cachedAPI) ListOnTheAirShows(ctx context.Context, page int) (*Page[Show], error) {
+	key := "/tv/on_the_air?page=" + strconv.Itoa(page)
+	return cached(ctx, c, key, ttlList, func(ctx context.Context) (*Page[Show], error) {
+		return c.api.ListOnTheAirShows(ctx, page)
+	})
+}
+
+func (c *cachedAPI) DiscoverMovies(ctx context.Context, filter DiscoverFilter) (*Page[Movie], error) {
+	key := "/discover/movie" + filterKey([]DiscoverFilter{filter})
+	return cached(ctx, c, key, ttlList, func(ctx context.Context) (*Page[Movie], error) {
+		return c.api.DiscoverMovies(ctx, filter)
+	})
+}
+
+func (c *cachedAPI) DiscoverShows(ctx context.Context, filter DiscoverFilter) (*Page[Show], error) {
+	key := "/discover/tv" + filterKey([]DiscoverFilter{filter})
+	return cached(ctx, c, key, ttlList, func(ctx context.Context) (*Page[Show], error) {
+		return c.api.DiscoverShows(ctx, filter)
+	})
+}
+
+func (c *cachedAPI) GetPerson(ctx context.Context, ref int) (*DetailedPerson, error) {
+	key := "/person/" + strconv.Itoa(ref)
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*DetailedPerson, error) {
+		return c.api.GetPerson(ctx, ref)
+	})
+}
+
+func (c *cachedAPI) GetPersonMovieCredits(ctx context.Context, ref int) (*PersonMovieCredits, error) {
+	key := "/person/" + strconv.Itoa(ref) + "/movie_credits"
+	return cached(ctx, c, key, ttlCredits, func(ctx context.Context) (*PersonMovieCredits, error) {
+		return c.api.GetPersonMovieCredits(ctx, ref)
+	})
+}
+
+func (c *cachedAPI) GetPersonShowCredits(ctx context.Context, ref int) (*PersonShowCredits, error) {
+	key := "/person/" + strconv.Itoa(ref) + "/tv_credits"
+	return cached(ctx, c, key, ttlCredits, func(ctx context.Context) (*PersonShowCredits, error) {
+		return c.api.GetPersonShowCredits(ctx, ref)
+	})
+}
+
+func (c *cachedAPI) GetMovieWatchProviders(ctx context.Context, ref int) (*WatchProviders, error) {
+	key := "/movie/" + strconv.Itoa(ref) + "/watch/providers"
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*WatchProviders, error) {
+		return c.api.GetMovieWatchProviders(ctx, ref)
+	})
+}
+
+func (c *cachedAPI) GetShowWatchProviders(ctx context.Context, ref int) (*WatchProviders, error) {
+	key := "/tv/" + strconv.Itoa(ref) + "/watch/providers"
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*WatchProviders, error) {
+		return c.api.GetShowWatchProviders(ctx, ref)
+	})
+}
+
+func (c *cachedAPI) GetTrending(ctx context.Context, trendingType TrendingType, window TrendingWindow, page int) (*Page[MultiResult], error) {
+	key := "/trending/" + string(trendingType) + "/" + string(window) + "?page=" + strconv.Itoa(page)
+	return cached(ctx, c, key, ttlList, func(ctx context.Context) (*Page[MultiResult], error) {
+		return c.api.GetTrending(ctx, trendingType, window, page)
+	})
+}
+
+// cached returns the value stored under key if it hasn't expired, otherwise it calls fetch and stores the result.
+// Concurrent callers asking for the same key while fetch is running wait for and share its result,
+// unless their own ctx is done first. fetch runs detached from the caller that started it, so one caller
+// going away doesn't fail the others, and the result is still cached for the next ones.
+func cached[T any](ctx context.Context, c *cachedAPI, key string, ttl time.Duration, fetch func(context.Context) (T, error)) (T, error) {
+	if value, err, ok := c.get(key); ok {
+		c.hits.Add(1)
+		return typed[T](value, err)
+	}
+
+	c.mu.Lock()
+	call, ok := c.inflight[key]
+	if ok {
+		c.coalesced.Add(1)
+	} else {
+		call = &inflightCall{done: make(chan struct{})}
+		c.inflight[key] = call
+		c.misses.Add(1)
+		go c.fetch(context.WithoutCancel(ctx), key, ttl, call, func(ctx context.Context) (any, error) {
+			return fetch(ctx)
+		})
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return typed[T](call.value, call.err)
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// fetch runs the shared fetch of call and stores its result, ctx only carrying values of the caller that started it.
+func (c *cachedAPI) fetch(ctx context.Context, key string, ttl time.Duration, call *inflightCall, fetch func(context.Context) (any, error)) {
+	ctx, cancel := context.WithTimeout(ctx, cacheFetchTimeout)
+	defer cancel()
+
+	value, err := fetch(ctx)
+	call.value, call.err = value, err
+
+	switch {
+	case err == nil:
+		c.set(key, value, nil, ttl)
+	case IsNotFound(err):
+		c.set(key, nil, err, ttlNotFound)
+	}
+
+	c.mu.Lock()
+	delete(c.inflight, key)
+	c.mu.Unlock()
+	close(call.done)
+}
+
+func typed[T any](value any, err error) (T, error) {
+	var zero T
+	if err != nil {
+		return zero, err
+	}
+	return value.(T), nil
+}
+
+func (c *cachedAPI) get(key string) (any, error, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, nil, false
+	}
+
+	entry := element.Value.(*cacheEntry)
+	if time.Now().After(entry.expires) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return nil, nil, false
+	}
+
+	c.order.MoveToFront(element)
+	return entry.value, entry.err, true
+}
+
+func (c *cachedAPI) set(key string, value any, err error, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry{key: key, value: value, err: err, expires: time.Now().Add(ttl)}
+	if element, ok := c.entries[key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+		c.evictions.Add(1)
+	}
+}
+
+// filterKey turns optional search filters into a stable cache key suffix.
+func filterKey[F any](filters []F) string {
+	if len(filters) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(filters[0])
+	return "&filter=" + string(b)
+}
+
+func (c *cachedAPI) GetMovieBundle(ctx context.Context, ref int, appends ...Append) (*MovieBundle, error) {
+	key := "/movie/" + strconv.Itoa(ref) + "?append_to_response=" + appendParam(appends)
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*MovieBundle, error) {
+		return c.api.GetMovieBundle(ctx, ref, appends...)
+	})
+}
+
+func (c *cachedAPI) GetMovieVideos(ctx context.Context, ref int) (*Videos, error) {
+	key := "/movie/" + strconv.Itoa(ref) + "/videos"
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*Videos, error) {
+		return c.api.GetMovieVideos(ctx, ref)
+	})
+}
+
+func (c *cachedAPI) GetMovieImages(ctx context.Context, ref int) (*Images, error) {
+	key := "/movie/" + strconv.Itoa(ref) + "/images"
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*Images, error) {
+		return c.api.GetMovieImages(ctx, ref)
+	})
+}
+
+func (c *cachedAPI) GetShowBundle(ctx context.Context, ref int, appends ...Append) (*ShowBundle, error) {
+	key := "/tv/" + strconv.Itoa(ref) + "?append_to_response=" + appendParam(appends)
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*ShowBundle, error) {
+		return c.api.GetShowBundle(ctx, ref, appends...)
+	})
+}
+
+func (c *cachedAPI) GetShowVideos(ctx context.Context, ref int) (*Videos, error) {
+	key := "/tv/" + strconv.Itoa(ref) + "/videos"
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*Videos, error) {
+		return c.api.GetShowVideos(ctx, ref)
+	})
+}
+
+func (c *cachedAPI) GetShowImages(ctx context.Context, ref int) (*Images, error) {
+	key := "/tv/" + strconv.Itoa(ref) + "/images"
+	return cached(ctx, c, key, ttlDetails, func(ctx context.Context) (*Images, error) {
+		return c.api.GetShowImages(ctx, ref)
+	})
+}
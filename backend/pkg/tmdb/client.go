@@ -0,0 +1,213 @@
+package tmdb
+
+import (
+	"cine/config"
+	"context"
+	"errors"
+	"github.com/go-resty/resty/v2"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	requestTimeout   = 10 * time.Second
+	retryWaitTime    = 250 * time.Millisecond
+	retryMaxWaitTime = 5 * time.Second
+
+	breakerThreshold = 5
+	breakerCooldown  = 30 * time.Second
+)
+
+// newClient builds the resty client used to talk to TMDB. Idempotent requests are retried with jittered
+// exponential backoff on transport errors, 429s and 5xxs, honoring TMDB's Retry-After header, and every
+// attempt (retries included) has to take a token from the client-side rate limiter before being sent.
+func newClient(config *config.Config) *resty.Client {
+	limiter := newTokenBucket(config.TMDBRateLimit, config.TMDBRateLimit)
+
+	return resty.New().
+		SetTimeout(requestTimeout).
+		SetRetryCount(config.TMDBMaxRetries).
+		SetRetryWaitTime(retryWaitTime).
+		SetRetryMaxWaitTime(retryMaxWaitTime).
+		SetRetryAfter(retryAfter).
+		AddRetryCondition(retryable).
+		OnBeforeRequest(func(_ *resty.Client, r *resty.Request) error {
+			return limiter.wait(r.Context())
+		})
+}
+
+func retryable(resp *resty.Response, err error) bool {
+	if resp != nil && resp.Request != nil && resp.Request.Method != http.MethodGet {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return unavailableStatus(resp.StatusCode())
+}
+
+func unavailableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// retryAfter reads the Retry-After header TMDB sends with 429s, either as seconds or as an HTTP date.
+// Returning 0 makes resty fall back to its jittered exponential backoff.
+func retryAfter(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
+	value := resp.Header().Get("Retry-After")
+	if value == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds)*time.Second + jitter(), nil
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date) + jitter(), nil
+	}
+	return 0, nil
+}
+
+func jitter() time.Duration {
+	return time.Duration(rand.Int63n(int64(retryWaitTime)))
+}
+
+// tokenBucket is a blocking token-bucket rate limiter, refilled continuously at rate tokens per second.
+type tokenBucket struct {
+	mu       sync.Mutex
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+func newTokenBucket(rate, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:     float64(rate),
+		capacity: float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+func (tb *tokenBucket) wait(ctx context.Context) error {
+	for {
+		delay := tb.reserve()
+		if delay == 0 {
+			return nil
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve takes a token if one is available, otherwise it returns how long until one will be.
+func (tb *tokenBucket) reserve() time.Duration {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+
+	now := time.Now()
+	tb.tokens = min(tb.capacity, tb.tokens+now.Sub(tb.last).Seconds()*tb.rate)
+	tb.last = now
+
+	if tb.tokens >= 1 {
+		tb.tokens--
+		return 0
+	}
+	return time.Duration((1 - tb.tokens) / tb.rate * float64(time.Second))
+}
+
+// breaker is a circuit breaker around TMDB. After breakerThreshold consecutive failed calls it opens and
+// rejects calls for breakerCooldown, after which a single probe call is let through to decide whether
+// to close again or stay open for another cooldown.
+type breaker struct {
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+var errBreakerOpen = errors.New("circuit breaker is open")
+
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < breakerThreshold {
+		return true
+	}
+	if b.probing || time.Since(b.openedAt) < breakerCooldown {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// release gives up a probe slot without recording a result.
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+func (b *breaker) record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if success {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= breakerThreshold {
+		b.openedAt = time.Now()
+	}
+}
+
+// request returns a request bound to ctx, with the headers every TMDB call needs.
+func (a *api) request(ctx context.Context) *resty.Request {
+	return a.client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetHeader("Authorization", "Bearer "+a.readToken)
+}
+
+// get sends request through the circuit breaker. Transport errors, and responses that are still
+// 429 or 5xx after retries, count as failures and are returned as ErrUnavailable. A request that
+// ends because its context was cancelled says nothing about TMDB and is not counted either way.
+func (a *api) get(request *resty.Request, endpoint string) (*resty.Response, error) {
+	if !a.breaker.allow() {
+		return nil, ErrorUnavailable(errBreakerOpen.Error())
+	}
+
+	resp, err := request.Get(a.url + endpoint)
+	if ctxErr := request.Context().Err(); ctxErr != nil {
+		a.breaker.release()
+		return nil, ErrorInternal(ctxErr.Error())
+	}
+	if err != nil {
+		a.breaker.record(false)
+		return nil, ErrorUnavailable(err.Error())
+	}
+	if unavailableStatus(resp.StatusCode()) {
+		a.breaker.record(false)
+		return nil, ErrorUnavailable("status " + strconv.Itoa(resp.StatusCode()) + ": " + prettyJSON(resp.Body()))
+	}
+
+	a.breaker.record(true)
+	return resp, nil
+}
+
+// failure converts an error from get into the error returned to callers, keeping ErrUnavailable distinct.
+func failure(err error, msg string) error {
+	if IsUnavailable(err) {
+		return ErrorUnavailable(msg)
+	}
+	return ErrorInternal(msg)
+}
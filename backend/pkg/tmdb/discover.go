@@ -0,0 +1,167 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"github.com/MarcusSanchez/go-parse"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type discoverAPI interface {
+	DiscoverMovies(ctx context.Context, filter DiscoverFilter) (*Page[Movie], error)
+	DiscoverShows(ctx context.Context, filter DiscoverFilter) (*Page[Show], error)
+}
+
+type DiscoverSort string
+
+const (
+	DiscoverSortPopularity  DiscoverSort = "popularity"
+	DiscoverSortRating      DiscoverSort = "rating"
+	DiscoverSortVoteCount   DiscoverSort = "voteCount"
+	DiscoverSortReleaseDate DiscoverSort = "releaseDate"
+	DiscoverSortTitle       DiscoverSort = "title"
+)
+
+// DiscoverFilter narrows down a discover request. Every field is optional, the zero value
+// discovers everything sorted by popularity.
+type DiscoverFilter struct {
+	Genres           []Genre
+	YearFrom         *int
+	YearTo           *int
+	MinRating        *float64
+	MinVoteCount     *int
+	MinRuntime       *int
+	MaxRuntime       *int
+	OriginalLanguage *string
+	SortBy           *DiscoverSort
+	Ascending        bool
+	WatchProviders   []int
+	WatchRegion      *string
+	Page             *int
+}
+
+func (a *api) DiscoverMovies(ctx context.Context, filter DiscoverFilter) (*Page[Movie], error) {
+	endpoint := "/discover/movie"
+
+	request := a.request(ctx)
+	filter.apply(request.QueryParam, "primary_release_date", "title")
+
+	resp, err := a.get(request, endpoint)
+	if err != nil {
+		a.logger.Error("failed to discover movies", err)
+		return nil, failure(err, "failed to discover movies")
+	}
+
+	if !resp.IsSuccess() {
+		a.logger.Warn(
+			"discover movies response was not successful",
+			fmt.Sprintf("status: %d | body: %s", resp.StatusCode(), prettyJSON(resp.Body())),
+		)
+		return nil, ErrorInternal("failed to discover movies")
+	}
+
+	movies, err := parse.JSON[Page[Movie]](resp.Body())
+	if err != nil {
+		a.logger.Error("failed to parse discovered movies", err)
+		return nil, ErrorInternal("failed to discover movies")
+	}
+
+	return movies, nil
+}
+
+func (a *api) DiscoverShows(ctx context.Context, filter DiscoverFilter) (*Page[Show], error) {
+	endpoint := "/discover/tv"
+
+	request := a.request(ctx)
+	filter.apply(request.QueryParam, "first_air_date", "name")
+
+	resp, err := a.get(request, endpoint)
+	if err != nil {
+		a.logger.Error("failed to discover shows", err)
+		return nil, failure(err, "failed to discover shows")
+	}
+
+	if !resp.IsSuccess() {
+		a.logger.Warn(
+			"discover shows response was not successful",
+			fmt.Sprintf("status: %d | body: %s", resp.StatusCode(), prettyJSON(resp.Body())),
+		)
+		return nil, ErrorInternal("failed to discover shows")
+	}
+
+	shows, err := parse.JSON[Page[Show]](resp.Body())
+	if err != nil {
+		a.logger.Error("failed to parse discovered shows", err)
+		return nil, ErrorInternal("failed to discover shows")
+	}
+
+	return shows, nil
+}
+
+// apply sets the query parameters for the filter. Movies and shows name their date and title
+// fields differently, so those are passed in.
+func (f DiscoverFilter) apply(params url.Values, dateField, titleField string) {
+	if len(f.Genres) > 0 {
+		ids := make([]string, len(f.Genres))
+		for i, genre := range f.Genres {
+			ids[i] = strconv.Itoa(int(genre))
+		}
+		params.Set("with_genres", strings.Join(ids, ","))
+	}
+	if f.YearFrom != nil {
+		params.Set(dateField+".gte", strconv.Itoa(*f.YearFrom)+"-01-01")
+	}
+	if f.YearTo != nil {
+		params.Set(dateField+".lte", strconv.Itoa(*f.YearTo)+"-12-31")
+	}
+	if f.MinRating != nil {
+		params.Set("vote_average.gte", strconv.FormatFloat(*f.MinRating, 'f', -1, 64))
+	}
+	if f.MinVoteCount != nil {
+		params.Set("vote_count.gte", strconv.Itoa(*f.MinVoteCount))
+	}
+	if f.MinRuntime != nil {
+		params.Set("with_runtime.gte", strconv.Itoa(*f.MinRuntime))
+	}
+	if f.MaxRuntime != nil {
+		params.Set("with_runtime.lte", strconv.Itoa(*f.MaxRuntime))
+	}
+	if f.OriginalLanguage != nil {
+		params.Set("with_original_language", *f.OriginalLanguage)
+	}
+	if len(f.WatchProviders) > 0 {
+		ids := make([]string, len(f.WatchProviders))
+		for i, provider := range f.WatchProviders {
+			ids[i] = strconv.Itoa(provider)
+		}
+		// providers are OR'd, a title only has to be on one of them
+		params.Set("with_watch_providers", strings.Join(ids, "|"))
+	}
+	if f.WatchRegion != nil {
+		params.Set("watch_region", *f.WatchRegion)
+	}
+	if f.Page != nil {
+		params.Set("page", strconv.Itoa(*f.Page))
+	}
+
+	sortBy := "popularity"
+	if f.SortBy != nil {
+		switch *f.SortBy {
+		case DiscoverSortRating:
+			sortBy = "vote_average"
+		case DiscoverSortVoteCount:
+			sortBy = "vote_count"
+		case DiscoverSortReleaseDate:
+			sortBy = dateField
+		case DiscoverSortTitle:
+			sortBy = titleField
+		}
+	}
+	if f.Ascending {
+		params.Set("sort_by", sortBy+".asc")
+	} else {
+		params.Set("sort_by", sortBy+".desc")
+	}
+}
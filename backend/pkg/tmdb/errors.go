@@ -6,8 +6,9 @@ import (
 )
 
 var (
-	ErrNotFound = errors.New("not found")
-	ErrInternal = errors.New("internal error")
+	ErrNotFound    = errors.New("not found")
+	ErrInternal    = errors.New("internal error")
+	ErrUnavailable = errors.New("unavailable")
 )
 
 func ErrorNotFound(msg string) error {
@@ -18,6 +19,10 @@ func ErrorInternal(msg string) error {
 	return fmt.Errorf("%w: %s", ErrInternal, msg)
 }
 
+func ErrorUnavailable(msg string) error {
+	return fmt.Errorf("%w: %s", ErrUnavailable, msg)
+}
+
 func IsNotFound(err error) bool {
 	return errors.Is(err, ErrNotFound)
 }
@@ -25,3 +30,7 @@ func IsNotFound(err error) bool {
 func IsInternal(err error) bool {
 	return errors.Is(err, ErrInternal)
 }
+
+func IsUnavailable(err error) bool {
+	return errors.Is(err, ErrUnavailable)
+}
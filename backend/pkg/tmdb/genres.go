@@ -92,3 +92,8 @@ func (g Genre) String() string {
 		return "Unknown"
 	}
 }
+
+// Valid reports whether g is one of the genres TMDB knows about.
+func (g Genre) Valid() bool {
+	return g.String() != "Unknown"
+}
@@ -0,0 +1,21 @@
+package tmdb
+
+import (
+	"context"
+	"strconv"
+)
+
+type imageAPI interface {
+	GetMovieImages(ctx context.Context, ref int) (*Images, error)
+	GetShowImages(ctx context.Context, ref int) (*Images, error)
+}
+
+func (a *api) GetMovieImages(ctx context.Context, ref int) (*Images, error) {
+	endpoint := "/movie/" + strconv.Itoa(ref) + "/images"
+	return refRequest[Images](ctx, a, endpoint, "movie images", ref)
+}
+
+func (a *api) GetShowImages(ctx context.Context, ref int) (*Images, error) {
+	endpoint := "/tv/" + strconv.Itoa(ref) + "/images"
+	return refRequest[Images](ctx, a, endpoint, "show images", ref)
+}
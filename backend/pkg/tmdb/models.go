@@ -1,5 +1,16 @@
 package tmdb
 
+// MaxPage is the highest page TMDB will return for any list or search.
+const MaxPage = 500
+
+// Page is a single page of a paginated TMDB response, along with the totals needed to page through the rest.
+type Page[T any] struct {
+	Page         int `json:"page"`
+	Results      []T `json:"results"`
+	TotalPages   int `json:"total_pages"`
+	TotalResults int `json:"total_results"`
+}
+
 type Movie struct {
 	ID           int     `json:"id"`
 	Overview     string  `json:"overview"`
@@ -218,6 +229,264 @@ type Episode struct {
 	VoteCount      int     `json:"vote_count"`
 }
 
+type Person struct {
+	ID                 int     `json:"id"`
+	Gender             int     `json:"gender"`
+	KnownForDepartment string  `json:"known_for_department"`
+	Name               string  `json:"name"`
+	OriginalName       string  `json:"original_name"`
+	Popularity         float64 `json:"popularity"`
+	ProfilePath        *string `json:"profile_path"`
+}
+
+type DetailedPerson struct {
+	AlsoKnownAs        []string `json:"also_known_as"`
+	Biography          string   `json:"biography"`
+	Birthday           *string  `json:"birthday,optional"`
+	Deathday           *string  `json:"deathday,optional"`
+	Gender             int      `json:"gender"`
+	Homepage           *string  `json:"homepage,optional"`
+	ID                 int      `json:"id"`
+	IMDbID             *string  `json:"imdb_id,optional"`
+	KnownForDepartment string   `json:"known_for_department"`
+	Name               string   `json:"name"`
+	PlaceOfBirth       *string  `json:"place_of_birth,optional"`
+	Popularity         float64  `json:"popularity"`
+	ProfilePath        *string  `json:"profile_path,optional"`
+}
+
+type PersonMovieCredits struct {
+	ID   int               `json:"id"`
+	Cast []PersonMovieCast `json:"cast"`
+	Crew []PersonMovieCrew `json:"crew"`
+}
+
+type PersonMovieCast struct {
+	ID            int     `json:"id"`
+	Title         string  `json:"title"`
+	OriginalTitle string  `json:"original_title"`
+	Overview      string  `json:"overview"`
+	GenreIDs      []int   `json:"genre_ids"`
+	BackdropPath  *string `json:"backdrop_path"`
+	PosterPath    *string `json:"poster_path"`
+	ReleaseDate   string  `json:"release_date"`
+	Popularity    float64 `json:"popularity"`
+	VoteAverage   float64 `json:"vote_average"`
+	VoteCount     int     `json:"vote_count"`
+	Character     string  `json:"character"`
+	CreditID      string  `json:"credit_id"`
+	Order         int     `json:"order"`
+}
+
+type PersonMovieCrew struct {
+	ID            int     `json:"id"`
+	Title         string  `json:"title"`
+	OriginalTitle string  `json:"original_title"`
+	Overview      string  `json:"overview"`
+	GenreIDs      []int   `json:"genre_ids"`
+	BackdropPath  *string `json:"backdrop_path"`
+	PosterPath    *string `json:"poster_path"`
+	ReleaseDate   string  `json:"release_date"`
+	Popularity    float64 `json:"popularity"`
+	VoteAverage   float64 `json:"vote_average"`
+	VoteCount     int     `json:"vote_count"`
+	CreditID      string  `json:"credit_id"`
+	Department    string  `json:"department"`
+	Job           string  `json:"job"`
+}
+
+type PersonShowCredits struct {
+	ID   int              `json:"id"`
+	Cast []PersonShowCast `json:"cast"`
+	Crew []PersonShowCrew `json:"crew"`
+}
+
+type PersonShowCast struct {
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	OriginalName string  `json:"original_name"`
+	Overview     string  `json:"overview"`
+	GenreIDs     []int   `json:"genre_ids"`
+	BackdropPath *string `json:"backdrop_path"`
+	PosterPath   *string `json:"poster_path"`
+	FirstAirDate string  `json:"first_air_date"`
+	Popularity   float64 `json:"popularity"`
+	VoteAverage  float64 `json:"vote_average"`
+	VoteCount    int     `json:"vote_count"`
+	Character    string  `json:"character"`
+	CreditID     string  `json:"credit_id"`
+	EpisodeCount int     `json:"episode_count"`
+}
+
+type PersonShowCrew struct {
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	OriginalName string  `json:"original_name"`
+	Overview     string  `json:"overview"`
+	GenreIDs     []int   `json:"genre_ids"`
+	BackdropPath *string `json:"backdrop_path"`
+	PosterPath   *string `json:"poster_path"`
+	FirstAirDate string  `json:"first_air_date"`
+	Popularity   float64 `json:"popularity"`
+	VoteAverage  float64 `json:"vote_average"`
+	VoteCount    int     `json:"vote_count"`
+	CreditID     string  `json:"credit_id"`
+	Department   string  `json:"department"`
+	Job          string  `json:"job"`
+	EpisodeCount int     `json:"episode_count"`
+}
+
+type Kind string
+
+const (
+	KindMovie  Kind = "movie"
+	KindShow   Kind = "show"
+	KindPerson Kind = "person"
+)
+
+// MultiResult is a tagged union of the results returned by endpoints that mix movies, shows and people.
+// Kind says which one of Movie, Show or Person is set, the other two are always nil.
+type MultiResult struct {
+	Kind   Kind    `json:"kind"`
+	Movie  *Movie  `json:"movie,omitempty"`
+	Show   *Show   `json:"show,omitempty"`
+	Person *Person `json:"person,omitempty"`
+}
+
+type VideoSite string
+
+const (
+	VideoSiteYouTube VideoSite = "YouTube"
+	VideoSiteVimeo   VideoSite = "Vimeo"
+)
+
+type VideoType string
+
+const (
+	VideoTypeTrailer         VideoType = "Trailer"
+	VideoTypeTeaser          VideoType = "Teaser"
+	VideoTypeClip            VideoType = "Clip"
+	VideoTypeFeaturette      VideoType = "Featurette"
+	VideoTypeBehindTheScenes VideoType = "Behind the Scenes"
+	VideoTypeBloopers        VideoType = "Bloopers"
+)
+
+// Video is hosted on Site, Key is the id of the video there (e.g. youtube.com/watch?v={Key}).
+type Video struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Site        VideoSite `json:"site"`
+	Key         string    `json:"key"`
+	Type        VideoType `json:"type"`
+	Official    bool      `json:"official"`
+	Size        int       `json:"size"`
+	Language    string    `json:"iso_639_1"`
+	Region      string    `json:"iso_3166_1"`
+	PublishedAt string    `json:"published_at"`
+}
+
+type Videos struct {
+	ID      int     `json:"id"`
+	Results []Video `json:"results"`
+}
+
+// Image is a single poster, backdrop or logo. Language is nil for images without any text on them.
+type Image struct {
+	FilePath    string  `json:"file_path"`
+	AspectRatio float64 `json:"aspect_ratio"`
+	Width       int     `json:"width"`
+	Height      int     `json:"height"`
+	Language    *string `json:"iso_639_1"`
+	VoteAverage float64 `json:"vote_average"`
+	VoteCount   int     `json:"vote_count"`
+}
+
+type Images struct {
+	ID        int     `json:"id"`
+	Backdrops []Image `json:"backdrops"`
+	Logos     []Image `json:"logos,optional"`
+	Posters   []Image `json:"posters"`
+}
+
+// Append is a sub-resource that can be requested along with a title's details with append_to_response.
+type Append string
+
+const (
+	AppendCredits Append = "credits"
+	AppendVideos  Append = "videos"
+	AppendImages  Append = "images"
+)
+
+func (a Append) Valid() bool {
+	switch a {
+	case AppendCredits, AppendVideos, AppendImages:
+		return true
+	default:
+		return false
+	}
+}
+
+// MovieBundle is a movie's details with the sub-resources that were appended to the request.
+// Sub-resources that weren't asked for are nil.
+type MovieBundle struct {
+	*DetailedMovie
+	Credits *MovieCredits `json:"credits,omitempty"`
+	Videos  *Videos       `json:"videos,omitempty"`
+	Images  *Images       `json:"images,omitempty"`
+}
+
+// ShowBundle is a show's details with the sub-resources that were appended to the request.
+// Sub-resources that weren't asked for are nil.
+type ShowBundle struct {
+	*DetailedShow
+	Credits *ShowCredits `json:"credits,omitempty"`
+	Videos  *Videos      `json:"videos,omitempty"`
+	Images  *Images      `json:"images,omitempty"`
+}
+
+type WatchProvider struct {
+	ID              int     `json:"provider_id"`
+	Name            string  `json:"provider_name"`
+	LogoPath        *string `json:"logo_path"`
+	DisplayPriority int     `json:"display_priority"`
+}
+
+// RegionProviders are the offers for a title in a single region. An offer type
+// with no providers is left empty.
+type RegionProviders struct {
+	Link     string          `json:"link"`
+	Flatrate []WatchProvider `json:"flatrate"`
+	Rent     []WatchProvider `json:"rent"`
+	Buy      []WatchProvider `json:"buy"`
+}
+
+// Streams reports whether any of the given providers offer the title as part of a subscription.
+func (r *RegionProviders) Streams(providers []int) bool {
+	for _, offer := range r.Flatrate {
+		for _, provider := range providers {
+			if offer.ID == provider {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WatchProviders holds the offers for a title keyed by ISO 3166-1 region code.
+type WatchProviders struct {
+	ID      int                        `json:"id"`
+	Results map[string]RegionProviders `json:"results"`
+}
+
+// Region returns the offers for region, or nil if the title isn't available there.
+func (w *WatchProviders) Region(region string) *RegionProviders {
+	providers, ok := w.Results[region]
+	if !ok {
+		return nil
+	}
+	return &providers
+}
+
 type MovieList string
 
 const (
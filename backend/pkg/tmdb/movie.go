@@ -1,6 +1,8 @@
 package tmdb
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/MarcusSanchez/go-parse"
 	"net/http"
@@ -8,25 +10,25 @@ import (
 )
 
 type movieAPI interface {
-	GetMovie(ref int) (*DetailedMovie, error)
-	GetMovieCredits(ref int) (*MovieCredits, error)
-
-	ListNowPlayingMovies() ([]Movie, error)
-	ListPopularMovies() ([]Movie, error)
-	ListTopRatedMovies() ([]Movie, error)
-	ListUpcomingMovies() ([]Movie, error)
+	GetMovie(ctx context.Context, ref int) (*DetailedMovie, error)
+	GetMovieBundle(ctx context.Context, ref int, appends ...Append) (*MovieBundle, error)
+	GetMovieCredits(ctx context.Context, ref int) (*MovieCredits, error)
+	GetMovieRecommendations(ctx context.Context, ref int, page int) (*Page[Movie], error)
+	GetSimilarMovies(ctx context.Context, ref int, page int) (*Page[Movie], error)
+
+	ListNowPlayingMovies(ctx context.Context, page int) (*Page[Movie], error)
+	ListPopularMovies(ctx context.Context, page int) (*Page[Movie], error)
+	ListTopRatedMovies(ctx context.Context, page int) (*Page[Movie], error)
+	ListUpcomingMovies(ctx context.Context, page int) (*Page[Movie], error)
 }
 
-func (a *api) GetMovie(ref int) (*DetailedMovie, error) {
+func (a *api) GetMovie(ctx context.Context, ref int) (*DetailedMovie, error) {
 	endpoint := "/movie/" + strconv.Itoa(ref)
 
-	resp, err := a.client.R().
-		SetHeader("Accept", "application/json").
-		SetHeader("Authorization", "Bearer "+a.readToken).
-		Get(url + endpoint)
+	resp, err := a.get(a.request(ctx), endpoint)
 	if err != nil {
 		a.logger.Error("failed to fetch movie by ref: "+strconv.Itoa(ref), err)
-		return nil, ErrorInternal("failed to fetch movie by ref: " + strconv.Itoa(ref))
+		return nil, failure(err, "failed to fetch movie by ref: "+strconv.Itoa(ref))
 	}
 
 	if !resp.IsSuccess() {
@@ -51,16 +53,54 @@ func (a *api) GetMovie(ref int) (*DetailedMovie, error) {
 	return movie, nil
 }
 
-func (a *api) GetMovieCredits(ref int) (*MovieCredits, error) {
+// GetMovieBundle fetches the movie's details together with the appended sub-resources in one round trip.
+func (a *api) GetMovieBundle(ctx context.Context, ref int, appends ...Append) (*MovieBundle, error) {
+	endpoint := "/movie/" + strconv.Itoa(ref)
+
+	body, err := a.appendedRequest(ctx, endpoint, "movie", ref, appends)
+	if err != nil {
+		return nil, err
+	}
+
+	movie, err := parse.JSON[DetailedMovie](body)
+	if err != nil {
+		a.logger.Error("failed to parse movie by ref: "+strconv.Itoa(ref), err)
+		return nil, ErrorInternal("failed to fetch movie by ref: " + strconv.Itoa(ref))
+	}
+
+	// appended sub-resources are nested under their name, and are missing if they weren't asked for
+	var appended struct {
+		Credits *MovieCredits `json:"credits"`
+		Videos  *Videos       `json:"videos"`
+		Images  *Images       `json:"images"`
+	}
+	if err = json.Unmarshal(body, &appended); err != nil {
+		a.logger.Error("failed to parse appended movie resources by ref: "+strconv.Itoa(ref), err)
+		return nil, ErrorInternal("failed to fetch movie by ref: " + strconv.Itoa(ref))
+	}
+
+	bundle := &MovieBundle{DetailedMovie: movie, Credits: appended.Credits, Videos: appended.Videos, Images: appended.Images}
+	// nested sub-resources don't carry the id the standalone endpoints do
+	if bundle.Credits != nil {
+		bundle.Credits.ID = ref
+	}
+	if bundle.Videos != nil {
+		bundle.Videos.ID = ref
+	}
+	if bundle.Images != nil {
+		bundle.Images.ID = ref
+	}
+
+	return bundle, nil
+}
+
+func (a *api) GetMovieCredits(ctx context.Context, ref int) (*MovieCredits, error) {
 	endpoint := "/movie/" + strconv.Itoa(ref) + "/credits"
 
-	resp, err := a.client.R().
-		SetHeader("Accept", "application/json").
-		SetHeader("Authorization", "Bearer "+a.readToken).
-		Get(url + endpoint)
+	resp, err := a.get(a.request(ctx), endpoint)
 	if err != nil {
 		a.logger.Error("failed to fetch movie credits by ref: "+strconv.Itoa(ref), err)
-		return nil, ErrorInternal("failed to fetch movie credits by ref: " + strconv.Itoa(ref))
+		return nil, failure(err, "failed to fetch movie credits by ref: "+strconv.Itoa(ref))
 	}
 
 	if !resp.IsSuccess() {
@@ -85,34 +125,44 @@ func (a *api) GetMovieCredits(ref int) (*MovieCredits, error) {
 	return credits, nil
 }
 
-func (a *api) ListNowPlayingMovies() ([]Movie, error) {
+func (a *api) GetMovieRecommendations(ctx context.Context, ref int, page int) (*Page[Movie], error) {
+	endpoint := "/movie/" + strconv.Itoa(ref) + "/recommendations"
+	return refListRequest[Movie](ctx, a, endpoint, "movie recommendations", ref, page)
+}
+
+func (a *api) GetSimilarMovies(ctx context.Context, ref int, page int) (*Page[Movie], error) {
+	endpoint := "/movie/" + strconv.Itoa(ref) + "/similar"
+	return refListRequest[Movie](ctx, a, endpoint, "similar movies", ref, page)
+}
+
+func (a *api) ListNowPlayingMovies(ctx context.Context, page int) (*Page[Movie], error) {
 	endpoint := "/movie/now_playing"
-	return a.movieListRequest(endpoint, "now-playing")
+	return a.movieListRequest(ctx, endpoint, "now-playing", page)
 }
 
-func (a *api) ListPopularMovies() ([]Movie, error) {
+func (a *api) ListPopularMovies(ctx context.Context, page int) (*Page[Movie], error) {
 	endpoint := "/movie/popular"
-	return a.movieListRequest(endpoint, "popular")
+	return a.movieListRequest(ctx, endpoint, "popular", page)
 }
 
-func (a *api) ListTopRatedMovies() ([]Movie, error) {
+func (a *api) ListTopRatedMovies(ctx context.Context, page int) (*Page[Movie], error) {
 	endpoint := "/movie/top_rated"
-	return a.movieListRequest(endpoint, "top-rated")
+	return a.movieListRequest(ctx, endpoint, "top-rated", page)
 }
 
-func (a *api) ListUpcomingMovies() ([]Movie, error) {
+func (a *api) ListUpcomingMovies(ctx context.Context, page int) (*Page[Movie], error) {
 	endpoint := "/movie/upcoming"
-	return a.movieListRequest(endpoint, "upcoming")
+	return a.movieListRequest(ctx, endpoint, "upcoming", page)
 }
 
-func (a *api) movieListRequest(endpoint, listName string) ([]Movie, error) {
-	resp, err := a.client.R().
-		SetHeader("Accept", "application/json").
-		SetHeader("Authorization", "Bearer "+a.readToken).
-		Get(url + endpoint)
+func (a *api) movieListRequest(ctx context.Context, endpoint, listName string, page int) (*Page[Movie], error) {
+	request := a.request(ctx).
+		SetQueryParam("page", strconv.Itoa(page))
+
+	resp, err := a.get(request, endpoint)
 	if err != nil {
 		a.logger.Error("failed to fetch '"+listName+"' movie-list", err)
-		return nil, ErrorInternal("failed to fetch '" + listName + "' movie-list")
+		return nil, failure(err, "failed to fetch '"+listName+"' movie-list")
 	}
 
 	if !resp.IsSuccess() {
@@ -123,15 +173,11 @@ func (a *api) movieListRequest(endpoint, listName string) ([]Movie, error) {
 		return nil, ErrorInternal("failed to fetch '" + listName + "' movie-list")
 	}
 
-	type Result struct {
-		Movies []Movie `json:"results"`
-	}
-
-	r, err := parse.JSON[Result](resp.Body())
+	movies, err := parse.JSON[Page[Movie]](resp.Body())
 	if err != nil {
 		a.logger.Error("failed to parse '"+listName+"' movie-list", err)
 		return nil, ErrorInternal("failed to fetch '" + listName + "' movie-list")
 	}
 
-	return r.Movies, nil
+	return movies, nil
 }
@@ -0,0 +1,108 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"github.com/MarcusSanchez/go-parse"
+	"net/http"
+	"strconv"
+)
+
+type personAPI interface {
+	GetPerson(ctx context.Context, ref int) (*DetailedPerson, error)
+	GetPersonMovieCredits(ctx context.Context, ref int) (*PersonMovieCredits, error)
+	GetPersonShowCredits(ctx context.Context, ref int) (*PersonShowCredits, error)
+}
+
+func (a *api) GetPerson(ctx context.Context, ref int) (*DetailedPerson, error) {
+	endpoint := "/person/" + strconv.Itoa(ref)
+
+	resp, err := a.get(a.request(ctx), endpoint)
+	if err != nil {
+		a.logger.Error("failed to fetch person by ref: "+strconv.Itoa(ref), err)
+		return nil, failure(err, "failed to fetch person by ref: "+strconv.Itoa(ref))
+	}
+
+	if !resp.IsSuccess() {
+		switch resp.StatusCode() {
+		case http.StatusNotFound:
+			return nil, ErrorNotFound("person by ref: " + strconv.Itoa(ref))
+		default:
+			a.logger.Warn(
+				"person by ref '"+strconv.Itoa(ref)+"' response was not successful",
+				fmt.Sprintf("status: %d | body: %s", resp.StatusCode(), prettyJSON(resp.Body())),
+			)
+			return nil, ErrorInternal("failed to fetch person by ref: " + strconv.Itoa(ref))
+		}
+	}
+
+	person, err := parse.JSON[DetailedPerson](resp.Body())
+	if err != nil {
+		a.logger.Error("failed to parse person by ref: "+strconv.Itoa(ref), err)
+		return nil, ErrorInternal("failed to fetch person by ref: " + strconv.Itoa(ref))
+	}
+
+	return person, nil
+}
+
+func (a *api) GetPersonMovieCredits(ctx context.Context, ref int) (*PersonMovieCredits, error) {
+	endpoint := "/person/" + strconv.Itoa(ref) + "/movie_credits"
+
+	resp, err := a.get(a.request(ctx), endpoint)
+	if err != nil {
+		a.logger.Error("failed to fetch person movie credits by ref: "+strconv.Itoa(ref), err)
+		return nil, failure(err, "failed to fetch person movie credits by ref: "+strconv.Itoa(ref))
+	}
+
+	if !resp.IsSuccess() {
+		switch resp.StatusCode() {
+		case http.StatusNotFound:
+			return nil, ErrorNotFound("person movie credits by ref: " + strconv.Itoa(ref))
+		default:
+			a.logger.Warn(
+				"person movie credits by ref '"+strconv.Itoa(ref)+"' response was not successful",
+				fmt.Sprintf("status: %d | body: %s", resp.StatusCode(), prettyJSON(resp.Body())),
+			)
+			return nil, ErrorInternal("failed to fetch person movie credits by ref: " + strconv.Itoa(ref))
+		}
+	}
+
+	credits, err := parse.JSON[PersonMovieCredits](resp.Body())
+	if err != nil {
+		a.logger.Error("failed to parse person movie credits by ref: "+strconv.Itoa(ref), err)
+		return nil, ErrorInternal("failed to fetch person movie credits by ref: " + strconv.Itoa(ref))
+	}
+
+	return credits, nil
+}
+
+func (a *api) GetPersonShowCredits(ctx context.Context, ref int) (*PersonShowCredits, error) {
+	endpoint := "/person/" + strconv.Itoa(ref) + "/tv_credits"
+
+	resp, err := a.get(a.request(ctx), endpoint)
+	if err != nil {
+		a.logger.Error("failed to fetch person show credits by ref: "+strconv.Itoa(ref), err)
+		return nil, failure(err, "failed to fetch person show credits by ref: "+strconv.Itoa(ref))
+	}
+
+	if !resp.IsSuccess() {
+		switch resp.StatusCode() {
+		case http.StatusNotFound:
+			return nil, ErrorNotFound("person show credits by ref: " + strconv.Itoa(ref))
+		default:
+			a.logger.Warn(
+				"person show credits by ref '"+strconv.Itoa(ref)+"' response was not successful",
+				fmt.Sprintf("status: %d | body: %s", resp.StatusCode(), prettyJSON(resp.Body())),
+			)
+			return nil, ErrorInternal("failed to fetch person show credits by ref: " + strconv.Itoa(ref))
+		}
+	}
+
+	credits, err := parse.JSON[PersonShowCredits](resp.Body())
+	if err != nil {
+		a.logger.Error("failed to parse person show credits by ref: "+strconv.Itoa(ref), err)
+		return nil, ErrorInternal("failed to fetch person show credits by ref: " + strconv.Itoa(ref))
+	}
+
+	return credits, nil
+}
@@ -1,14 +1,18 @@
 package tmdb
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/MarcusSanchez/go-parse"
 	"strconv"
 )
 
 type searchAPI interface {
-	SearchMovies(query string, filter ...SearchMovieFilter) ([]Movie, error)
-	SearchShows(query string, filter ...SearchShowFilter) ([]Show, error)
+	SearchMovies(ctx context.Context, query string, filter ...SearchMovieFilter) (*Page[Movie], error)
+	SearchShows(ctx context.Context, query string, filter ...SearchShowFilter) (*Page[Show], error)
+	SearchPeople(ctx context.Context, query string, filter ...SearchPeopleFilter) (*Page[Person], error)
+	SearchMulti(ctx context.Context, query string, filter ...SearchMultiFilter) (*Page[MultiResult], error)
 }
 
 type SearchMovieFilter struct {
@@ -19,12 +23,10 @@ type SearchMovieFilter struct {
 	Page               *int
 }
 
-func (a *api) SearchMovies(query string, filters ...SearchMovieFilter) ([]Movie, error) {
+func (a *api) SearchMovies(ctx context.Context, query string, filters ...SearchMovieFilter) (*Page[Movie], error) {
 	endpoint := "/search/movie"
 
-	request := a.client.R().
-		SetHeader("Authorization", "Bearer "+a.readToken).
-		SetHeader("Accept", "application/json").
+	request := a.request(ctx).
 		SetQueryParam("query", query)
 
 	if len(filters) > 0 {
@@ -46,10 +48,10 @@ func (a *api) SearchMovies(query string, filters ...SearchMovieFilter) ([]Movie,
 		}
 	}
 
-	resp, err := request.Get(url + endpoint)
+	resp, err := a.get(request, endpoint)
 	if err != nil {
 		a.logger.Error("failed to fetch movies for query: "+query, err)
-		return nil, ErrorInternal("failed to fetch movies for query: " + query)
+		return nil, failure(err, "failed to fetch movies for query: "+query)
 	}
 
 	if !resp.IsSuccess() {
@@ -60,17 +62,13 @@ func (a *api) SearchMovies(query string, filters ...SearchMovieFilter) ([]Movie,
 		return nil, ErrorInternal("failed to fetch movies for query: " + query)
 	}
 
-	type Result struct {
-		Movies []Movie `json:"results"`
-	}
-
-	r, err := parse.JSON[Result](resp.Body())
+	movies, err := parse.JSON[Page[Movie]](resp.Body())
 	if err != nil {
 		a.logger.Error("failed to parse movies for query: "+query, err)
 		return nil, ErrorInternal("failed to fetch movies for query: " + query)
 	}
 
-	return r.Movies, nil
+	return movies, nil
 }
 
 type SearchShowFilter struct {
@@ -80,12 +78,10 @@ type SearchShowFilter struct {
 	Page             *int
 }
 
-func (a *api) SearchShows(query string, filters ...SearchShowFilter) ([]Show, error) {
+func (a *api) SearchShows(ctx context.Context, query string, filters ...SearchShowFilter) (*Page[Show], error) {
 	endpoint := "/search/tv"
 
-	request := a.client.R().
-		SetHeader("Accept", "application/json").
-		SetHeader("Authorization", "Bearer "+a.readToken).
+	request := a.request(ctx).
 		SetQueryParam("query", query)
 
 	if len(filters) > 0 {
@@ -104,10 +100,10 @@ func (a *api) SearchShows(query string, filters ...SearchShowFilter) ([]Show, er
 		}
 	}
 
-	resp, err := request.Get(url + endpoint)
+	resp, err := a.get(request, endpoint)
 	if err != nil {
 		a.logger.Error("failed to fetch shows for query: "+query, err)
-		return nil, ErrorInternal("failed to fetch shows for query: " + query)
+		return nil, failure(err, "failed to fetch shows for query: "+query)
 	}
 
 	if resp.StatusCode() != 200 {
@@ -118,15 +114,105 @@ func (a *api) SearchShows(query string, filters ...SearchShowFilter) ([]Show, er
 		return nil, ErrorInternal("failed to fetch shows for query: " + query)
 	}
 
-	type Result struct {
-		Shows []Show `json:"results"`
-	}
-
-	r, err := parse.JSON[Result](resp.Body())
+	shows, err := parse.JSON[Page[Show]](resp.Body())
 	if err != nil {
 		a.logger.Error("failed to parse shows for query: "+query, err)
 		return nil, ErrorInternal("failed to fetch shows for query: " + query)
 	}
 
-	return r.Shows, nil
+	return shows, nil
+}
+
+type SearchPeopleFilter struct {
+	Language *string
+	Page     *int
+}
+
+func (a *api) SearchPeople(ctx context.Context, query string, filters ...SearchPeopleFilter) (*Page[Person], error) {
+	endpoint := "/search/person"
+
+	request := a.request(ctx).
+		SetQueryParam("query", query)
+
+	if len(filters) > 0 {
+		filter := filters[0]
+		if filter.Language != nil {
+			request.SetQueryParam("language", *filter.Language)
+		}
+		if filter.Page != nil {
+			request.SetQueryParam("page", strconv.Itoa(*filter.Page))
+		}
+	}
+
+	resp, err := a.get(request, endpoint)
+	if err != nil {
+		a.logger.Error("failed to fetch people for query: "+query, err)
+		return nil, failure(err, "failed to fetch people for query: "+query)
+	}
+
+	if !resp.IsSuccess() {
+		a.logger.Warn(
+			"people search query '"+query+"' response was not successful",
+			fmt.Sprintf("status: %d | body: %s", resp.StatusCode(), prettyJSON(resp.Body())),
+		)
+		return nil, ErrorInternal("failed to fetch people for query: " + query)
+	}
+
+	people, err := parse.JSON[Page[Person]](resp.Body())
+	if err != nil {
+		a.logger.Error("failed to parse people for query: "+query, err)
+		return nil, ErrorInternal("failed to fetch people for query: " + query)
+	}
+
+	return people, nil
+}
+
+type SearchMultiFilter struct {
+	Language *string
+	Page     *int
+}
+
+func (a *api) SearchMulti(ctx context.Context, query string, filters ...SearchMultiFilter) (*Page[MultiResult], error) {
+	endpoint := "/search/multi"
+
+	request := a.request(ctx).
+		SetQueryParam("query", query)
+
+	if len(filters) > 0 {
+		filter := filters[0]
+		if filter.Language != nil {
+			request.SetQueryParam("language", *filter.Language)
+		}
+		if filter.Page != nil {
+			request.SetQueryParam("page", strconv.Itoa(*filter.Page))
+		}
+	}
+
+	resp, err := a.get(request, endpoint)
+	if err != nil {
+		a.logger.Error("failed to fetch multi results for query: "+query, err)
+		return nil, failure(err, "failed to fetch multi results for query: "+query)
+	}
+
+	if !resp.IsSuccess() {
+		a.logger.Warn(
+			"multi search query '"+query+"' response was not successful",
+			fmt.Sprintf("status: %d | body: %s", resp.StatusCode(), prettyJSON(resp.Body())),
+		)
+		return nil, ErrorInternal("failed to fetch multi results for query: " + query)
+	}
+
+	raw, err := parse.JSON[Page[json.RawMessage]](resp.Body())
+	if err != nil {
+		a.logger.Error("failed to parse multi results for query: "+query, err)
+		return nil, ErrorInternal("failed to fetch multi results for query: " + query)
+	}
+
+	results, err := multiPage(raw)
+	if err != nil {
+		a.logger.Error("failed to parse multi results for query: "+query, err)
+		return nil, ErrorInternal("failed to fetch multi results for query: " + query)
+	}
+
+	return results, nil
 }
@@ -1,6 +1,8 @@
 package tmdb
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/MarcusSanchez/go-parse"
 	"net/http"
@@ -8,26 +10,26 @@ import (
 )
 
 type showAPI interface {
-	GetShow(ref int) (*DetailedShow, error)
-	GetShowCredits(ref int) (*ShowCredits, error)
-	GetShowSeasonDetails(ref int, seasonNumber int) (*DetailedSeason, error)
-
-	ListAiringTodayShows() ([]Show, error)
-	ListPopularShows() ([]Show, error)
-	ListTopRatedShows() ([]Show, error)
-	ListOnTheAirShows() ([]Show, error)
+	GetShow(ctx context.Context, ref int) (*DetailedShow, error)
+	GetShowBundle(ctx context.Context, ref int, appends ...Append) (*ShowBundle, error)
+	GetShowCredits(ctx context.Context, ref int) (*ShowCredits, error)
+	GetShowRecommendations(ctx context.Context, ref int, page int) (*Page[Show], error)
+	GetSimilarShows(ctx context.Context, ref int, page int) (*Page[Show], error)
+	GetShowSeasonDetails(ctx context.Context, ref int, seasonNumber int) (*DetailedSeason, error)
+
+	ListAiringTodayShows(ctx context.Context, page int) (*Page[Show], error)
+	ListPopularShows(ctx context.Context, page int) (*Page[Show], error)
+	ListTopRatedShows(ctx context.Context, page int) (*Page[Show], error)
+	ListOnTheAirShows(ctx context.Context, page int) (*Page[Show], error)
 }
 
-func (a *api) GetShow(ref int) (*DetailedShow, error) {
+func (a *api) GetShow(ctx context.Context, ref int) (*DetailedShow, error) {
 	endpoint := "/tv/" + strconv.Itoa(ref)
 
-	resp, err := a.client.R().
-		SetHeader("Accept", "application/json").
-		SetHeader("Authorization", "Bearer "+a.readToken).
-		Get(url + endpoint)
+	resp, err := a.get(a.request(ctx), endpoint)
 	if err != nil {
 		a.logger.Error("failed to fetch show by ref: "+strconv.Itoa(ref), err)
-		return nil, ErrorInternal("failed to fetch show by ref: " + strconv.Itoa(ref))
+		return nil, failure(err, "failed to fetch show by ref: "+strconv.Itoa(ref))
 	}
 
 	if !resp.IsSuccess() {
@@ -52,16 +54,54 @@ func (a *api) GetShow(ref int) (*DetailedShow, error) {
 	return show, nil
 }
 
-func (a *api) GetShowCredits(ref int) (*ShowCredits, error) {
+// GetShowBundle fetches the show's details together with the appended sub-resources in one round trip.
+func (a *api) GetShowBundle(ctx context.Context, ref int, appends ...Append) (*ShowBundle, error) {
+	endpoint := "/tv/" + strconv.Itoa(ref)
+
+	body, err := a.appendedRequest(ctx, endpoint, "show", ref, appends)
+	if err != nil {
+		return nil, err
+	}
+
+	show, err := parse.JSON[DetailedShow](body)
+	if err != nil {
+		a.logger.Error("failed to parse show by ref: "+strconv.Itoa(ref), err)
+		return nil, ErrorInternal("failed to fetch show by ref: " + strconv.Itoa(ref))
+	}
+
+	// appended sub-resources are nested under their name, and are missing if they weren't asked for
+	var appended struct {
+		Credits *ShowCredits `json:"credits"`
+		Videos  *Videos      `json:"videos"`
+		Images  *Images      `json:"images"`
+	}
+	if err = json.Unmarshal(body, &appended); err != nil {
+		a.logger.Error("failed to parse appended show resources by ref: "+strconv.Itoa(ref), err)
+		return nil, ErrorInternal("failed to fetch show by ref: " + strconv.Itoa(ref))
+	}
+
+	bundle := &ShowBundle{DetailedShow: show, Credits: appended.Credits, Videos: appended.Videos, Images: appended.Images}
+	// nested sub-resources don't carry the id the standalone endpoints do
+	if bundle.Credits != nil {
+		bundle.Credits.ID = ref
+	}
+	if bundle.Videos != nil {
+		bundle.Videos.ID = ref
+	}
+	if bundle.Images != nil {
+		bundle.Images.ID = ref
+	}
+
+	return bundle, nil
+}
+
+func (a *api) GetShowCredits(ctx context.Context, ref int) (*ShowCredits, error) {
 	endpoint := "/tv/" + strconv.Itoa(ref) + "/aggregate_credits"
 
-	resp, err := a.client.R().
-		SetHeader("Accept", "application/json").
-		SetHeader("Authorization", "Bearer "+a.readToken).
-		Get(url + endpoint)
+	resp, err := a.get(a.request(ctx), endpoint)
 	if err != nil {
 		a.logger.Error("failed to fetch show credits by ref: "+strconv.Itoa(ref), err)
-		return nil, ErrorInternal("failed to fetch show credits by ref: " + strconv.Itoa(ref))
+		return nil, failure(err, "failed to fetch show credits by ref: "+strconv.Itoa(ref))
 	}
 
 	if !resp.IsSuccess() {
@@ -86,16 +126,13 @@ func (a *api) GetShowCredits(ref int) (*ShowCredits, error) {
 	return credits, nil
 }
 
-func (a *api) GetShowSeasonDetails(ref int, seasonNumber int) (*DetailedSeason, error) {
+func (a *api) GetShowSeasonDetails(ctx context.Context, ref int, seasonNumber int) (*DetailedSeason, error) {
 	endpoint := "/tv/" + strconv.Itoa(ref) + "/season/" + strconv.Itoa(seasonNumber)
 
-	resp, err := a.client.R().
-		SetHeader("Accept", "application/json").
-		SetHeader("Authorization", "Bearer "+a.readToken).
-		Get(url + endpoint)
+	resp, err := a.get(a.request(ctx), endpoint)
 	if err != nil {
 		a.logger.Error("failed to fetch season details", err)
-		return nil, ErrorInternal("failed to fetch season details")
+		return nil, failure(err, "failed to fetch season details")
 	}
 
 	if !resp.IsSuccess() {
@@ -120,30 +157,40 @@ func (a *api) GetShowSeasonDetails(ref int, seasonNumber int) (*DetailedSeason,
 	return detailedSeason, nil
 }
 
-func (a *api) ListAiringTodayShows() ([]Show, error) {
-	return a.showListRequest("/tv/airing_today", "airing-today")
+func (a *api) GetShowRecommendations(ctx context.Context, ref int, page int) (*Page[Show], error) {
+	endpoint := "/tv/" + strconv.Itoa(ref) + "/recommendations"
+	return refListRequest[Show](ctx, a, endpoint, "show recommendations", ref, page)
+}
+
+func (a *api) GetSimilarShows(ctx context.Context, ref int, page int) (*Page[Show], error) {
+	endpoint := "/tv/" + strconv.Itoa(ref) + "/similar"
+	return refListRequest[Show](ctx, a, endpoint, "similar shows", ref, page)
+}
+
+func (a *api) ListAiringTodayShows(ctx context.Context, page int) (*Page[Show], error) {
+	return a.showListRequest(ctx, "/tv/airing_today", "airing-today", page)
 }
 
-func (a *api) ListPopularShows() ([]Show, error) {
-	return a.showListRequest("/tv/popular", "popular")
+func (a *api) ListPopularShows(ctx context.Context, page int) (*Page[Show], error) {
+	return a.showListRequest(ctx, "/tv/popular", "popular", page)
 }
 
-func (a *api) ListTopRatedShows() ([]Show, error) {
-	return a.showListRequest("/tv/top_rated", "top-rated")
+func (a *api) ListTopRatedShows(ctx context.Context, page int) (*Page[Show], error) {
+	return a.showListRequest(ctx, "/tv/top_rated", "top-rated", page)
 }
 
-func (a *api) ListOnTheAirShows() ([]Show, error) {
-	return a.showListRequest("/tv/on_the_air", "on-the-air")
+func (a *api) ListOnTheAirShows(ctx context.Context, page int) (*Page[Show], error) {
+	return a.showListRequest(ctx, "/tv/on_the_air", "on-the-air", page)
 }
 
-func (a *api) showListRequest(endpoint, listName string) ([]Show, error) {
-	resp, err := a.client.R().
-		SetHeader("Accept", "application/json").
-		SetHeader("Authorization", "Bearer "+a.readToken).
-		Get(url + endpoint)
+func (a *api) showListRequest(ctx context.Context, endpoint, listName string, page int) (*Page[Show], error) {
+	request := a.request(ctx).
+		SetQueryParam("page", strconv.Itoa(page))
+
+	resp, err := a.get(request, endpoint)
 	if err != nil {
 		a.logger.Error("failed to fetch '"+listName+"' show-list", err)
-		return nil, ErrorInternal("failed to fetch '" + listName + "' show-list")
+		return nil, failure(err, "failed to fetch '"+listName+"' show-list")
 	}
 
 	if !resp.IsSuccess() {
@@ -154,15 +201,11 @@ func (a *api) showListRequest(endpoint, listName string) ([]Show, error) {
 		return nil, ErrorInternal("failed to fetch '" + listName + "' show-list")
 	}
 
-	type Result struct {
-		Shows []Show `json:"results"`
-	}
-
-	r, err := parse.JSON[Result](resp.Body())
+	shows, err := parse.JSON[Page[Show]](resp.Body())
 	if err != nil {
 		a.logger.Error("failed to parse '"+listName+"' show-list", err)
 		return nil, ErrorInternal("failed to parse '" + listName + "' show-list")
 	}
 
-	return r.Shows, nil
+	return shows, nil
 }
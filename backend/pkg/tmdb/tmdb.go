@@ -7,26 +7,34 @@ import (
 	"github.com/go-resty/resty/v2"
 )
 
-const url = "https://api.themoviedb.org/3"
-
 type API interface {
 	searchAPI
 	movieAPI
 	showAPI
+	discoverAPI
+	personAPI
+	trendingAPI
+	watchProviderAPI
+	videoAPI
+	imageAPI
 }
 
 type api struct {
+	url       string
 	key       string
 	readToken string
 	logger    logger.Logger
 	client    *resty.Client
+	breaker   *breaker
 }
 
 func NewTheMovieDatabaseAPI(config *config.Config, logger logger.Logger) API {
 	return &api{
+		url:       config.TMDBURL,
 		key:       config.TMDBApiKey,
 		readToken: config.TMDBReadToken,
 		logger:    logger,
-		client:    resty.New(),
+		client:    newClient(config),
+		breaker:   new(breaker),
 	}
 }
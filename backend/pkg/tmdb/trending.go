@@ -0,0 +1,69 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/MarcusSanchez/go-parse"
+	"strconv"
+)
+
+type trendingAPI interface {
+	GetTrending(ctx context.Context, trendingType TrendingType, window TrendingWindow, page int) (*Page[MultiResult], error)
+}
+
+type TrendingWindow string
+
+const (
+	TrendingWindowDay  TrendingWindow = "day"
+	TrendingWindowWeek TrendingWindow = "week"
+)
+
+type TrendingType string
+
+const (
+	TrendingAll    TrendingType = "all"
+	TrendingMovie  TrendingType = "movie"
+	TrendingShow   TrendingType = "show"
+	TrendingPerson TrendingType = "person"
+)
+
+func (a *api) GetTrending(ctx context.Context, trendingType TrendingType, window TrendingWindow, page int) (*Page[MultiResult], error) {
+	mediaType := string(trendingType)
+	if trendingType == TrendingShow {
+		mediaType = "tv"
+	}
+	endpoint := "/trending/" + mediaType + "/" + string(window)
+	listName := string(trendingType) + "/" + string(window)
+
+	request := a.request(ctx).
+		SetQueryParam("page", strconv.Itoa(page))
+
+	resp, err := a.get(request, endpoint)
+	if err != nil {
+		a.logger.Error("failed to fetch '"+listName+"' trending-list", err)
+		return nil, failure(err, "failed to fetch '"+listName+"' trending-list")
+	}
+
+	if !resp.IsSuccess() {
+		a.logger.Warn(
+			"fetched '"+listName+"' trending-list response was not successful",
+			fmt.Sprintf("status: %d | body: %s", resp.StatusCode(), prettyJSON(resp.Body())),
+		)
+		return nil, ErrorInternal("failed to fetch '" + listName + "' trending-list")
+	}
+
+	raw, err := parse.JSON[Page[json.RawMessage]](resp.Body())
+	if err != nil {
+		a.logger.Error("failed to parse '"+listName+"' trending-list", err)
+		return nil, ErrorInternal("failed to fetch '" + listName + "' trending-list")
+	}
+
+	trending, err := multiPage(raw)
+	if err != nil {
+		a.logger.Error("failed to parse '"+listName+"' trending-list", err)
+		return nil, ErrorInternal("failed to fetch '" + listName + "' trending-list")
+	}
+
+	return trending, nil
+}
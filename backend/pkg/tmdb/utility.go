@@ -2,7 +2,14 @@ package tmdb
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"github.com/MarcusSanchez/go-parse"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 func prettyJSON(body []byte) string {
@@ -13,3 +20,159 @@ func prettyJSON(body []byte) string {
 	}
 	return string(buf.Bytes())
 }
+
+// refListRequest fetches a page of titles related to the title with the given ref,
+// such as its recommendations or similar titles.
+func refListRequest[T any](ctx context.Context, a *api, endpoint, listName string, ref, page int) (*Page[T], error) {
+	request := a.request(ctx).
+		SetQueryParam("page", strconv.Itoa(page))
+
+	resp, err := a.get(request, endpoint)
+	if err != nil {
+		a.logger.Error("failed to fetch "+listName+" by ref: "+strconv.Itoa(ref), err)
+		return nil, failure(err, "failed to fetch "+listName+" by ref: "+strconv.Itoa(ref))
+	}
+
+	if !resp.IsSuccess() {
+		switch resp.StatusCode() {
+		case http.StatusNotFound:
+			return nil, ErrorNotFound(listName + " by ref: " + strconv.Itoa(ref))
+		default:
+			a.logger.Warn(
+				listName+" by ref '"+strconv.Itoa(ref)+"' response was not successful",
+				fmt.Sprintf("status: %d | body: %s", resp.StatusCode(), prettyJSON(resp.Body())),
+			)
+			return nil, ErrorInternal("failed to fetch " + listName + " by ref: " + strconv.Itoa(ref))
+		}
+	}
+
+	results, err := parse.JSON[Page[T]](resp.Body())
+	if err != nil {
+		a.logger.Error("failed to parse "+listName+" by ref: "+strconv.Itoa(ref), err)
+		return nil, ErrorInternal("failed to fetch " + listName + " by ref: " + strconv.Itoa(ref))
+	}
+
+	return results, nil
+}
+
+// refRequest fetches a sub-resource of the title or person with the given ref, such as its videos or images.
+func refRequest[T any](ctx context.Context, a *api, endpoint, resourceName string, ref int) (*T, error) {
+	resp, err := a.get(a.request(ctx), endpoint)
+	if err != nil {
+		a.logger.Error("failed to fetch "+resourceName+" by ref: "+strconv.Itoa(ref), err)
+		return nil, failure(err, "failed to fetch "+resourceName+" by ref: "+strconv.Itoa(ref))
+	}
+
+	if !resp.IsSuccess() {
+		switch resp.StatusCode() {
+		case http.StatusNotFound:
+			return nil, ErrorNotFound(resourceName + " by ref: " + strconv.Itoa(ref))
+		default:
+			a.logger.Warn(
+				resourceName+" by ref '"+strconv.Itoa(ref)+"' response was not successful",
+				fmt.Sprintf("status: %d | body: %s", resp.StatusCode(), prettyJSON(resp.Body())),
+			)
+			return nil, ErrorInternal("failed to fetch " + resourceName + " by ref: " + strconv.Itoa(ref))
+		}
+	}
+
+	resource, err := parse.JSON[T](resp.Body())
+	if err != nil {
+		a.logger.Error("failed to parse "+resourceName+" by ref: "+strconv.Itoa(ref), err)
+		return nil, ErrorInternal("failed to fetch " + resourceName + " by ref: " + strconv.Itoa(ref))
+	}
+
+	return resource, nil
+}
+
+// appendedRequest fetches the details at endpoint along with the appended sub-resources in a single
+// request, and returns the body so the details and each sub-resource can be parsed out of it.
+func (a *api) appendedRequest(ctx context.Context, endpoint, resourceName string, ref int, appends []Append) ([]byte, error) {
+	request := a.request(ctx).
+		SetQueryParam("append_to_response", appendParam(appends))
+
+	resp, err := a.get(request, endpoint)
+	if err != nil {
+		a.logger.Error("failed to fetch "+resourceName+" by ref: "+strconv.Itoa(ref), err)
+		return nil, failure(err, "failed to fetch "+resourceName+" by ref: "+strconv.Itoa(ref))
+	}
+
+	if !resp.IsSuccess() {
+		switch resp.StatusCode() {
+		case http.StatusNotFound:
+			return nil, ErrorNotFound(resourceName + " by ref: " + strconv.Itoa(ref))
+		default:
+			a.logger.Warn(
+				resourceName+" by ref '"+strconv.Itoa(ref)+"' response was not successful",
+				fmt.Sprintf("status: %d | body: %s", resp.StatusCode(), prettyJSON(resp.Body())),
+			)
+			return nil, ErrorInternal("failed to fetch " + resourceName + " by ref: " + strconv.Itoa(ref))
+		}
+	}
+
+	return resp.Body(), nil
+}
+
+// appendParam joins appends into the value of append_to_response. It's sorted and deduplicated,
+// so the same set of appends always results in the same request.
+func appendParam(appends []Append) string {
+	seen := make(map[Append]bool, len(appends))
+	values := make([]string, 0, len(appends))
+	for _, ap := range appends {
+		if !seen[ap] {
+			seen[ap] = true
+			values = append(values, string(ap))
+		}
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+// multiPage decodes every result of a page returned by a mixed media endpoint.
+func multiPage(raw *Page[json.RawMessage]) (*Page[MultiResult], error) {
+	page := &Page[MultiResult]{
+		Page:         raw.Page,
+		Results:      make([]MultiResult, 0, len(raw.Results)),
+		TotalPages:   raw.TotalPages,
+		TotalResults: raw.TotalResults,
+	}
+	for _, result := range raw.Results {
+		r, err := multiResult(result)
+		if err != nil {
+			return nil, err
+		}
+		if r != nil {
+			page.Results = append(page.Results, *r)
+		}
+	}
+	return page, nil
+}
+
+// multiResult decodes a single result of a mixed media endpoint based on its media_type, with the same
+// leniency towards missing fields that results get when parsed as part of a page. Results of a media
+// type we don't know about are skipped by returning nil.
+func multiResult(result json.RawMessage) (*MultiResult, error) {
+	var kind struct {
+		MediaType string `json:"media_type"`
+	}
+	if err := json.Unmarshal(result, &kind); err != nil {
+		return nil, err
+	}
+
+	switch kind.MediaType {
+	case "movie":
+		var movie Movie
+		err := json.Unmarshal(result, &movie)
+		return &MultiResult{Kind: KindMovie, Movie: &movie}, err
+	case "tv":
+		var show Show
+		err := json.Unmarshal(result, &show)
+		return &MultiResult{Kind: KindShow, Show: &show}, err
+	case "person":
+		var person Person
+		err := json.Unmarshal(result, &person)
+		return &MultiResult{Kind: KindPerson, Person: &person}, err
+	default:
+		return nil, nil
+	}
+}
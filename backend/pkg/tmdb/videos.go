@@ -0,0 +1,21 @@
+package tmdb
+
+import (
+	"context"
+	"strconv"
+)
+
+type videoAPI interface {
+	GetMovieVideos(ctx context.Context, ref int) (*Videos, error)
+	GetShowVideos(ctx context.Context, ref int) (*Videos, error)
+}
+
+func (a *api) GetMovieVideos(ctx context.Context, ref int) (*Videos, error) {
+	endpoint := "/movie/" + strconv.Itoa(ref) + "/videos"
+	return refRequest[Videos](ctx, a, endpoint, "movie videos", ref)
+}
+
+func (a *api) GetShowVideos(ctx context.Context, ref int) (*Videos, error) {
+	endpoint := "/tv/" + strconv.Itoa(ref) + "/videos"
+	return refRequest[Videos](ctx, a, endpoint, "show videos", ref)
+}
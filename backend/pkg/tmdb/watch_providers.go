@@ -0,0 +1,21 @@
+package tmdb
+
+import (
+	"context"
+	"strconv"
+)
+
+type watchProviderAPI interface {
+	GetMovieWatchProviders(ctx context.Context, ref int) (*WatchProviders, error)
+	GetShowWatchProviders(ctx context.Context, ref int) (*WatchProviders, error)
+}
+
+func (a *api) GetMovieWatchProviders(ctx context.Context, ref int) (*WatchProviders, error) {
+	endpoint := "/movie/" + strconv.Itoa(ref) + "/watch/providers"
+	return refRequest[WatchProviders](ctx, a, endpoint, "movie watch providers", ref)
+}
+
+func (a *api) GetShowWatchProviders(ctx context.Context, ref int) (*WatchProviders, error) {
+	endpoint := "/tv/" + strconv.Itoa(ref) + "/watch/providers"
+	return refRequest[WatchProviders](ctx, a, endpoint, "show watch providers", ref)
+}
@@ -24,9 +24,12 @@ type Repository[E, F, U any] interface {
 }
 
 type (
-	SessionRepository Repository[*model.Session, *model.SessionF, *model.SessionU]
-	LikeRepository    Repository[*model.Like, *model.LikeF, *model.LikeU]
-	MediaRepository   Repository[*model.Media, *model.MediaF, *model.MediaU]
+	SessionRepository         Repository[*model.Session, *model.SessionF, *model.SessionU]
+	LikeRepository            Repository[*model.Like, *model.LikeF, *model.LikeU]
+	ReviewLikeRepository      Repository[*model.ReviewLike, *model.ReviewLikeF, *model.ReviewLikeU]
+	RevisionRepository        Repository[*model.Revision, *model.RevisionF, *model.RevisionU]
+	MediaRepository           Repository[*model.Media, *model.MediaF, *model.MediaU]
+	EpisodeProgressRepository Repository[*model.EpisodeProgress, *model.EpisodeProgressF, *model.EpisodeProgressU]
 )
 
 type UserRepository interface {
@@ -41,6 +44,29 @@ type UserRepository interface {
 
 	OneFollower(ctx context.Context, user *model.User, followerID uuid.UUID) (*model.User, error)
 	AllFollowers(ctx context.Context, user *model.User) ([]*model.User, error)
+
+	// AllFollowedDetailed and AllFollowersDetailed page through a user's follows most recent first,
+	// starting after the cursor when one is given, relating every user listed to the viewer.
+	AllFollowedDetailed(ctx context.Context, id, viewerID uuid.UUID, after *model.Cursor, limit int) ([]*model.DetailedFollow, error)
+	AllFollowersDetailed(ctx context.Context, id, viewerID uuid.UUID, after *model.Cursor, limit int) ([]*model.DetailedFollow, error)
+
+	// Blocked reports whether either user blocks the other.
+	Blocked(ctx context.Context, userID, otherID uuid.UUID) (bool, error)
+	// BlockUser also removes the follows between the pair, in both directions.
+	BlockUser(ctx context.Context, user *model.User, blockedID uuid.UUID) error
+	UnblockUser(ctx context.Context, user *model.User, blockedID uuid.UUID) error
+	AllBlocked(ctx context.Context, user *model.User) ([]*model.User, error)
+
+	MuteUser(ctx context.Context, user *model.User, mutedID uuid.UUID) error
+	UnmuteUser(ctx context.Context, user *model.User, mutedID uuid.UUID) error
+	AllMuted(ctx context.Context, user *model.User) ([]*model.User, error)
+
+	// CanView reports whether the viewer may see the reviews, lists and activity of the user:
+	// the user's account is public, is the viewer's own, or the viewer was approved to follow it.
+	CanView(ctx context.Context, viewerID, userID uuid.UUID) (bool, error)
+
+	// Search leaves out users the viewer muted, or who are on either side of a block with them.
+	Search(ctx context.Context, viewerID uuid.UUID, query string, limit int) ([]*model.User, error)
 }
 
 type ListRepository interface {
@@ -56,17 +82,71 @@ type ListRepository interface {
 	AddMedia(ctx context.Context, list *model.List, mediaID uuid.UUID) error
 	RemoveMedia(ctx context.Context, list *model.List, mediaID uuid.UUID) error
 	AllMedia(ctx context.Context, list *model.List) ([]*model.Media, error)
+
+	// HasMedia reports which of the given refs are in at least one of the user's lists, in a single query.
+	HasMedia(ctx context.Context, userID uuid.UUID, mediaType model.MediaType, refs []int) (map[int]bool, error)
+
+	// SearchPublic only searches the lists of owners the viewer may see and hasn't muted or blocked.
+	SearchPublic(ctx context.Context, viewerID uuid.UUID, query string, limit int) ([]*model.List, error)
 }
 
 type CommentRepository interface {
 	Repository[*model.Comment, *model.CommentF, *model.CommentU]
 
+	// AllAsDetailed and AllRepliesAsDetailed leave out comments by users the viewer muted,
+	// or who are on either side of a block with them.
 	AllAsDetailed(ctx context.Context, mediaID uuid.UUID, userID uuid.UUID) ([]*model.DetailedComment, error)
+	AllOnReviewAsDetailed(ctx context.Context, reviewID uuid.UUID, userID uuid.UUID) ([]*model.DetailedComment, error)
 	AllRepliesAsDetailed(ctx context.Context, comment *model.Comment, userID uuid.UUID) ([]*model.DetailedComment, error)
+
+	// DeleteTombstones hard deletes the tombstones of deleted comments that have no replies left.
+	DeleteTombstones(ctx context.Context) (int, error)
 }
 
 type ReviewRepository interface {
 	Repository[*model.Review, *model.ReviewF, *model.ReviewU]
 
-	AllWithUser(ctx context.Context, reviewFs ...*model.ReviewF) ([]*model.DetailedReview, error)
+	AllDetailed(ctx context.Context, viewerID uuid.UUID, sort model.ReviewSort, after *model.RankedCursor, limit int, reviewFs ...*model.ReviewF) ([]*model.DetailedReview, error)
+	Summary(ctx context.Context, reviewFs ...*model.ReviewF) (*model.ReviewSummary, error)
+
+	// DeleteTombstones hard deletes the tombstones of deleted reviews that have no comments left in their thread.
+	DeleteTombstones(ctx context.Context) (int, error)
+}
+
+type ActivityRepository interface {
+	Repository[*model.Activity, *model.ActivityF, *model.ActivityU]
+
+	// AllDetailed pages through activities newest first, starting after the cursor when one is given.
+	// Activities about lists are left out unless the list is public, or the viewer is one of its members,
+	// and so are activities by users the viewer muted, who are on either side of a block with them,
+	// or whose private account the viewer doesn't follow.
+	AllDetailed(ctx context.Context, viewerID uuid.UUID, after *model.Cursor, limit int, activityFs ...*model.ActivityF) ([]*model.DetailedActivity, error)
+}
+
+type NotificationRepository interface {
+	Repository[*model.Notification, *model.NotificationF, *model.NotificationU]
+
+	// AllDetailed pages through notifications newest first, starting after the cursor when one is given.
+	AllDetailed(ctx context.Context, after *model.Cursor, limit int, notificationFs ...*model.NotificationF) ([]*model.DetailedNotification, error)
+}
+
+type FollowRequestRepository interface {
+	Repository[*model.FollowRequest, *model.FollowRequestF, *model.FollowRequestU]
+
+	// AllDetailed pages through follow requests newest first, starting after the cursor when one is given.
+	AllDetailed(ctx context.Context, after *model.Cursor, limit int, requestFs ...*model.FollowRequestF) ([]*model.DetailedFollowRequest, error)
+}
+
+type WatchItemRepository interface {
+	Repository[*model.WatchItem, *model.WatchItemF, *model.WatchItemU]
+
+	AllWithMedia(ctx context.Context, itemFs ...*model.WatchItemF) ([]*model.DetailedWatchItem, error)
+	// OneByRef finds the user's item for a media by its TMDB ref, without needing the media's record first.
+	OneByRef(ctx context.Context, userID uuid.UUID, mediaType model.MediaType, ref int) (*model.WatchItem, error)
+}
+
+type DiaryEntryRepository interface {
+	Repository[*model.DiaryEntry, *model.DiaryEntryF, *model.DiaryEntryU]
+
+	AllWithMedia(ctx context.Context, entryFs ...*model.DiaryEntryF) ([]*model.DetailedDiaryEntry, error)
 }
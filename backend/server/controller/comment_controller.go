@@ -25,14 +25,18 @@ func (cc *CommentController) Routes(router fiber.Router, mw *middleware.Middlewa
 	comment := router.Group("/comments")
 
 	comment.Get("/:commentID/replies", mw.SignedIn, mw.ParseUUID("commentID"), cc.GetCommentReplies)
+	comment.Get("/:commentID/revisions", mw.SignedIn, mw.ParseUUID("commentID"), cc.GetCommentRevisions)
+	comment.Get("/review/:reviewID", mw.SignedIn, mw.ParseUUID("reviewID"), cc.GetReviewComments)
 	comment.Get("/:mediaType/:ref", mw.SignedIn, mw.ParseInt("ref"), mw.ParseMediaType("mediaType"), cc.GetComments)
 
 	comment.Put("/:commentID", mw.SignedIn, mw.CSRF, mw.ParseUUID("commentID"), cc.UpdateContent)
 
 	comment.Post("/like/:commentID", mw.SignedIn, mw.CSRF, mw.ParseUUID("commentID"), cc.LikeComment)
+	comment.Post("/review/:reviewID", mw.SignedIn, mw.CSRF, mw.ParseUUID("reviewID"), cc.CreateReviewComment)
 	comment.Post("/:mediaType/:ref", mw.SignedIn, mw.CSRF, mw.ParseMediaType("mediaType"), mw.ParseInt("ref"), cc.CreateComment)
 
 	comment.Delete("/like/:commentID", mw.SignedIn, mw.CSRF, mw.ParseUUID("commentID"), cc.UnlikeComment)
+	comment.Delete("/:commentID/purge", mw.SignedIn, mw.CSRF, mw.ParseUUID("commentID"), cc.PurgeComment)
 	comment.Delete("/:commentID", mw.SignedIn, mw.CSRF, mw.ParseUUID("commentID"), cc.DeleteComment)
 }
 
@@ -42,6 +46,7 @@ func (cc *CommentController) CreateComment(c *fiber.Ctx) error {
 	type Payload struct {
 		Content      string  `json:"content"                 z:"content"`
 		ReplyingToID *string `json:"replying_to_id,optional" z:"replying_to_id"`
+		Spoiler      bool    `json:"spoiler,optional"`
 	}
 
 	p, err := parse.JSON[Payload](c.Body())
@@ -72,6 +77,41 @@ func (cc *CommentController) CreateComment(c *fiber.Ctx) error {
 			UserID:       session.UserID,
 			Content:      p.Content,
 			ReplyingToID: replyingToID,
+			Spoiler:      p.Spoiler,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{"comment": comment})
+}
+
+// CreateReviewComment [POST] /api/comments/review/:reviewID
+func (cc *CommentController) CreateReviewComment(c *fiber.Ctx) error {
+
+	type Payload struct {
+		Content string `json:"content"`
+		Spoiler bool   `json:"spoiler,optional"`
+	}
+
+	p, err := parse.JSON[Payload](c.Body())
+	if err != nil {
+		return fault.BadRequest(err.Error())
+	}
+
+	if errs := schemas.CommentContentSchema.Validate(p.Content); errs != nil {
+		return fault.Validation(errs.One())
+	}
+
+	session := c.Locals("session").(*model.Session)
+	reviewID := c.Locals("reviewID").(uuid.UUID)
+
+	comment, err := cc.comment.CreateReviewComment(c.Context(),
+		reviewID, &model.Comment{
+			UserID:  session.UserID,
+			Content: p.Content,
+			Spoiler: p.Spoiler,
 		},
 	)
 	if err != nil {
@@ -87,6 +127,7 @@ func (cc *CommentController) UpdateContent(c *fiber.Ctx) error {
 
 	type Payload struct {
 		Content string `json:"content"`
+		Spoiler *bool  `json:"spoiler,optional"`
 	}
 
 	p, err := parse.JSON[Payload](c.Body())
@@ -100,7 +141,12 @@ func (cc *CommentController) UpdateContent(c *fiber.Ctx) error {
 
 	session := c.Locals("session").(*model.Session)
 
-	comment, err := cc.comment.UpdateComment(c.Context(), session.UserID, commentID, p.Content)
+	comment, err := cc.comment.UpdateComment(c.Context(),
+		session.UserID, commentID, &model.CommentU{
+			Content: &p.Content,
+			Spoiler: p.Spoiler,
+		},
+	)
 	if err != nil {
 		return err
 	}
@@ -121,6 +167,19 @@ func (cc *CommentController) DeleteComment(c *fiber.Ctx) error {
 	return c.SendStatus(http.StatusNoContent)
 }
 
+// PurgeComment [DELETE] /api/comments/:commentID/purge
+func (cc *CommentController) PurgeComment(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	commentID := c.Locals("commentID").(uuid.UUID)
+
+	err := cc.comment.PurgeComment(c.Context(), session.UserID, commentID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
 // GetComments [GET] /api/comments/:mediaType/:ref
 func (cc *CommentController) GetComments(c *fiber.Ctx) error {
 	session := c.Locals("session").(*model.Session)
@@ -148,6 +207,32 @@ func (cc *CommentController) GetCommentReplies(c *fiber.Ctx) error {
 	return c.Status(http.StatusOK).JSON(fiber.Map{"replies": replies})
 }
 
+// GetCommentRevisions [GET] /api/comments/:commentID/revisions
+func (cc *CommentController) GetCommentRevisions(c *fiber.Ctx) error {
+	commentID := c.Locals("commentID").(uuid.UUID)
+	session := c.Locals("session").(*model.Session)
+
+	revisions, err := cc.comment.GetCommentRevisions(c.Context(), commentID, session.UserID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"revisions": revisions})
+}
+
+// GetReviewComments [GET] /api/comments/review/:reviewID
+func (cc *CommentController) GetReviewComments(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	reviewID := c.Locals("reviewID").(uuid.UUID)
+
+	comments, err := cc.comment.GetReviewComments(c.Context(), reviewID, session.UserID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"detailed_comments": comments})
+}
+
 // LikeComment [POST] /api/comments/like/:commentID
 func (cc *CommentController) LikeComment(c *fiber.Ctx) error {
 	session := c.Locals("session").(*model.Session)
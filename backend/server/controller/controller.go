@@ -1,7 +1,10 @@
 package controller
 
 import (
+	"cine/entity/schemas"
+	"cine/pkg/fault"
 	"cine/server/middleware"
+	"github.com/MarcusSanchez/go-z"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -18,6 +21,14 @@ func NewControllers(
 	reviewController *ReviewController,
 	commentController *CommentController,
 	mediaController *MediaController,
+	personController *PersonController,
+	searchController *SearchController,
+	progressController *ProgressController,
+	diaryController *DiaryController,
+	watchlistController *WatchlistController,
+	feedController *FeedController,
+	notificationController *NotificationController,
+	streamController *StreamController,
 ) Controllers {
 	return Controllers{
 		userController,
@@ -26,6 +37,14 @@ func NewControllers(
 		reviewController,
 		commentController,
 		mediaController,
+		personController,
+		searchController,
+		progressController,
+		diaryController,
+		watchlistController,
+		feedController,
+		notificationController,
+		streamController,
 	}
 }
 
@@ -34,3 +53,25 @@ func (cs Controllers) Register(router fiber.Router, mw *middleware.Middleware) {
 		c.Routes(router, mw)
 	}
 }
+
+// PageQuery is the query of cursor paginated routes.
+type PageQuery struct {
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit" z:"limit"`
+}
+
+func parsePageQuery(c *fiber.Ctx) (*PageQuery, error) {
+	q := PageQuery{Limit: schemas.DefaultLimit}
+	if err := c.QueryParser(&q); err != nil {
+		return nil, fault.BadRequest("invalid query parameters")
+	}
+
+	schema := z.Struct{
+		"limit": schemas.LimitSchema,
+	}
+	if errs := schema.Validate(q); errs != nil {
+		return nil, fault.Validation(errs.One())
+	}
+
+	return &q, nil
+}
@@ -0,0 +1,186 @@
+package controller
+
+import (
+	"cine/entity/model"
+	"cine/entity/schemas"
+	"cine/pkg/fault"
+	"cine/server/middleware"
+	"cine/service"
+	"github.com/MarcusSanchez/go-parse"
+	"github.com/MarcusSanchez/go-z"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+)
+
+type DiaryController struct {
+	diary service.DiaryService
+}
+
+func NewDiaryController(diary service.DiaryService) *DiaryController {
+	return &DiaryController{diary: diary}
+}
+
+func (dc *DiaryController) Routes(router fiber.Router, mw *middleware.Middleware) {
+	diary := router.Group("/diary")
+	diary.Post("/:mediaType/:ref", mw.SignedIn, mw.CSRF, mw.ParseMediaType("mediaType"), mw.ParseInt("ref"), dc.CreateEntry)
+	diary.Put("/:entryID", mw.SignedIn, mw.CSRF, mw.ParseUUID("entryID"), dc.UpdateEntry)
+	diary.Delete("/:entryID", mw.SignedIn, mw.CSRF, mw.ParseUUID("entryID"), dc.DeleteEntry)
+	diary.Get("/user/:userID/:year", mw.SignedIn, mw.ParseUUID("userID"), mw.ParseInt("year"), dc.GetDiary)
+	diary.Get("/user/:userID/:year/:month", mw.SignedIn, mw.ParseUUID("userID"), mw.ParseInt("year", "month"), dc.GetDiary)
+}
+
+// CreateEntry [POST] /api/diary/:mediaType/:ref
+func (dc *DiaryController) CreateEntry(c *fiber.Ctx) error {
+
+	type Payload struct {
+		WatchedOn string  `json:"watched_on"          z:"watched_on"`
+		Rewatch   bool    `json:"rewatch,optional"`
+		Rating    *int    `json:"rating,optional"     z:"rating"`
+		ReviewID  *string `json:"review_id,optional"  z:"review_id"`
+	}
+
+	p, err := parse.JSON[Payload](c.Body())
+	if err != nil {
+		return fault.BadRequest(err.Error())
+	}
+
+	schema := z.Struct{
+		"watched_on": schemas.DiaryWatchedOnSchema,
+		"rating":     schemas.DiaryRatingSchema,
+		"review_id":  schemas.DiaryReviewIDSchema,
+	}
+	if errs := schema.Validate(p); errs != nil {
+		return fault.Validation(errs.One())
+	}
+
+	watchedOn, _ := time.Parse(time.DateOnly, p.WatchedOn)
+	var reviewID *uuid.UUID
+	if p.ReviewID != nil {
+		id, _ := uuid.Parse(*p.ReviewID)
+		reviewID = &id
+	}
+
+	session := c.Locals("session").(*model.Session)
+	ref := c.Locals("ref").(int)
+	mediaType := c.Locals("mediaType").(model.MediaType)
+
+	entry, err := dc.diary.CreateEntry(
+		c.Context(), &service.CreateDiaryEntryInput{
+			UserID:    session.UserID,
+			Ref:       ref,
+			MediaType: mediaType,
+			Entry: &model.DiaryEntry{
+				UserID:    session.UserID,
+				ReviewID:  reviewID,
+				WatchedOn: watchedOn,
+				Rewatch:   p.Rewatch,
+				Rating:    p.Rating,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{"diary_entry": entry})
+}
+
+// UpdateEntry [PUT] /api/diary/:entryID
+func (dc *DiaryController) UpdateEntry(c *fiber.Ctx) error {
+
+	type Payload struct {
+		WatchedOn   *string `json:"watched_on,optional"   z:"watched_on"`
+		Rewatch     *bool   `json:"rewatch,optional"`
+		Rating      *int    `json:"rating,optional"       z:"rating"`
+		ClearRating bool    `json:"clear_rating,optional"`
+		ReviewID    *string `json:"review_id,optional"    z:"review_id"`
+		ClearReview bool    `json:"clear_review,optional"`
+	}
+
+	p, err := parse.JSON[Payload](c.Body())
+	if err != nil {
+		return fault.BadRequest(err.Error())
+	}
+
+	schema := z.Struct{
+		"watched_on": schemas.DiaryWatchedOnSchema.Optional(),
+		"rating":     schemas.DiaryRatingSchema,
+		"review_id":  schemas.DiaryReviewIDSchema,
+	}
+	if errs := schema.Validate(p); errs != nil {
+		return fault.Validation(errs.One())
+	}
+	if (p.ClearRating && p.Rating != nil) || (p.ClearReview && p.ReviewID != nil) {
+		return fault.BadRequest("a field cannot be both set and cleared")
+	}
+
+	var watchedOn *time.Time
+	if p.WatchedOn != nil {
+		date, _ := time.Parse(time.DateOnly, *p.WatchedOn)
+		watchedOn = &date
+	}
+	var reviewID *uuid.UUID
+	if p.ReviewID != nil {
+		id, _ := uuid.Parse(*p.ReviewID)
+		reviewID = &id
+	}
+
+	session := c.Locals("session").(*model.Session)
+	entryID := c.Locals("entryID").(uuid.UUID)
+
+	entry, err := dc.diary.UpdateEntry(c.Context(),
+		session.UserID, entryID, &model.DiaryEntryU{
+			ReviewID:    reviewID,
+			ClearReview: p.ClearReview,
+			WatchedOn:   watchedOn,
+			Rewatch:     p.Rewatch,
+			Rating:      p.Rating,
+			ClearRating: p.ClearRating,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"diary_entry": entry})
+}
+
+// DeleteEntry [DELETE] /api/diary/:entryID
+func (dc *DiaryController) DeleteEntry(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	entryID := c.Locals("entryID").(uuid.UUID)
+
+	err := dc.diary.DeleteEntry(c.Context(), session.UserID, entryID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// GetDiary [GET] /api/diary/user/:userID/:year[/:month]
+func (dc *DiaryController) GetDiary(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	userID := c.Locals("userID").(uuid.UUID)
+	year := c.Locals("year").(int)
+	if errs := schemas.DiaryYearSchema.Validate(year); errs != nil {
+		return fault.Validation(errs.One())
+	}
+
+	var month *int
+	if m, ok := c.Locals("month").(int); ok {
+		if errs := schemas.DiaryMonthSchema.Validate(m); errs != nil {
+			return fault.Validation(errs.One())
+		}
+		month = &m
+	}
+
+	entries, err := dc.diary.GetDiary(c.Context(), session.UserID, userID, year, month)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"diary": entries})
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"cine/entity/model"
+	"cine/server/middleware"
+	"cine/service"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+type FeedController struct {
+	activity service.ActivityService
+}
+
+func NewFeedController(activity service.ActivityService) *FeedController {
+	return &FeedController{activity: activity}
+}
+
+func (fc *FeedController) Routes(router fiber.Router, mw *middleware.Middleware) {
+	feed := router.Group("/feed")
+	feed.Get("/", mw.SignedIn, fc.GetFeed)
+	feed.Get("/users/:userID", mw.SignedIn, mw.ParseUUID("userID"), fc.GetUserActivity)
+}
+
+// GetFeed [GET] /api/feed
+func (fc *FeedController) GetFeed(c *fiber.Ctx) error {
+	q, err := parsePageQuery(c)
+	if err != nil {
+		return err
+	}
+
+	session := c.Locals("session").(*model.Session)
+
+	feed, err := fc.activity.GetFeed(c.Context(), session.UserID, q.Cursor, q.Limit)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"feed": feed})
+}
+
+// GetUserActivity [GET] /api/feed/users/:userID
+func (fc *FeedController) GetUserActivity(c *fiber.Ctx) error {
+	q, err := parsePageQuery(c)
+	if err != nil {
+		return err
+	}
+
+	session := c.Locals("session").(*model.Session)
+	userID := c.Locals("userID").(uuid.UUID)
+
+	activity, err := fc.activity.GetUserActivity(c.Context(), session.UserID, userID, q.Cursor, q.Limit)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"activity": activity})
+}
@@ -136,11 +136,12 @@ func (lc *ListController) RemoveMemberFromList(c *fiber.Ctx) error {
 	return c.SendStatus(http.StatusNoContent)
 }
 
-// GetYourLists [GET] /api/lists/
+// GetYourLists [GET] /api/lists/?streamable=
 func (lc *ListController) GetYourLists(c *fiber.Ctx) error {
 	session := c.Locals("session").(*model.Session)
+	streamable := c.QueryBool("streamable")
 
-	lists, err := lc.list.GetAllLists(c.Context(), session.UserID)
+	lists, err := lc.list.GetAllLists(c.Context(), session.UserID, streamable)
 	if err != nil {
 		return err
 	}
@@ -150,9 +151,10 @@ func (lc *ListController) GetYourLists(c *fiber.Ctx) error {
 
 // GetUsersPublicLists [GET] /api/lists/:userID
 func (lc *ListController) GetUsersPublicLists(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
 	userID := c.Locals("userID").(uuid.UUID)
 
-	lists, err := lc.list.GetPublicLists(c.Context(), userID)
+	lists, err := lc.list.GetPublicLists(c.Context(), session.UserID, userID)
 	if err != nil {
 		return err
 	}
@@ -160,12 +162,13 @@ func (lc *ListController) GetUsersPublicLists(c *fiber.Ctx) error {
 	return c.Status(http.StatusOK).JSON(fiber.Map{"detailed_lists": lists})
 }
 
-// GetDetailedList [GET] /api/lists/:listID/detailed
+// GetDetailedList [GET] /api/lists/:listID/detailed?streamable=
 func (lc *ListController) GetDetailedList(c *fiber.Ctx) error {
 	session := c.Locals("session").(*model.Session)
 	listID := c.Locals("listID").(uuid.UUID)
+	streamable := c.QueryBool("streamable")
 
-	detailedList, err := lc.list.GetDetailedList(c.Context(), session.UserID, listID)
+	detailedList, err := lc.list.GetDetailedList(c.Context(), session.UserID, listID, streamable)
 	if err != nil {
 		return err
 	}
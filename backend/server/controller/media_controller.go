@@ -1,11 +1,17 @@
 package controller
 
 import (
+	"cine/entity/model"
+	"cine/entity/schemas"
+	"cine/pkg/fault"
 	"cine/pkg/tmdb"
 	"cine/server/middleware"
 	"cine/service"
+	"github.com/MarcusSanchez/go-z"
 	"github.com/gofiber/fiber/v2"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
 type MediaController struct {
@@ -24,6 +30,12 @@ func (mc *MediaController) Routes(router fiber.Router, mw *middleware.Middleware
 	media.Get("/movie/:ref/credits", mw.SignedIn, mw.ParseInt("ref"), mc.GetMovieCredits)
 	media.Get("/show/:ref/credits", mw.SignedIn, mw.ParseInt("ref"), mc.GetShowCredits)
 
+	media.Get("/movie/:ref/videos", mw.SignedIn, mw.ParseInt("ref"), mc.GetMovieVideos)
+	media.Get("/show/:ref/videos", mw.SignedIn, mw.ParseInt("ref"), mc.GetShowVideos)
+
+	media.Get("/movie/:ref/images", mw.SignedIn, mw.ParseInt("ref"), mc.GetMovieImages)
+	media.Get("/show/:ref/images", mw.SignedIn, mw.ParseInt("ref"), mc.GetShowImages)
+
 	media.Get("/show/:ref/season/:season", mw.SignedIn, mw.ParseInt("ref"), mw.ParseInt("season"), mc.GetShowDetailedSeason)
 
 	media.Get("/movie/list/:list", mw.SignedIn, mw.ParseMovieList("list"), mc.GetMovieList)
@@ -31,13 +43,24 @@ func (mc *MediaController) Routes(router fiber.Router, mw *middleware.Middleware
 
 	media.Get("/search/movies/:query", mw.SignedIn, mc.SearchMovies)
 	media.Get("/search/shows/:query", mw.SignedIn, mc.SearchShows)
+
+	media.Get("/discover/:mediaType", mw.SignedIn, mw.ParseMediaType("mediaType"), mc.Discover)
+	media.Get("/trending/:type/:window", mw.SignedIn, mw.ParseTrendingType("type"), mw.ParseTrendingWindow("window"), mc.GetTrending)
+
+	media.Get("/:mediaType/:ref/recommendations", mw.SignedIn, mw.ParseMediaType("mediaType"), mw.ParseInt("ref"), mc.GetRecommendations)
 }
 
-// GetMovie [Get] /api/medias/movie/:ref
+// GetMovie [Get] /api/medias/movie/:ref?append=
 func (mc *MediaController) GetMovie(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
 	ref := c.Locals("ref").(int)
 
-	movie, err := mc.media.GetDetailedMovie(c.Context(), ref)
+	appends, err := mc.appends(c)
+	if err != nil {
+		return err
+	}
+
+	movie, err := mc.media.GetDetailedMovie(c.Context(), session.UserID, ref, appends...)
 	if err != nil {
 		return err
 	}
@@ -45,11 +68,17 @@ func (mc *MediaController) GetMovie(c *fiber.Ctx) error {
 	return c.Status(http.StatusOK).JSON(fiber.Map{"detailed_movie": movie})
 }
 
-// GetShow [Get] /api/medias/show/:ref
+// GetShow [Get] /api/medias/show/:ref?append=
 func (mc *MediaController) GetShow(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
 	ref := c.Locals("ref").(int)
 
-	show, err := mc.media.GetDetailedShow(c.Context(), ref)
+	appends, err := mc.appends(c)
+	if err != nil {
+		return err
+	}
+
+	show, err := mc.media.GetDetailedShow(c.Context(), session.UserID, ref, appends...)
 	if err != nil {
 		return err
 	}
@@ -57,6 +86,30 @@ func (mc *MediaController) GetShow(c *fiber.Ctx) error {
 	return c.Status(http.StatusOK).JSON(fiber.Map{"detailed_show": show})
 }
 
+// GetMovieVideos [Get] /api/medias/movie/:ref/videos
+func (mc *MediaController) GetMovieVideos(c *fiber.Ctx) error {
+	ref := c.Locals("ref").(int)
+
+	videos, err := mc.media.GetMovieVideos(c.Context(), ref)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"movie_videos": videos})
+}
+
+// GetMovieImages [Get] /api/medias/movie/:ref/images
+func (mc *MediaController) GetMovieImages(c *fiber.Ctx) error {
+	ref := c.Locals("ref").(int)
+
+	images, err := mc.media.GetMovieImages(c.Context(), ref)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"movie_images": images})
+}
+
 // GetMovieCredits [Get] /api/medias/movie/:ref/credits
 func (mc *MediaController) GetMovieCredits(c *fiber.Ctx) error {
 	ref := c.Locals("ref").(int)
@@ -69,6 +122,30 @@ func (mc *MediaController) GetMovieCredits(c *fiber.Ctx) error {
 	return c.Status(http.StatusOK).JSON(fiber.Map{"movie_credits": credits})
 }
 
+// GetShowVideos [Get] /api/medias/show/:ref/videos
+func (mc *MediaController) GetShowVideos(c *fiber.Ctx) error {
+	ref := c.Locals("ref").(int)
+
+	videos, err := mc.media.GetShowVideos(c.Context(), ref)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"show_videos": videos})
+}
+
+// GetShowImages [Get] /api/medias/show/:ref/images
+func (mc *MediaController) GetShowImages(c *fiber.Ctx) error {
+	ref := c.Locals("ref").(int)
+
+	images, err := mc.media.GetShowImages(c.Context(), ref)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"show_images": images})
+}
+
 // GetShowCredits [Get] /api/medias/show/:ref/credits
 func (mc *MediaController) GetShowCredits(c *fiber.Ctx) error {
 	ref := c.Locals("ref").(int)
@@ -97,25 +174,37 @@ func (mc *MediaController) GetShowDetailedSeason(c *fiber.Ctx) error {
 // GetMovieList [Get] /api/medias/movie/list/:list
 func (mc *MediaController) GetMovieList(c *fiber.Ctx) error {
 	list := c.Locals("list").(tmdb.MovieList)
+	page := c.QueryInt("page", 1)
 
-	movies, err := mc.media.GetMovieList(c.Context(), list)
+	movies, err := mc.media.GetMovieList(c.Context(), list, page)
 	if err != nil {
 		return err
 	}
 
-	return c.Status(http.StatusOK).JSON(fiber.Map{"movies": movies})
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"movies":        movies.Results,
+		"page":          movies.Page,
+		"total_pages":   movies.TotalPages,
+		"total_results": movies.TotalResults,
+	})
 }
 
 // GetShowList [Get] /api/medias/show/list/:list
 func (mc *MediaController) GetShowList(c *fiber.Ctx) error {
 	list := c.Locals("list").(tmdb.ShowList)
+	page := c.QueryInt("page", 1)
 
-	shows, err := mc.media.GetShowList(c.Context(), list)
+	shows, err := mc.media.GetShowList(c.Context(), list, page)
 	if err != nil {
 		return err
 	}
 
-	return c.Status(http.StatusOK).JSON(fiber.Map{"shows": shows})
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"shows":         shows.Results,
+		"page":          shows.Page,
+		"total_pages":   shows.TotalPages,
+		"total_results": shows.TotalResults,
+	})
 }
 
 // SearchMovies [Get] /api/medias/search/movies/:query
@@ -128,7 +217,12 @@ func (mc *MediaController) SearchMovies(c *fiber.Ctx) error {
 		return err
 	}
 
-	return c.Status(http.StatusOK).JSON(fiber.Map{"movies": movies})
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"movies":        movies.Results,
+		"page":          movies.Page,
+		"total_pages":   movies.TotalPages,
+		"total_results": movies.TotalResults,
+	})
 }
 
 // SearchShows [Get] /api/medias/search/shows/:query
@@ -141,5 +235,161 @@ func (mc *MediaController) SearchShows(c *fiber.Ctx) error {
 		return err
 	}
 
-	return c.Status(http.StatusOK).JSON(fiber.Map{"shows": shows})
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"shows":         shows.Results,
+		"page":          shows.Page,
+		"total_pages":   shows.TotalPages,
+		"total_results": shows.TotalResults,
+	})
+}
+
+// GetTrending [Get] /api/medias/trending/:type/:window
+func (mc *MediaController) GetTrending(c *fiber.Ctx) error {
+	trendingType := c.Locals("type").(tmdb.TrendingType)
+	window := c.Locals("window").(tmdb.TrendingWindow)
+	page := c.QueryInt("page", 1)
+
+	trending, err := mc.media.GetTrending(c.Context(), trendingType, window, page)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"trending":      trending.Results,
+		"page":          trending.Page,
+		"total_pages":   trending.TotalPages,
+		"total_results": trending.TotalResults,
+	})
+}
+
+// GetRecommendations [Get] /api/medias/:mediaType/:ref/recommendations
+func (mc *MediaController) GetRecommendations(c *fiber.Ctx) error {
+	mediaType := c.Locals("mediaType").(model.MediaType)
+	ref := c.Locals("ref").(int)
+	page := c.QueryInt("page", 1)
+	session := c.Locals("session").(*model.Session)
+
+	switch mediaType {
+	case model.MediaTypeMovie:
+		recommendations, err := mc.media.GetMovieRecommendations(c.Context(), session.UserID, ref, page)
+		if err != nil {
+			return err
+		}
+		return c.Status(http.StatusOK).JSON(fiber.Map{"recommendations": recommendations})
+	default:
+		recommendations, err := mc.media.GetShowRecommendations(c.Context(), session.UserID, ref, page)
+		if err != nil {
+			return err
+		}
+		return c.Status(http.StatusOK).JSON(fiber.Map{"recommendations": recommendations})
+	}
+}
+
+// Discover [Get] /api/medias/discover/:mediaType
+func (mc *MediaController) Discover(c *fiber.Ctx) error {
+	mediaType := c.Locals("mediaType").(model.MediaType)
+
+	type Query struct {
+		Genres     *string  `query:"genres"      z:"genres"`
+		YearFrom   *int     `query:"year_from"   z:"year_from"`
+		YearTo     *int     `query:"year_to"     z:"year_to"`
+		MinRating  *float64 `query:"min_rating"  z:"min_rating"`
+		MinVotes   *int     `query:"min_votes"   z:"min_votes"`
+		MinRuntime *int     `query:"min_runtime" z:"min_runtime"`
+		MaxRuntime *int     `query:"max_runtime" z:"max_runtime"`
+		Language   *string  `query:"language"    z:"language"`
+		SortBy     *string  `query:"sort_by"     z:"sort_by"`
+		Order      *string  `query:"order"       z:"order"`
+		Providers  *string  `query:"providers"   z:"providers"`
+		Region     *string  `query:"region"      z:"region"`
+		Page       int      `query:"page"        z:"page"`
+	}
+
+	q := Query{Page: 1}
+	if err := c.QueryParser(&q); err != nil {
+		return fault.BadRequest("invalid query parameters")
+	}
+
+	schema := z.Struct{
+		"genres":      schemas.DiscoverGenresSchema,
+		"year_from":   schemas.DiscoverYearSchema,
+		"year_to":     schemas.DiscoverYearSchema,
+		"min_rating":  schemas.DiscoverMinRatingSchema,
+		"min_votes":   schemas.DiscoverMinVotesSchema,
+		"min_runtime": schemas.DiscoverRuntimeSchema,
+		"max_runtime": schemas.DiscoverRuntimeSchema,
+		"language":    schemas.DiscoverLanguageSchema,
+		"sort_by":     schemas.DiscoverSortSchema,
+		"order":       schemas.DiscoverOrderSchema,
+		"providers":   schemas.DiscoverProvidersSchema,
+		"region":      schemas.DiscoverRegionSchema,
+		"page":        schemas.PageSchema,
+	}
+	if errs := schema.Validate(q); errs != nil {
+		return fault.Validation(errs.One())
+	}
+
+	filter := tmdb.DiscoverFilter{
+		YearFrom:         q.YearFrom,
+		YearTo:           q.YearTo,
+		MinRating:        q.MinRating,
+		MinVoteCount:     q.MinVotes,
+		MinRuntime:       q.MinRuntime,
+		MaxRuntime:       q.MaxRuntime,
+		OriginalLanguage: q.Language,
+		SortBy:           (*tmdb.DiscoverSort)(q.SortBy),
+		Ascending:        q.Order != nil && *q.Order == "asc",
+		WatchRegion:      q.Region,
+		Page:             &q.Page,
+	}
+	if q.Genres != nil {
+		for _, id := range strings.Split(*q.Genres, ",") {
+			genre, _ := strconv.Atoi(id)
+			filter.Genres = append(filter.Genres, tmdb.Genre(genre))
+		}
+	}
+	if q.Providers != nil {
+		for _, id := range strings.Split(*q.Providers, ",") {
+			provider, _ := strconv.Atoi(id)
+			filter.WatchProviders = append(filter.WatchProviders, provider)
+		}
+	}
+
+	discovered, err := mc.media.Discover(c.Context(), mediaType, filter)
+	if err != nil {
+		return err
+	}
+
+	if discovered.Movies != nil {
+		return c.Status(http.StatusOK).JSON(fiber.Map{
+			"movies":        discovered.Movies.Results,
+			"page":          discovered.Movies.Page,
+			"total_pages":   discovered.Movies.TotalPages,
+			"total_results": discovered.Movies.TotalResults,
+		})
+	}
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"shows":         discovered.Shows.Results,
+		"page":          discovered.Shows.Page,
+		"total_pages":   discovered.Shows.TotalPages,
+		"total_results": discovered.Shows.TotalResults,
+	})
+}
+
+// appends parses the comma separated sub-resources to fetch along with a title's details.
+func (mc *MediaController) appends(c *fiber.Ctx) ([]tmdb.Append, error) {
+	query := c.Query("append")
+	if query == "" {
+		return nil, nil
+	}
+
+	if errs := schemas.AppendSchema.Validate(query); errs != nil {
+		return nil, fault.Validation(errs.One())
+	}
+
+	var appends []tmdb.Append
+	for _, value := range strings.Split(query, ",") {
+		appends = append(appends, tmdb.Append(value))
+	}
+	return appends, nil
 }
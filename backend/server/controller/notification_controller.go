@@ -0,0 +1,141 @@
+package controller
+
+import (
+	"cine/entity/model"
+	"cine/entity/schemas"
+	"cine/pkg/fault"
+	"cine/server/middleware"
+	"cine/service"
+	"github.com/MarcusSanchez/go-parse"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+type NotificationController struct {
+	notification service.NotificationService
+}
+
+func NewNotificationController(notification service.NotificationService) *NotificationController {
+	return &NotificationController{notification: notification}
+}
+
+func (nc *NotificationController) Routes(router fiber.Router, mw *middleware.Middleware) {
+	notifications := router.Group("/notifications")
+	notifications.Get("/", mw.SignedIn, nc.GetNotifications)
+	notifications.Get("/unread", mw.SignedIn, nc.CountUnread)
+	notifications.Put("/read", mw.SignedIn, mw.CSRF, nc.MarkRead)
+
+	notifications.Get("/preferences", mw.SignedIn, nc.GetPreferences)
+	notifications.Put("/preferences", mw.SignedIn, mw.CSRF, nc.UpdatePreferences)
+}
+
+// GetNotifications [GET] /api/notifications
+func (nc *NotificationController) GetNotifications(c *fiber.Ctx) error {
+	q, err := parsePageQuery(c)
+	if err != nil {
+		return err
+	}
+
+	session := c.Locals("session").(*model.Session)
+	unreadOnly := c.QueryBool("unread")
+
+	notifications, err := nc.notification.GetNotifications(c.Context(), session.UserID, q.Cursor, q.Limit, unreadOnly)
+	if err != nil {
+		return err
+	}
+
+	unread, err := nc.notification.CountUnread(c.Context(), session.UserID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"notifications": notifications, "unread_count": unread})
+}
+
+// CountUnread [GET] /api/notifications/unread
+func (nc *NotificationController) CountUnread(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+
+	unread, err := nc.notification.CountUnread(c.Context(), session.UserID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"unread_count": unread})
+}
+
+// MarkRead [PUT] /api/notifications/read
+func (nc *NotificationController) MarkRead(c *fiber.Ctx) error {
+
+	type Payload struct {
+		IDs []string `json:"ids,optional"`
+	}
+
+	p, err := parse.JSON[Payload](c.Body())
+	if err != nil {
+		return fault.BadRequest(err.Error())
+	}
+
+	if errs := schemas.MarkReadCountSchema.Validate(len(p.IDs)); errs != nil {
+		return fault.Validation(errs.One())
+	}
+	ids := make([]uuid.UUID, 0, len(p.IDs))
+	for _, id := range p.IDs {
+		if errs := schemas.NotificationIDSchema.Validate(id); errs != nil {
+			return fault.Validation(errs.One())
+		}
+		ids = append(ids, uuid.MustParse(id))
+	}
+
+	session := c.Locals("session").(*model.Session)
+
+	marked, err := nc.notification.MarkRead(c.Context(), session.UserID, ids)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"marked": marked})
+}
+
+// GetPreferences [GET] /api/notifications/preferences
+func (nc *NotificationController) GetPreferences(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+
+	muted, err := nc.notification.GetMuted(c.Context(), session.UserID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"muted": muted})
+}
+
+// UpdatePreferences [PUT] /api/notifications/preferences
+func (nc *NotificationController) UpdatePreferences(c *fiber.Ctx) error {
+
+	type Payload struct {
+		Muted []string `json:"muted"`
+	}
+
+	p, err := parse.JSON[Payload](c.Body())
+	if err != nil {
+		return fault.BadRequest(err.Error())
+	}
+
+	kinds := make([]model.NotificationKind, 0, len(p.Muted))
+	for _, kind := range p.Muted {
+		if errs := schemas.NotificationKindSchema.Validate(kind); errs != nil {
+			return fault.Validation(errs.One())
+		}
+		kinds = append(kinds, model.NotificationKind(kind))
+	}
+
+	session := c.Locals("session").(*model.Session)
+
+	muted, err := nc.notification.SetMuted(c.Context(), session.UserID, kinds)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"muted": muted})
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"cine/server/middleware"
+	"cine/service"
+	"github.com/gofiber/fiber/v2"
+	"net/http"
+)
+
+type PersonController struct {
+	media service.MediaService
+}
+
+func NewPersonController(mediaService service.MediaService) *PersonController {
+	return &PersonController{media: mediaService}
+}
+
+func (pc *PersonController) Routes(router fiber.Router, mw *middleware.Middleware) {
+	people := router.Group("/people")
+	people.Get("/search/:query", mw.SignedIn, pc.SearchPeople)
+
+	people.Get("/:ref", mw.SignedIn, mw.ParseInt("ref"), pc.GetPerson)
+	people.Get("/:ref/filmography", mw.SignedIn, mw.ParseInt("ref"), pc.GetPersonFilmography)
+}
+
+// SearchPeople [Get] /api/people/search/:query
+func (pc *PersonController) SearchPeople(c *fiber.Ctx) error {
+	query := c.Params("query")
+	page := c.QueryInt("page", 1)
+
+	people, err := pc.media.SearchPeople(c.Context(), query, page)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"people":        people.Results,
+		"page":          people.Page,
+		"total_pages":   people.TotalPages,
+		"total_results": people.TotalResults,
+	})
+}
+
+// GetPerson [Get] /api/people/:ref
+func (pc *PersonController) GetPerson(c *fiber.Ctx) error {
+	ref := c.Locals("ref").(int)
+
+	person, err := pc.media.GetPerson(c.Context(), ref)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"detailed_person": person})
+}
+
+// GetPersonFilmography [Get] /api/people/:ref/filmography
+func (pc *PersonController) GetPersonFilmography(c *fiber.Ctx) error {
+	ref := c.Locals("ref").(int)
+
+	filmography, err := pc.media.GetPersonFilmography(c.Context(), ref)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"filmography": filmography})
+}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"cine/entity/model"
+	"cine/server/middleware"
+	"cine/service"
+	"github.com/gofiber/fiber/v2"
+	"net/http"
+)
+
+type ProgressController struct {
+	progress service.ProgressService
+}
+
+func NewProgressController(progressService service.ProgressService) *ProgressController {
+	return &ProgressController{progress: progressService}
+}
+
+func (pc *ProgressController) Routes(router fiber.Router, mw *middleware.Middleware) {
+	progress := router.Group("/progress")
+
+	progress.Get("/show/:ref", mw.SignedIn, mw.ParseInt("ref"), pc.GetShowProgress)
+
+	progress.Post("/show/:ref", mw.SignedIn, mw.CSRF, mw.ParseInt("ref"), pc.MarkShow)
+	progress.Post("/show/:ref/season/:season", mw.SignedIn, mw.CSRF, mw.ParseInt("ref", "season"), pc.MarkSeason)
+	progress.Post("/show/:ref/season/:season/episode/:episode", mw.SignedIn, mw.CSRF, mw.ParseInt("ref", "season", "episode"), pc.MarkEpisode)
+
+	progress.Delete("/show/:ref", mw.SignedIn, mw.CSRF, mw.ParseInt("ref"), pc.UnmarkShow)
+	progress.Delete("/show/:ref/season/:season", mw.SignedIn, mw.CSRF, mw.ParseInt("ref", "season"), pc.UnmarkSeason)
+	progress.Delete("/show/:ref/season/:season/episode/:episode", mw.SignedIn, mw.CSRF, mw.ParseInt("ref", "season", "episode"), pc.UnmarkEpisode)
+}
+
+// GetShowProgress [GET] /api/progress/show/:ref
+func (pc *ProgressController) GetShowProgress(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	ref := c.Locals("ref").(int)
+
+	progress, err := pc.progress.GetShowProgress(c.Context(), session.UserID, ref)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"show_progress": progress})
+}
+
+// MarkShow [POST] /api/progress/show/:ref
+func (pc *ProgressController) MarkShow(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	ref := c.Locals("ref").(int)
+
+	err := pc.progress.MarkShow(c.Context(), session.UserID, ref)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// MarkSeason [POST] /api/progress/show/:ref/season/:season
+func (pc *ProgressController) MarkSeason(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	ref := c.Locals("ref").(int)
+	season := c.Locals("season").(int)
+
+	err := pc.progress.MarkSeason(c.Context(), session.UserID, ref, season)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// MarkEpisode [POST] /api/progress/show/:ref/season/:season/episode/:episode
+func (pc *ProgressController) MarkEpisode(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	ref := c.Locals("ref").(int)
+	season := c.Locals("season").(int)
+	episode := c.Locals("episode").(int)
+
+	err := pc.progress.MarkEpisode(c.Context(), session.UserID, ref, season, episode)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// UnmarkShow [DELETE] /api/progress/show/:ref
+func (pc *ProgressController) UnmarkShow(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	ref := c.Locals("ref").(int)
+
+	err := pc.progress.UnmarkShow(c.Context(), session.UserID, ref)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// UnmarkSeason [DELETE] /api/progress/show/:ref/season/:season
+func (pc *ProgressController) UnmarkSeason(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	ref := c.Locals("ref").(int)
+	season := c.Locals("season").(int)
+
+	err := pc.progress.UnmarkSeason(c.Context(), session.UserID, ref, season)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// UnmarkEpisode [DELETE] /api/progress/show/:ref/season/:season/episode/:episode
+func (pc *ProgressController) UnmarkEpisode(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	ref := c.Locals("ref").(int)
+	season := c.Locals("season").(int)
+	episode := c.Locals("episode").(int)
+
+	err := pc.progress.UnmarkEpisode(c.Context(), session.UserID, ref, season, episode)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
@@ -24,8 +24,12 @@ func NewReviewController(review service.ReviewService) *ReviewController {
 func (rc *ReviewController) Routes(router fiber.Router, mw *middleware.Middleware) {
 	review := router.Group("/reviews")
 	review.Post("/:mediaType/:ref", mw.SignedIn, mw.CSRF, mw.ParseMediaType("mediaType"), mw.ParseInt("ref"), rc.CreateReview)
+	review.Post("/like/:reviewID", mw.SignedIn, mw.CSRF, mw.ParseUUID("reviewID"), rc.LikeReview)
 	review.Put("/:reviewID", mw.SignedIn, mw.CSRF, mw.ParseUUID("reviewID"), rc.UpdateReview)
+	review.Delete("/like/:reviewID", mw.SignedIn, mw.CSRF, mw.ParseUUID("reviewID"), rc.UnlikeReview)
 	review.Delete("/:reviewID", mw.SignedIn, mw.CSRF, mw.ParseUUID("reviewID"), rc.DeleteReview)
+	review.Get("/user/:userID", mw.SignedIn, mw.ParseUUID("userID"), rc.GetUserReviews)
+	review.Get("/:reviewID/revisions", mw.SignedIn, mw.ParseUUID("reviewID"), rc.GetReviewRevisions)
 	review.Get("/:mediaType/:ref", mw.SignedIn, mw.ParseMediaType("mediaType"), mw.ParseInt("ref"), rc.GetAllReviews)
 }
 
@@ -33,8 +37,9 @@ func (rc *ReviewController) Routes(router fiber.Router, mw *middleware.Middlewar
 func (rc *ReviewController) CreateReview(c *fiber.Ctx) error {
 
 	type Payload struct {
-		Content string `json:"content" z:"content"`
-		Rating  int    `json:"rating"  z:"rating"`
+		Content string `json:"content"          z:"content"`
+		Rating  int    `json:"rating"           z:"rating"`
+		Spoiler bool   `json:"spoiler,optional"`
 	}
 
 	p, err := parse.JSON[Payload](c.Body())
@@ -63,6 +68,7 @@ func (rc *ReviewController) CreateReview(c *fiber.Ctx) error {
 				UserID:  session.UserID,
 				Content: p.Content,
 				Rating:  p.Rating,
+				Spoiler: p.Spoiler,
 			},
 		},
 	)
@@ -79,6 +85,7 @@ func (rc *ReviewController) UpdateReview(c *fiber.Ctx) error {
 	type Payload struct {
 		Content *string `json:"content,optional" z:"content"`
 		Rating  *int    `json:"rating,optional"  z:"rating"`
+		Spoiler *bool   `json:"spoiler,optional"`
 	}
 
 	p, err := parse.JSON[Payload](c.Body())
@@ -101,6 +108,7 @@ func (rc *ReviewController) UpdateReview(c *fiber.Ctx) error {
 		session.UserID, reviewID, &model.ReviewU{
 			Content: p.Content,
 			Rating:  p.Rating,
+			Spoiler: p.Spoiler,
 		},
 	)
 	if err != nil {
@@ -123,15 +131,107 @@ func (rc *ReviewController) DeleteReview(c *fiber.Ctx) error {
 	return c.SendStatus(http.StatusNoContent)
 }
 
-// GetAllReviews [GET] /api/reviews/:mediaType/:ref
+// GetAllReviews [GET] /api/reviews/:mediaType/:ref?sort=&cursor=&limit=
 func (rc *ReviewController) GetAllReviews(c *fiber.Ctx) error {
+	q, err := parsePageQuery(c)
+	if err != nil {
+		return err
+	}
+	sort, err := rc.sort(c)
+	if err != nil {
+		return err
+	}
+
+	session := c.Locals("session").(*model.Session)
 	ref := c.Locals("ref").(int)
 	mediaType := c.Locals("mediaType").(model.MediaType)
 
-	reviews, err := rc.review.GetAllReviews(c.Context(), ref, mediaType)
+	reviews, err := rc.review.GetAllReviews(c.Context(), &service.GetReviewsInput{
+		ViewerID:  session.UserID,
+		Ref:       ref,
+		MediaType: mediaType,
+		Sort:      sort,
+		Cursor:    q.Cursor,
+		Limit:     q.Limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"media_reviews": reviews})
+}
+
+// GetUserReviews [GET] /api/reviews/user/:userID?sort=&cursor=&limit=
+func (rc *ReviewController) GetUserReviews(c *fiber.Ctx) error {
+	q, err := parsePageQuery(c)
+	if err != nil {
+		return err
+	}
+	sort, err := rc.sort(c)
+	if err != nil {
+		return err
+	}
+
+	session := c.Locals("session").(*model.Session)
+	userID := c.Locals("userID").(uuid.UUID)
+
+	reviews, err := rc.review.GetUserReviews(c.Context(), session.UserID, userID, sort, q.Cursor, q.Limit)
 	if err != nil {
 		return err
 	}
 
 	return c.Status(http.StatusOK).JSON(fiber.Map{"detailed_reviews": reviews})
 }
+
+// LikeReview [POST] /api/reviews/like/:reviewID
+func (rc *ReviewController) LikeReview(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	reviewID := c.Locals("reviewID").(uuid.UUID)
+
+	like, err := rc.review.LikeReview(
+		c.Context(), &model.ReviewLike{
+			UserID:   session.UserID,
+			ReviewID: reviewID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{"like": like})
+}
+
+// UnlikeReview [DELETE] /api/reviews/like/:reviewID
+func (rc *ReviewController) UnlikeReview(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	reviewID := c.Locals("reviewID").(uuid.UUID)
+
+	err := rc.review.UnlikeReview(c.Context(), session.UserID, reviewID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// GetReviewRevisions [GET] /api/reviews/:reviewID/revisions
+func (rc *ReviewController) GetReviewRevisions(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	reviewID := c.Locals("reviewID").(uuid.UUID)
+
+	revisions, err := rc.review.GetReviewRevisions(c.Context(), session.UserID, reviewID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"revisions": revisions})
+}
+
+// sort parses the order to page through reviews in, newest first unless asked otherwise.
+func (rc *ReviewController) sort(c *fiber.Ctx) (model.ReviewSort, error) {
+	sort := c.Query("sort", string(model.ReviewSortNewest))
+	if errs := schemas.ReviewSortSchema.Validate(sort); errs != nil {
+		return "", fault.Validation(errs.One())
+	}
+	return model.ReviewSort(sort), nil
+}
@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"cine/entity/model"
+	"cine/entity/schemas"
+	"cine/pkg/fault"
+	"cine/server/middleware"
+	"cine/service"
+	"github.com/gofiber/fiber/v2"
+	"net/http"
+	"strings"
+)
+
+type SearchController struct {
+	search service.SearchService
+}
+
+func NewSearchController(searchService service.SearchService) *SearchController {
+	return &SearchController{search: searchService}
+}
+
+func (sc *SearchController) Routes(router fiber.Router, mw *middleware.Middleware) {
+	router.Get("/search", mw.SignedIn, sc.Search)
+}
+
+// Search [Get] /api/search?q=
+func (sc *SearchController) Search(c *fiber.Ctx) error {
+	query := strings.TrimSpace(c.Query("q"))
+	if errs := schemas.SearchQuerySchema.Validate(query); errs != nil {
+		return fault.Validation(errs.One())
+	}
+
+	session := c.Locals("session").(*model.Session)
+	results, err := sc.search.Search(c.Context(), session.UserID, query)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"results": results})
+}
@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"bufio"
+	"cine/entity/model"
+	"cine/pkg/pubsub"
+	"cine/server/middleware"
+	"cine/service"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"time"
+)
+
+const (
+	// streamHeartbeat keeps idle streams from being cut by proxies, and notices clients that went away.
+	streamHeartbeat = 25 * time.Second
+	// streamMaxAge ends streams periodically so clients reconnect, and are authenticated again.
+	streamMaxAge = time.Hour
+)
+
+type StreamController struct {
+	stream service.StreamService
+}
+
+func NewStreamController(streamService service.StreamService) *StreamController {
+	return &StreamController{stream: streamService}
+}
+
+func (sc *StreamController) Routes(router fiber.Router, mw *middleware.Middleware) {
+	stream := router.Group("/stream")
+	stream.Get("/", mw.SignedIn, sc.StreamNotifications)
+	stream.Get("/:mediaType/:ref", mw.SignedIn, mw.ParseMediaType("mediaType"), mw.ParseInt("ref"), sc.StreamComments)
+}
+
+// StreamNotifications [GET] /api/stream
+func (sc *StreamController) StreamNotifications(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+
+	sub := sc.stream.SubscribeNotifications(c.Context(), session.UserID)
+
+	return sc.serve(c, sub)
+}
+
+// StreamComments [GET] /api/stream/:mediaType/:ref
+func (sc *StreamController) StreamComments(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	ref := c.Locals("ref").(int)
+	mediaType := c.Locals("mediaType").(model.MediaType)
+
+	sub, err := sc.stream.SubscribeComments(c.Context(), session.UserID, ref, mediaType)
+	if err != nil {
+		return err
+	}
+
+	return sc.serve(c, sub)
+}
+
+// serve writes the events of sub as server-sent events until the client disconnects,
+// the hub shuts down or the stream reaches its max age.
+func (sc *StreamController) serve(c *fiber.Ctx, sub pubsub.Subscription) error {
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer sub.Close()
+
+		heartbeat := time.NewTicker(streamHeartbeat)
+		defer heartbeat.Stop()
+		expired := time.After(streamMaxAge)
+
+		// flush right away so the client knows the stream is open before the first event
+		_, _ = fmt.Fprint(w, ": connected\n\n")
+		for {
+			if err := w.Flush(); err != nil {
+				return
+			}
+
+			select {
+			case event, ok := <-sub.Events():
+				if !ok {
+					return
+				}
+				_, _ = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, event.Data)
+			case <-heartbeat.C:
+				_, _ = fmt.Fprint(w, ": heartbeat\n\n")
+			case <-expired:
+				return
+			}
+		}
+	})
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"net/http"
+	"strconv"
 )
 
 type UserController struct {
@@ -25,6 +26,9 @@ func (uc *UserController) Routes(router fiber.Router, mw *middleware.Middleware)
 	users := router.Group("/users")
 
 	users.Get("/me", mw.SignedIn, uc.GetMe)
+	users.Get("/blocked", mw.SignedIn, uc.GetBlocked)
+	users.Get("/muted", mw.SignedIn, uc.GetMuted)
+	users.Get("/requests", mw.SignedIn, uc.GetFollowRequests)
 	users.Get("/:userID", mw.SignedIn, mw.ParseUUID("userID"), uc.GetUser)
 
 	users.Get("/detailed/me", mw.SignedIn, uc.GetDetailedMe)
@@ -35,6 +39,18 @@ func (uc *UserController) Routes(router fiber.Router, mw *middleware.Middleware)
 
 	users.Post("/:userID/follow", mw.SignedIn, mw.CSRF, mw.ParseUUID("userID"), uc.FollowUser)
 	users.Delete("/:userID/unfollow", mw.SignedIn, mw.CSRF, mw.ParseUUID("userID"), uc.UnfollowUser)
+
+	users.Post("/requests/:requestID/approve", mw.SignedIn, mw.CSRF, mw.ParseUUID("requestID"), uc.ApproveFollowRequest)
+	users.Post("/requests/:requestID/deny", mw.SignedIn, mw.CSRF, mw.ParseUUID("requestID"), uc.DenyFollowRequest)
+	users.Delete("/:userID/request", mw.SignedIn, mw.CSRF, mw.ParseUUID("userID"), uc.CancelFollowRequest)
+
+	users.Post("/:userID/block", mw.SignedIn, mw.CSRF, mw.ParseUUID("userID"), uc.BlockUser)
+	users.Delete("/:userID/unblock", mw.SignedIn, mw.CSRF, mw.ParseUUID("userID"), uc.UnblockUser)
+	users.Post("/:userID/mute", mw.SignedIn, mw.CSRF, mw.ParseUUID("userID"), uc.MuteUser)
+	users.Delete("/:userID/unmute", mw.SignedIn, mw.CSRF, mw.ParseUUID("userID"), uc.UnmuteUser)
+
+	users.Get("/:userID/followers", mw.SignedIn, mw.ParseUUID("userID"), uc.GetFollowers)
+	users.Get("/:userID/following", mw.SignedIn, mw.ParseUUID("userID"), uc.GetFollowing)
 }
 
 // GetMe [GET] /api/users/me
@@ -95,6 +111,10 @@ func (uc *UserController) UpdateUser(c *fiber.Ctx) error {
 		Username       *string `json:"username,optional"        z:"username"`
 		Password       *string `json:"password,optional"        z:"password"`
 		ProfilePicture *string `json:"profile_picture,optional" z:"profile_picture"`
+		Region         *string `json:"region,optional"          z:"region"`
+		Services       *[]int  `json:"streaming_services,optional"`
+		Private        *bool   `json:"private,optional"`
+		HideSpoilers   *bool   `json:"hide_spoilers,optional"`
 	}
 
 	p, err := parse.JSON[Payload](c.Body())
@@ -108,11 +128,23 @@ func (uc *UserController) UpdateUser(c *fiber.Ctx) error {
 		"username":        schemas.UsernameSchema.Optional(),
 		"password":        schemas.PasswordSchema.Optional(),
 		"profile_picture": schemas.ProfilePictureSchema.Optional(),
+		"region":          schemas.RegionSchema.Optional(),
 	}
 	if errs := schema.Validate(p); errs != nil {
 		return fault.Validation(errs.One())
 	}
 
+	if p.Services != nil {
+		if len(*p.Services) > schemas.MaxStreamingServices {
+			return fault.Validation("streaming services must be at most " + strconv.Itoa(schemas.MaxStreamingServices) + " providers")
+		}
+		for _, service := range *p.Services {
+			if errs := schemas.StreamingServiceSchema.Validate(service); errs != nil {
+				return fault.Validation(errs.One())
+			}
+		}
+	}
+
 	session := c.Locals("session").(*model.Session)
 
 	user, err := uc.user.UpdateUser(c.Context(),
@@ -122,6 +154,10 @@ func (uc *UserController) UpdateUser(c *fiber.Ctx) error {
 			Email:          p.Email,
 			Password:       p.Password,
 			ProfilePicture: p.ProfilePicture,
+			Region:         p.Region,
+			Services:       p.Services,
+			Private:        p.Private,
+			HideSpoilers:   p.HideSpoilers,
 		},
 	)
 	if err != nil {
@@ -148,11 +184,16 @@ func (uc *UserController) FollowUser(c *fiber.Ctx) error {
 	session := c.Locals("session").(*model.Session)
 	userID := c.Locals("userID").(uuid.UUID)
 
-	err := uc.user.FollowUser(c.Context(), session.UserID, userID)
+	request, err := uc.user.FollowUser(c.Context(), session.UserID, userID)
 	if err != nil {
 		return err
 	}
 
+	// private accounts have to approve the follow first
+	if request != nil {
+		return c.Status(http.StatusAccepted).JSON(fiber.Map{"follow_request": request})
+	}
+
 	return c.SendStatus(http.StatusNoContent)
 }
 
@@ -168,3 +209,171 @@ func (uc *UserController) UnfollowUser(c *fiber.Ctx) error {
 
 	return c.SendStatus(http.StatusNoContent)
 }
+
+// GetFollowers [GET] /api/users/:userID/followers
+func (uc *UserController) GetFollowers(c *fiber.Ctx) error {
+	q, err := parsePageQuery(c)
+	if err != nil {
+		return err
+	}
+
+	session := c.Locals("session").(*model.Session)
+	userID := c.Locals("userID").(uuid.UUID)
+
+	followers, err := uc.user.GetFollowers(c.Context(), session.UserID, userID, q.Cursor, q.Limit)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"followers": followers})
+}
+
+// GetFollowing [GET] /api/users/:userID/following
+func (uc *UserController) GetFollowing(c *fiber.Ctx) error {
+	q, err := parsePageQuery(c)
+	if err != nil {
+		return err
+	}
+
+	session := c.Locals("session").(*model.Session)
+	userID := c.Locals("userID").(uuid.UUID)
+
+	following, err := uc.user.GetFollowing(c.Context(), session.UserID, userID, q.Cursor, q.Limit)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"following": following})
+}
+
+// BlockUser [POST] /api/users/:userID/block
+func (uc *UserController) BlockUser(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	userID := c.Locals("userID").(uuid.UUID)
+
+	err := uc.user.BlockUser(c.Context(), session.UserID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// UnblockUser [DELETE] /api/users/:userID/unblock
+func (uc *UserController) UnblockUser(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	userID := c.Locals("userID").(uuid.UUID)
+
+	err := uc.user.UnblockUser(c.Context(), session.UserID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// MuteUser [POST] /api/users/:userID/mute
+func (uc *UserController) MuteUser(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	userID := c.Locals("userID").(uuid.UUID)
+
+	err := uc.user.MuteUser(c.Context(), session.UserID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// UnmuteUser [DELETE] /api/users/:userID/unmute
+func (uc *UserController) UnmuteUser(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	userID := c.Locals("userID").(uuid.UUID)
+
+	err := uc.user.UnmuteUser(c.Context(), session.UserID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// GetBlocked [GET] /api/users/blocked
+func (uc *UserController) GetBlocked(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+
+	users, err := uc.user.GetBlocked(c.Context(), session.UserID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"blocked": users})
+}
+
+// GetMuted [GET] /api/users/muted
+func (uc *UserController) GetMuted(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+
+	users, err := uc.user.GetMuted(c.Context(), session.UserID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"muted": users})
+}
+
+// GetFollowRequests [GET] /api/users/requests
+func (uc *UserController) GetFollowRequests(c *fiber.Ctx) error {
+	q, err := parsePageQuery(c)
+	if err != nil {
+		return err
+	}
+
+	session := c.Locals("session").(*model.Session)
+
+	requests, err := uc.user.GetFollowRequests(c.Context(), session.UserID, q.Cursor, q.Limit)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"follow_requests": requests})
+}
+
+// ApproveFollowRequest [POST] /api/users/requests/:requestID/approve
+func (uc *UserController) ApproveFollowRequest(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	requestID := c.Locals("requestID").(uuid.UUID)
+
+	err := uc.user.ApproveFollowRequest(c.Context(), session.UserID, requestID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// DenyFollowRequest [POST] /api/users/requests/:requestID/deny
+func (uc *UserController) DenyFollowRequest(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	requestID := c.Locals("requestID").(uuid.UUID)
+
+	err := uc.user.DenyFollowRequest(c.Context(), session.UserID, requestID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// CancelFollowRequest [DELETE] /api/users/:userID/request
+func (uc *UserController) CancelFollowRequest(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	userID := c.Locals("userID").(uuid.UUID)
+
+	err := uc.user.CancelFollowRequest(c.Context(), session.UserID, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"cine/entity/model"
+	"cine/entity/schemas"
+	"cine/pkg/fault"
+	"cine/server/middleware"
+	"cine/service"
+	"github.com/MarcusSanchez/go-parse"
+	"github.com/MarcusSanchez/go-z"
+	"github.com/gofiber/fiber/v2"
+	"net/http"
+)
+
+type WatchlistController struct {
+	watchlist service.WatchlistService
+}
+
+func NewWatchlistController(watchlist service.WatchlistService) *WatchlistController {
+	return &WatchlistController{watchlist: watchlist}
+}
+
+func (wc *WatchlistController) Routes(router fiber.Router, mw *middleware.Middleware) {
+	watchlist := router.Group("/watchlist")
+	watchlist.Get("/", mw.SignedIn, wc.GetWatchlist)
+	watchlist.Get("/watched", mw.SignedIn, wc.GetWatched)
+
+	watchlist.Post("/watched/:mediaType/:ref", mw.SignedIn, mw.CSRF, mw.ParseMediaType("mediaType"), mw.ParseInt("ref"), wc.MarkWatched)
+	watchlist.Post("/:mediaType/:ref", mw.SignedIn, mw.CSRF, mw.ParseMediaType("mediaType"), mw.ParseInt("ref"), wc.AddToWatchlist)
+	watchlist.Put("/:mediaType/:ref", mw.SignedIn, mw.CSRF, mw.ParseMediaType("mediaType"), mw.ParseInt("ref"), wc.UpdatePriority)
+	watchlist.Delete("/:mediaType/:ref", mw.SignedIn, mw.CSRF, mw.ParseMediaType("mediaType"), mw.ParseInt("ref"), wc.RemoveItem)
+}
+
+// GetWatchlist [GET] /api/watchlist
+func (wc *WatchlistController) GetWatchlist(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+
+	items, err := wc.watchlist.GetItems(c.Context(), session.UserID, model.WatchStatusWatchlist)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"watchlist": items})
+}
+
+// GetWatched [GET] /api/watchlist/watched
+func (wc *WatchlistController) GetWatched(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+
+	items, err := wc.watchlist.GetItems(c.Context(), session.UserID, model.WatchStatusWatched)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"watched": items})
+}
+
+// AddToWatchlist [POST] /api/watchlist/:mediaType/:ref
+func (wc *WatchlistController) AddToWatchlist(c *fiber.Ctx) error {
+	priority, err := wc.parsePriority(c)
+	if err != nil {
+		return err
+	}
+
+	session := c.Locals("session").(*model.Session)
+	ref := c.Locals("ref").(int)
+	mediaType := c.Locals("mediaType").(model.MediaType)
+
+	item, err := wc.watchlist.AddToWatchlist(c.Context(), session.UserID, ref, mediaType, priority)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"watch_item": item})
+}
+
+// MarkWatched [POST] /api/watchlist/watched/:mediaType/:ref
+func (wc *WatchlistController) MarkWatched(c *fiber.Ctx) error {
+	priority, err := wc.parsePriority(c)
+	if err != nil {
+		return err
+	}
+
+	session := c.Locals("session").(*model.Session)
+	ref := c.Locals("ref").(int)
+	mediaType := c.Locals("mediaType").(model.MediaType)
+
+	item, err := wc.watchlist.MarkWatched(c.Context(), session.UserID, ref, mediaType, priority)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"watch_item": item})
+}
+
+// UpdatePriority [PUT] /api/watchlist/:mediaType/:ref
+func (wc *WatchlistController) UpdatePriority(c *fiber.Ctx) error {
+	priority, err := wc.parsePriority(c)
+	if err != nil {
+		return err
+	}
+	if priority == nil {
+		return fault.BadRequest("priority is required")
+	}
+
+	session := c.Locals("session").(*model.Session)
+	ref := c.Locals("ref").(int)
+	mediaType := c.Locals("mediaType").(model.MediaType)
+
+	item, err := wc.watchlist.UpdatePriority(c.Context(), session.UserID, ref, mediaType, *priority)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"watch_item": item})
+}
+
+// RemoveItem [DELETE] /api/watchlist/:mediaType/:ref
+func (wc *WatchlistController) RemoveItem(c *fiber.Ctx) error {
+	session := c.Locals("session").(*model.Session)
+	ref := c.Locals("ref").(int)
+	mediaType := c.Locals("mediaType").(model.MediaType)
+
+	err := wc.watchlist.RemoveItem(c.Context(), session.UserID, ref, mediaType)
+	if err != nil {
+		return err
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// parsePriority reads the optional priority from the body, an empty body leaves it unset.
+func (wc *WatchlistController) parsePriority(c *fiber.Ctx) (*int, error) {
+	if len(c.Body()) == 0 {
+		return nil, nil
+	}
+
+	type Payload struct {
+		Priority *int `json:"priority,optional" z:"priority"`
+	}
+
+	p, err := parse.JSON[Payload](c.Body())
+	if err != nil {
+		return nil, fault.BadRequest(err.Error())
+	}
+
+	schema := z.Struct{
+		"priority": schemas.WatchPrioritySchema,
+	}
+	if errs := schema.Validate(p); errs != nil {
+		return nil, fault.Validation(errs.One())
+	}
+
+	return p.Priority, nil
+}
@@ -67,3 +67,25 @@ func (m *Middleware) ParseShowList(key string) func(*fiber.Ctx) error {
 		return c.Next()
 	}
 }
+
+func (m *Middleware) ParseTrendingType(key string) func(*fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		trendingType := c.Params(key)
+		if errs := schemas.TrendingTypeSchema.Validate(trendingType); errs != nil {
+			return fault.BadRequest(key + " must be a valid trending type")
+		}
+		c.Locals(key, tmdb.TrendingType(trendingType))
+		return c.Next()
+	}
+}
+
+func (m *Middleware) ParseTrendingWindow(key string) func(*fiber.Ctx) error {
+	return func(c *fiber.Ctx) error {
+		window := c.Params(key)
+		if errs := schemas.TrendingWindowSchema.Validate(window); errs != nil {
+			return fault.BadRequest(key + " must be a valid trending window")
+		}
+		c.Locals(key, tmdb.TrendingWindow(window))
+		return c.Next()
+	}
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"cine/datastore"
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/pkg/logger"
+	"context"
+	"github.com/google/uuid"
+)
+
+type ActivityService interface {
+	GetFeed(ctx context.Context, viewerID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedActivity], error)
+	GetUserActivity(ctx context.Context, viewerID, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedActivity], error)
+}
+
+type activityService struct {
+	store  datastore.Store
+	logger logger.Logger
+}
+
+func NewActivityService(store datastore.Store, logger logger.Logger) ActivityService {
+	return &activityService{store: store, logger: logger}
+}
+
+// GetFeed pages through the activity of everyone the viewer follows, newest first.
+func (as *activityService) GetFeed(ctx context.Context, viewerID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedActivity], error) {
+	return as.page(ctx, viewerID, cursor, limit, &model.ActivityF{FollowedBy: &viewerID})
+}
+
+// GetUserActivity pages through a single user's activity, newest first.
+func (as *activityService) GetUserActivity(ctx context.Context, viewerID, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedActivity], error) {
+	exists, err := as.store.Users().Exists(ctx, &model.UserF{ID: &userID})
+	if err != nil {
+		as.logger.Error("exists check on user failed", err)
+		return nil, fault.Internal("error getting activity")
+	} else if !exists {
+		return nil, fault.NotFound("user not found")
+	}
+
+	return as.page(ctx, viewerID, cursor, limit, &model.ActivityF{UserID: &userID})
+}
+
+func (as *activityService) page(
+	ctx context.Context,
+	viewerID uuid.UUID,
+	cursor string,
+	limit int,
+	activityF *model.ActivityF,
+) (*model.Page[*model.DetailedActivity], error) {
+	after, err := model.ParseCursor(cursor)
+	if err != nil {
+		return nil, fault.BadRequest("cursor is invalid")
+	}
+
+	activities, err := as.store.Activities().AllDetailed(ctx, viewerID, after, limit+1, activityF)
+	if err != nil {
+		as.logger.Error("failed getting activities", err)
+		return nil, fault.Internal("error getting activity")
+	}
+
+	page := paginate(activities, limit, func(activity *model.DetailedActivity) model.Cursor {
+		return model.Cursor{CreatedAt: activity.Activity.CreatedAt, ID: activity.Activity.ID}
+	})
+
+	if err = as.hideSpoilers(ctx, viewerID, page.Items); err != nil {
+		return nil, fault.Internal("error getting activity")
+	}
+
+	return page, nil
+}
+
+// hideSpoilers redacts the reviews and comments of the activities for a viewer who hides spoilers of media they haven't watched.
+func (as *activityService) hideSpoilers(ctx context.Context, viewerID uuid.UUID, activities []*model.DetailedActivity) error {
+	var mediaIDs []uuid.UUID
+	for _, activity := range activities {
+		if activity.Review != nil {
+			mediaIDs = append(mediaIDs, activity.Review.MediaID)
+		}
+		if activity.Comment != nil {
+			mediaIDs = append(mediaIDs, activity.Comment.MediaID)
+		}
+	}
+
+	guard, err := newSpoilerGuard(ctx, as.store, viewerID, mediaIDs)
+	if err != nil {
+		as.logger.Error("failed checking spoilers", err)
+		return err
+	}
+	for _, activity := range activities {
+		guard.review(activity.Review)
+		guard.comment(activity.Comment)
+	}
+	return nil
+}
+
+// recordActivity saves an activity for the actor's followers to see. The action it describes has
+// already happened by the time it is recorded, so failing to record it is only logged.
+func recordActivity(ctx context.Context, store datastore.Store, logger logger.Logger, activity *model.Activity) {
+	if _, err := store.Activities().Insert(ctx, activity); err != nil {
+		logger.Error("failed recording "+string(activity.Kind)+" activity", err)
+	}
+}
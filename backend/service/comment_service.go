@@ -5,31 +5,48 @@ import (
 	"cine/entity/model"
 	"cine/pkg/fault"
 	"cine/pkg/logger"
+	"cine/pkg/pubsub"
 	"context"
 	"github.com/google/uuid"
+	"time"
 )
 
 type CommentService interface {
 	CreateComment(ctx context.Context, ref int, mediaType model.MediaType, comment *model.Comment) (*model.Comment, error)
-	UpdateComment(ctx context.Context, userID, commentID uuid.UUID, content string) (*model.Comment, error)
+	UpdateComment(ctx context.Context, userID, commentID uuid.UUID, commentU *model.CommentU) (*model.Comment, error)
 	DeleteComment(ctx context.Context, userID, commentID uuid.UUID) error
+	PurgeComment(ctx context.Context, userID, commentID uuid.UUID) error
+	PurgeTombstones(ctx context.Context) (int, error)
 	GetComments(ctx context.Context, ref int, mediaType model.MediaType, userID uuid.UUID) ([]*model.DetailedComment, error)
 	GetCommentReplies(ctx context.Context, commentID, userID uuid.UUID) ([]*model.DetailedComment, error)
+	GetCommentRevisions(ctx context.Context, commentID, userID uuid.UUID) ([]*model.Revision, error)
+	CreateReviewComment(ctx context.Context, reviewID uuid.UUID, comment *model.Comment) (*model.Comment, error)
+	GetReviewComments(ctx context.Context, reviewID, userID uuid.UUID) ([]*model.DetailedComment, error)
 	LikeComment(ctx context.Context, like *model.Like) (*model.Like, error)
 	UnlikeComment(ctx context.Context, userID, commentID uuid.UUID) error
 }
 
 type commentService struct {
-	store  datastore.Store
-	logger logger.Logger
-	media  MediaService
+	store         datastore.Store
+	logger        logger.Logger
+	media         MediaService
+	notifications NotificationService
+	hub           pubsub.Hub
 }
 
-func NewCommentService(store datastore.Store, logger logger.Logger, media MediaService) CommentService {
+func NewCommentService(
+	store datastore.Store,
+	logger logger.Logger,
+	media MediaService,
+	notifications NotificationService,
+	hub pubsub.Hub,
+) CommentService {
 	return &commentService{
-		store:  store,
-		logger: logger,
-		media:  media,
+		store:         store,
+		logger:        logger,
+		media:         media,
+		notifications: notifications,
+		hub:           hub,
 	}
 }
 
@@ -52,26 +69,108 @@ func (cs *commentService) CreateComment(ctx context.Context, ref int, mediaType
 	}
 	comment.MediaID = media.ID
 
+	return cs.insert(ctx, comment, nil)
+}
+
+// CreateReviewComment starts a thread under a review the user can see. Replies within the thread
+// go through CreateComment, like any other reply.
+func (cs *commentService) CreateReviewComment(ctx context.Context, reviewID uuid.UUID, comment *model.Comment) (*model.Comment, error) {
+	review, err := cs.store.Reviews().One(ctx, &model.ReviewF{ID: &reviewID, VisibleTo: &comment.UserID})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return nil, fault.NotFound("review not found")
+		}
+		cs.logger.Error("failed getting review", err)
+		return nil, fault.Internal("failed to create comment")
+	}
+	if review.DeletedAt != nil {
+		return nil, fault.BadRequest("you can't comment on a deleted review")
+	}
+	comment.MediaID = review.MediaID
+	comment.ReviewID = &review.ID
+	comment.ReplyingToID = nil
+
+	return cs.insert(ctx, comment, review)
+}
+
+// insert saves a comment on a media, or a review of it when review is set, then lets whoever
+// it is replying to know.
+func (cs *commentService) insert(ctx context.Context, comment *model.Comment, review *model.Review) (*model.Comment, error) {
+	var replyingTo *model.Comment
 	if comment.ReplyingToID != nil {
-		exists, err = cs.store.Comments().Exists(ctx, &model.CommentF{ID: comment.ReplyingToID})
+		var err error
+		replyingTo, err = cs.store.Comments().One(ctx, &model.CommentF{ID: comment.ReplyingToID})
 		if err != nil {
-			cs.logger.Error("failed checking comment existence", err)
+			if datastore.IsNotFound(err) {
+				return nil, fault.NotFound("comment being replied to not found")
+			}
+			cs.logger.Error("failed getting comment being replied to", err)
 			return nil, fault.Internal("failed to create comment")
-		} else if !exists {
+		} else if replyingTo.DeletedAt != nil {
+			return nil, fault.BadRequest("you can't reply to a deleted comment")
+		}
+
+		visible, err := cs.threadVisible(ctx, replyingTo, comment.UserID)
+		if err != nil {
+			cs.logger.Error("failed checking review existence", err)
+			return nil, fault.Internal("failed to create comment")
+		} else if !visible {
 			return nil, fault.NotFound("comment being replied to not found")
 		}
+
+		blocked, err := cs.store.Users().Blocked(ctx, comment.UserID, replyingTo.UserID)
+		if err != nil {
+			cs.logger.Error("failed checking block", err)
+			return nil, fault.Internal("failed to create comment")
+		} else if blocked {
+			return nil, fault.Forbidden("you can't reply to this user")
+		}
+		// replies stay in the thread of what they reply to
+		comment.ReviewID = replyingTo.ReviewID
+	} else if review != nil {
+		blocked, err := cs.store.Users().Blocked(ctx, comment.UserID, review.UserID)
+		if err != nil {
+			cs.logger.Error("failed checking block", err)
+			return nil, fault.Internal("failed to create comment")
+		} else if blocked {
+			return nil, fault.Forbidden("you can't comment on this user's review")
+		}
 	}
 
-	comment, err = cs.store.Comments().Insert(ctx, comment)
+	comment, err := cs.store.Comments().Insert(ctx, comment)
 	if err != nil {
 		cs.logger.Error("failed creating comment", err)
 		return nil, fault.Internal("failed to create comment")
 	}
 
+	recordActivity(ctx, cs.store, cs.logger, &model.Activity{
+		UserID:    comment.UserID,
+		Kind:      model.ActivityCommentCreated,
+		MediaID:   &comment.MediaID,
+		CommentID: &comment.ID,
+	})
+	if replyingTo != nil {
+		cs.notifications.Notify(ctx, &model.Notification{
+			UserID:    replyingTo.UserID,
+			ActorID:   comment.UserID,
+			Kind:      model.NotificationCommentReplied,
+			CommentID: &comment.ID,
+		})
+	} else if review != nil {
+		cs.notifications.Notify(ctx, &model.Notification{
+			UserID:    review.UserID,
+			ActorID:   comment.UserID,
+			Kind:      model.NotificationReviewCommented,
+			CommentID: &comment.ID,
+			ReviewID:  &review.ID,
+		})
+	}
+	publishComment(ctx, cs.store, cs.hub, cs.logger, EventCommentCreated, comment)
+
 	return comment, nil
 }
 
-func (cs *commentService) UpdateComment(ctx context.Context, userID, commentID uuid.UUID, content string) (*model.Comment, error) {
+func (cs *commentService) UpdateComment(ctx context.Context, userID, commentID uuid.UUID, commentU *model.CommentU) (*model.Comment, error) {
 	comment, err := cs.store.Comments().One(ctx, &model.CommentF{ID: &commentID})
 	if err != nil {
 		if datastore.IsNotFound(err) {
@@ -83,14 +182,36 @@ func (cs *commentService) UpdateComment(ctx context.Context, userID, commentID u
 
 	if comment.UserID != userID {
 		return nil, fault.Forbidden("you are not allowed to update this comment")
+	} else if comment.DeletedAt != nil {
+		return nil, fault.NotFound("comment not found")
 	}
 
-	comment, err = cs.store.Comments().Update(ctx, commentID, &model.CommentU{Content: &content})
+	tx, err := cs.store.Transaction(ctx)
+	if err != nil {
+		cs.logger.Error("error starting transaction", err)
+		return nil, fault.Internal("failed to update comment")
+	}
+	defer tx.Rollback()
+
+	// the version being replaced is kept, so replies to it still make sense
+	revision := &model.Revision{CommentID: &comment.ID, Content: comment.Content, Spoiler: comment.Spoiler}
+	if _, err = tx.Revisions().Insert(ctx, revision); err != nil {
+		cs.logger.Error("failed inserting comment revision", err)
+		return nil, fault.Internal("failed to update comment")
+	}
+
+	comment, err = tx.Comments().Update(ctx, commentID, commentU)
 	if err != nil {
 		cs.logger.Error("failed updating comment", err)
 		return nil, fault.Internal("failed to update comment")
 	}
 
+	if err = tx.Commit(); err != nil {
+		cs.logger.Error("error committing transaction", err)
+		return nil, fault.Internal("failed to update comment")
+	}
+	publishComment(ctx, cs.store, cs.hub, cs.logger, EventCommentUpdated, comment)
+
 	return comment, nil
 }
 
@@ -106,16 +227,95 @@ func (cs *commentService) DeleteComment(ctx context.Context, userID, commentID u
 
 	if comment.UserID != userID {
 		return fault.Forbidden("you are not allowed to delete this comment")
+	} else if comment.DeletedAt != nil {
+		return fault.NotFound("comment not found")
 	}
 
-	if err = cs.store.Comments().Delete(ctx, commentID); err != nil {
+	tx, err := cs.store.Transaction(ctx)
+	if err != nil {
+		cs.logger.Error("error starting transaction", err)
+		return fault.Internal("failed to delete comment")
+	}
+	defer tx.Rollback()
+
+	// what the author wrote goes with the comment, only a tombstone is left to keep the replies in the thread
+	if _, err = tx.Revisions().DeleteExec(ctx, &model.RevisionF{CommentID: &comment.ID}); err != nil {
+		cs.logger.Error("failed deleting comment revisions", err)
+		return fault.Internal("failed to delete comment")
+	}
+	if _, err = tx.Activities().DeleteExec(ctx, &model.ActivityF{CommentID: &comment.ID}); err != nil {
+		cs.logger.Error("failed deleting comment activities", err)
+		return fault.Internal("failed to delete comment")
+	}
+	if _, err = tx.Notifications().DeleteExec(ctx, &model.NotificationF{CommentID: &comment.ID}); err != nil {
+		cs.logger.Error("failed deleting comment notifications", err)
+		return fault.Internal("failed to delete comment")
+	}
+
+	content, spoiler, now := model.CommentTombstone, false, time.Now()
+	comment, err = tx.Comments().Update(ctx, comment.ID, &model.CommentU{Content: &content, Spoiler: &spoiler, DeletedAt: &now})
+	if err != nil {
 		cs.logger.Error("failed deleting comment", err)
 		return fault.Internal("failed to delete comment")
 	}
 
+	if err = tx.Commit(); err != nil {
+		cs.logger.Error("error committing transaction", err)
+		return fault.Internal("failed to delete comment")
+	}
+	publishComment(ctx, cs.store, cs.hub, cs.logger, EventCommentDeleted, comment)
+
 	return nil
 }
 
+// PurgeComment hard deletes a comment along with its replies, only moderators can purge comments.
+func (cs *commentService) PurgeComment(ctx context.Context, userID, commentID uuid.UUID) error {
+	user, err := cs.store.Users().One(ctx, &model.UserF{ID: &userID})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return fault.NotFound("user not found")
+		}
+		cs.logger.Error("failed getting user", err)
+		return fault.Internal("failed to purge comment")
+	} else if !user.Moderator {
+		return fault.Forbidden("only moderators can purge comments")
+	}
+
+	comment, err := cs.store.Comments().One(ctx, &model.CommentF{ID: &commentID})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return fault.NotFound("comment not found")
+		}
+		cs.logger.Error("failed getting comment", err)
+		return fault.Internal("failed to purge comment")
+	}
+
+	if err = cs.store.Comments().Delete(ctx, comment.ID); err != nil {
+		cs.logger.Error("failed purging comment", err)
+		return fault.Internal("failed to purge comment")
+	}
+	publishComment(ctx, cs.store, cs.hub, cs.logger, EventCommentPurged, comment)
+
+	return nil
+}
+
+// PurgeTombstones hard deletes the tombstones that have no replies left. Tombstones only replied to
+// by other tombstones are deleted from the bottom of the thread up, so the whole chain goes.
+func (cs *commentService) PurgeTombstones(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		affected, err := cs.store.Comments().DeleteTombstones(ctx)
+		if err != nil {
+			cs.logger.Error("failed purging tombstones", err)
+			return total, fault.Internal("failed to purge tombstones")
+		}
+		if affected == 0 {
+			return total, nil
+		}
+		total += affected
+	}
+}
+
 func (cs *commentService) GetComments(ctx context.Context, ref int, mediaType model.MediaType, userID uuid.UUID) ([]*model.DetailedComment, error) {
 	media, err := cs.media.GetMedia(ctx, ref, mediaType)
 	if e, ok := fault.As(err); ok {
@@ -132,6 +332,10 @@ func (cs *commentService) GetComments(ctx context.Context, ref int, mediaType mo
 		return nil, fault.Internal("failed to get comments")
 	}
 
+	if err = cs.hideSpoilers(ctx, userID, comments); err != nil {
+		return nil, fault.Internal("failed to get comments")
+	}
+
 	return comments, nil
 }
 
@@ -145,15 +349,97 @@ func (cs *commentService) GetCommentReplies(ctx context.Context, commentID, user
 		return nil, fault.Internal("failed to get comment replies")
 	}
 
+	visible, err := cs.threadVisible(ctx, comment, userID)
+	if err != nil {
+		cs.logger.Error("failed checking review existence", err)
+		return nil, fault.Internal("failed to get comment replies")
+	} else if !visible {
+		return nil, fault.NotFound("comment not found")
+	}
+
 	comments, err := cs.store.Comments().AllRepliesAsDetailed(ctx, comment, userID)
 	if err != nil {
 		cs.logger.Error("failed getting comment replies", err)
 		return nil, fault.Internal("failed to get comment replies")
 	}
 
+	if err = cs.hideSpoilers(ctx, userID, comments); err != nil {
+		return nil, fault.Internal("failed to get comment replies")
+	}
+
+	return comments, nil
+}
+
+// GetCommentRevisions gets the versions of a comment the user can see, that its edits replaced, most recent first.
+func (cs *commentService) GetCommentRevisions(ctx context.Context, commentID, userID uuid.UUID) ([]*model.Revision, error) {
+	comment, err := cs.store.Comments().One(ctx, &model.CommentF{ID: &commentID, VisibleTo: &userID})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return nil, fault.NotFound("comment not found")
+		}
+		cs.logger.Error("failed getting comment", err)
+		return nil, fault.Internal("failed to get comment revisions")
+	}
+
+	revisions, err := cs.store.Revisions().All(ctx, &model.RevisionF{CommentID: &comment.ID})
+	if err != nil {
+		cs.logger.Error("failed getting comment revisions", err)
+		return nil, fault.Internal("failed to get comment revisions")
+	}
+
+	guard, err := newSpoilerGuard(ctx, cs.store, userID, []uuid.UUID{comment.MediaID})
+	if err != nil {
+		cs.logger.Error("failed checking spoilers", err)
+		return nil, fault.Internal("failed to get comment revisions")
+	}
+	guard.revisions(comment.UserID, comment.MediaID, comment.Spoiler, revisions)
+
+	return revisions, nil
+}
+
+// GetReviewComments gets the top level comments of a review's thread.
+func (cs *commentService) GetReviewComments(ctx context.Context, reviewID, userID uuid.UUID) ([]*model.DetailedComment, error) {
+	exists, err := cs.store.Reviews().Exists(ctx, &model.ReviewF{ID: &reviewID, VisibleTo: &userID})
+	if err != nil {
+		cs.logger.Error("failed checking review existence", err)
+		return nil, fault.Internal("failed to get comments")
+	} else if !exists {
+		return nil, fault.NotFound("review not found")
+	}
+
+	comments, err := cs.store.Comments().AllOnReviewAsDetailed(ctx, reviewID, userID)
+	if err != nil {
+		cs.logger.Error("failed getting review comments", err)
+		return nil, fault.Internal("failed to get comments")
+	}
+
+	if err = cs.hideSpoilers(ctx, userID, comments); err != nil {
+		return nil, fault.Internal("failed to get comments")
+	}
+
 	return comments, nil
 }
 
+// threadVisible reports whether the viewer can see the thread of the comment, comments in the thread of a review
+// are only seen by those who can see the review.
+func (cs *commentService) threadVisible(ctx context.Context, comment *model.Comment, viewerID uuid.UUID) (bool, error) {
+	if comment.ReviewID == nil {
+		return true, nil
+	}
+	return cs.store.Reviews().Exists(ctx, &model.ReviewF{ID: comment.ReviewID, VisibleTo: &viewerID})
+}
+
+// hideSpoilers redacts the comments for a viewer who hides spoilers of media they haven't watched.
+func (cs *commentService) hideSpoilers(ctx context.Context, viewerID uuid.UUID, comments []*model.DetailedComment) error {
+	guard, err := newSpoilerGuard(ctx, cs.store, viewerID, commentMediaIDs(comments))
+	if err != nil {
+		cs.logger.Error("failed checking spoilers", err)
+		return err
+	}
+	guard.comments(comments)
+	return nil
+}
+
 func (cs *commentService) LikeComment(ctx context.Context, like *model.Like) (*model.Like, error) {
 	exists, err := cs.store.Users().Exists(ctx, &model.UserF{ID: &like.UserID})
 	if err != nil {
@@ -163,14 +449,35 @@ func (cs *commentService) LikeComment(ctx context.Context, like *model.Like) (*m
 		return nil, fault.NotFound("user not found")
 	}
 
-	exists, err = cs.store.Comments().Exists(ctx, &model.CommentF{ID: &like.CommentID})
+	comment, err := cs.store.Comments().One(ctx, &model.CommentF{ID: &like.CommentID})
 	if err != nil {
-		cs.logger.Error("failed checking comment existence", err)
+		if datastore.IsNotFound(err) {
+			return nil, fault.NotFound("comment not found")
+		}
+		cs.logger.Error("failed getting comment", err)
 		return nil, fault.Internal("failed to like comment")
-	} else if !exists {
+	}
+
+	if comment.DeletedAt != nil {
 		return nil, fault.NotFound("comment not found")
 	}
 
+	visible, err := cs.threadVisible(ctx, comment, like.UserID)
+	if err != nil {
+		cs.logger.Error("failed checking review existence", err)
+		return nil, fault.Internal("failed to like comment")
+	} else if !visible {
+		return nil, fault.NotFound("comment not found")
+	}
+
+	blocked, err := cs.store.Users().Blocked(ctx, like.UserID, comment.UserID)
+	if err != nil {
+		cs.logger.Error("failed checking block", err)
+		return nil, fault.Internal("failed to like comment")
+	} else if blocked {
+		return nil, fault.Forbidden("you can't like this user's comments")
+	}
+
 	like, err = cs.store.Likes().Insert(ctx, like)
 	if err != nil {
 		if datastore.IsConstraint(err) {
@@ -180,6 +487,13 @@ func (cs *commentService) LikeComment(ctx context.Context, like *model.Like) (*m
 		return nil, fault.Internal("failed to like comment")
 	}
 
+	cs.notifications.Notify(ctx, &model.Notification{
+		UserID:    comment.UserID,
+		ActorID:   like.UserID,
+		Kind:      model.NotificationCommentLiked,
+		CommentID: &comment.ID,
+	})
+
 	return like, err
 }
 
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"cine/datastore"
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/pkg/logger"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type DiaryService interface {
+	CreateEntry(ctx context.Context, input *CreateDiaryEntryInput) (*model.DiaryEntry, error)
+	UpdateEntry(ctx context.Context, userID, entryID uuid.UUID, entryU *model.DiaryEntryU) (*model.DiaryEntry, error)
+	DeleteEntry(ctx context.Context, userID, entryID uuid.UUID) error
+	GetDiary(ctx context.Context, viewerID, userID uuid.UUID, year int, month *int) ([]*model.DetailedDiaryEntry, error)
+}
+
+type diaryService struct {
+	store  datastore.Store
+	logger logger.Logger
+	media  MediaService
+}
+
+func NewDiaryService(store datastore.Store, logger logger.Logger, media MediaService) DiaryService {
+	return &diaryService{store: store, logger: logger, media: media}
+}
+
+type CreateDiaryEntryInput struct {
+	UserID    uuid.UUID
+	Ref       int
+	MediaType model.MediaType
+	Entry     *model.DiaryEntry
+}
+
+func (ds *diaryService) CreateEntry(ctx context.Context, input *CreateDiaryEntryInput) (*model.DiaryEntry, error) {
+	if inFuture(input.Entry.WatchedOn) {
+		return nil, fault.BadRequest("watched_on must not be in the future")
+	}
+
+	media, err := ds.media.GetMedia(ctx, input.Ref, input.MediaType)
+	if e, ok := fault.As(err); ok {
+		if e.Code == fault.CodeNotFound {
+			return nil, fault.NotFound("media not found")
+		}
+		return nil, e
+	}
+	input.Entry.MediaID = media.ID
+
+	if input.Entry.ReviewID != nil {
+		if err = ds.checkReview(ctx, input.UserID, media.ID, *input.Entry.ReviewID); err != nil {
+			return nil, err
+		}
+	}
+
+	entry, err := ds.store.DiaryEntries().Insert(ctx, input.Entry)
+	if err != nil {
+		ds.logger.Error("failed inserting diary entry", err)
+		return nil, fault.Internal("error creating diary entry")
+	}
+
+	return entry, nil
+}
+
+func (ds *diaryService) UpdateEntry(ctx context.Context, userID, entryID uuid.UUID, entryU *model.DiaryEntryU) (*model.DiaryEntry, error) {
+	if !ds.hasFieldToUpdate(entryU) {
+		return nil, fault.BadRequest("no fields to update")
+	}
+	if entryU.WatchedOn != nil && inFuture(*entryU.WatchedOn) {
+		return nil, fault.BadRequest("watched_on must not be in the future")
+	}
+
+	entry, err := ds.store.DiaryEntries().One(ctx, &model.DiaryEntryF{ID: &entryID})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return nil, fault.NotFound("diary entry not found")
+		}
+		ds.logger.Error("failed to fetch diary entry", err)
+		return nil, fault.Internal("error updating diary entry")
+	}
+
+	if entry.UserID != userID {
+		return nil, fault.Forbidden("you are not allowed to update this diary entry")
+	}
+
+	if entryU.ReviewID != nil {
+		if err = ds.checkReview(ctx, userID, entry.MediaID, *entryU.ReviewID); err != nil {
+			return nil, err
+		}
+	}
+
+	entry, err = ds.store.DiaryEntries().Update(ctx, entry.ID, entryU)
+	if err != nil {
+		ds.logger.Error("failed updating diary entry", err)
+		return nil, fault.Internal("error updating diary entry")
+	}
+
+	return entry, nil
+}
+
+func (ds *diaryService) DeleteEntry(ctx context.Context, userID, entryID uuid.UUID) error {
+	entry, err := ds.store.DiaryEntries().One(ctx, &model.DiaryEntryF{ID: &entryID})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return fault.NotFound("diary entry not found")
+		}
+		ds.logger.Error("failed getting diary entry", err)
+		return fault.Internal("error deleting diary entry")
+	}
+
+	if entry.UserID != userID {
+		return fault.Forbidden("you are not allowed to delete this diary entry")
+	}
+
+	if err = ds.store.DiaryEntries().Delete(ctx, entry.ID); err != nil {
+		ds.logger.Error("failed deleting diary entry", err)
+		return fault.Internal("error deleting diary entry")
+	}
+
+	return nil
+}
+
+// GetDiary returns a user's entries for a whole year, or for a single month of it when month is set,
+// most recently watched first.
+func (ds *diaryService) GetDiary(ctx context.Context, viewerID, userID uuid.UUID, year int, month *int) ([]*model.DetailedDiaryEntry, error) {
+	exists, err := ds.store.Users().Exists(ctx, &model.UserF{ID: &userID})
+	if err != nil {
+		ds.logger.Error("exists check on user failed", err)
+		return nil, fault.Internal("error getting diary")
+	} else if !exists {
+		return nil, fault.NotFound("user not found")
+	}
+
+	// a user the viewer muted, or who is on either side of a block with them, has no diary to show
+	hidden, err := ds.store.Users().Exists(ctx, &model.UserF{ID: &userID, HiddenFrom: &viewerID})
+	if err != nil {
+		ds.logger.Error("exists check on user failed", err)
+		return nil, fault.Internal("error getting diary")
+	} else if hidden {
+		return nil, fault.NotFound("user not found")
+	}
+
+	visible, err := ds.store.Users().CanView(ctx, viewerID, userID)
+	if err != nil {
+		ds.logger.Error("user visibility check failed", err)
+		return nil, fault.Internal("error getting diary")
+	} else if !visible {
+		return nil, fault.Forbidden("this account is private")
+	}
+
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	before := from.AddDate(1, 0, 0)
+	if month != nil {
+		from = time.Date(year, time.Month(*month), 1, 0, 0, 0, 0, time.UTC)
+		before = from.AddDate(0, 1, 0)
+	}
+
+	entries, err := ds.store.DiaryEntries().AllWithMedia(ctx, &model.DiaryEntryF{
+		UserID:        &userID,
+		WatchedFrom:   &from,
+		WatchedBefore: &before,
+	})
+	if err != nil {
+		ds.logger.Error("failed getting diary entries", err)
+		return nil, fault.Internal("error getting diary")
+	}
+
+	return entries, nil
+}
+
+// checkReview makes sure a review linked to an entry is the user's own and is about the same media.
+func (ds *diaryService) checkReview(ctx context.Context, userID, mediaID, reviewID uuid.UUID) error {
+	deleted := false
+	review, err := ds.store.Reviews().One(ctx, &model.ReviewF{ID: &reviewID, Deleted: &deleted})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return fault.NotFound("review not found")
+		}
+		ds.logger.Error("failed getting review", err)
+		return fault.Internal("error linking review")
+	}
+
+	if review.UserID != userID {
+		return fault.Forbidden("you are not allowed to link this review")
+	}
+	if review.MediaID != mediaID {
+		return fault.BadRequest("review must be for the same media as the diary entry")
+	}
+
+	return nil
+}
+
+func (ds *diaryService) hasFieldToUpdate(entryU *model.DiaryEntryU) bool {
+	return entryU.WatchedOn != nil || entryU.Rewatch != nil || entryU.Rating != nil ||
+		entryU.ClearRating || entryU.ReviewID != nil || entryU.ClearReview
+}
+
+// inFuture reports whether a watch date is after today. A day of slack is given since
+// the date comes from the user's own timezone, which can be ahead of UTC.
+func inFuture(watchedOn time.Time) bool {
+	return watchedOn.After(time.Now().UTC().AddDate(0, 0, 1))
+}
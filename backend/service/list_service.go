@@ -17,9 +17,9 @@ type ListService interface {
 	AddMemberToList(ctx context.Context, ownerID uuid.UUID, listID uuid.UUID, userID uuid.UUID) error
 	RemoveMemberFromList(ctx context.Context, ownerID uuid.UUID, listID uuid.UUID, userID uuid.UUID) error
 
-	GetAllLists(ctx context.Context, memberID uuid.UUID) ([]*model.DetailedList, error)
-	GetPublicLists(ctx context.Context, userID uuid.UUID) ([]*model.DetailedList, error)
-	GetDetailedList(ctx context.Context, memberID uuid.UUID, id uuid.UUID) (*model.DetailedList, error)
+	GetAllLists(ctx context.Context, memberID uuid.UUID, streamable bool) ([]*model.DetailedList, error)
+	GetPublicLists(ctx context.Context, viewerID uuid.UUID, userID uuid.UUID) ([]*model.DetailedList, error)
+	GetDetailedList(ctx context.Context, memberID uuid.UUID, id uuid.UUID, streamable bool) (*model.DetailedList, error)
 
 	AddMovieToList(ctx context.Context, memberID uuid.UUID, listID uuid.UUID, ref int) error
 	RemoveMovieFromList(ctx context.Context, memberID uuid.UUID, listID uuid.UUID, ref int) error
@@ -29,20 +29,23 @@ type ListService interface {
 }
 
 type listService struct {
-	store  datastore.Store
-	logger logger.Logger
-	media  MediaService
+	store         datastore.Store
+	logger        logger.Logger
+	media         MediaService
+	notifications NotificationService
 }
 
 func NewListService(
 	store datastore.Store,
 	logger logger.Logger,
 	mediaService MediaService,
+	notifications NotificationService,
 ) ListService {
 	return &listService{
-		store:  store,
-		logger: logger,
-		media:  mediaService,
+		store:         store,
+		logger:        logger,
+		media:         mediaService,
+		notifications: notifications,
 	}
 }
 
@@ -84,6 +87,11 @@ func (ls *listService) CreateList(ctx context.Context, ownerID uuid.UUID, title
 		return nil, fault.Internal("error creating list")
 	}
 
+	// only public lists make it into feeds, and lists start out private
+	if list.Public {
+		recordActivity(ctx, ls.store, ls.logger, &model.Activity{UserID: ownerID, Kind: model.ActivityListCreated, ListID: &list.ID})
+	}
+
 	return list, nil
 }
 
@@ -123,6 +131,14 @@ func (ls *listService) UpdateList(ctx context.Context, ownerID uuid.UUID, id uui
 		return nil, fault.Internal("error updating list")
 	}
 
+	// only public lists make it into feeds, a list made private takes its activity with it
+	// so it doesn't resurface if the list is made public again
+	if list.Public {
+		recordActivity(ctx, ls.store, ls.logger, &model.Activity{UserID: ownerID, Kind: model.ActivityListUpdated, ListID: &list.ID})
+	} else if _, err = ls.store.Activities().DeleteExec(ctx, &model.ActivityF{ListID: &list.ID}); err != nil {
+		ls.logger.Error("failed deleting list activities", err)
+	}
+
 	return list, nil
 }
 
@@ -144,11 +160,26 @@ func (ls *listService) AddMemberToList(ctx context.Context, ownerID uuid.UUID, l
 		return fault.Internal("error adding user to list")
 	}
 
+	blocked, err := ls.store.Users().Blocked(ctx, ownerID, userID)
+	if err != nil {
+		ls.logger.Error("error checking block", err)
+		return fault.Internal("error adding user to list")
+	} else if blocked {
+		return fault.Forbidden("you can't add this user to lists")
+	}
+
 	if err = ls.store.Lists().AddMember(ctx, list, userID); err != nil {
 		ls.logger.Error("error adding user to list", err)
 		return fault.Internal("error adding user to list")
 	}
 
+	ls.notifications.Notify(ctx, &model.Notification{
+		UserID:  userID,
+		ActorID: ownerID,
+		Kind:    model.NotificationListMemberAdded,
+		ListID:  &list.ID,
+	})
+
 	return nil
 }
 
@@ -178,7 +209,9 @@ func (ls *listService) RemoveMemberFromList(ctx context.Context, ownerID uuid.UU
 	return nil
 }
 
-func (ls *listService) GetAllLists(ctx context.Context, memberID uuid.UUID) ([]*model.DetailedList, error) {
+// GetAllLists returns the lists memberID is a member of. If streamable is set, only the media
+// included in one of the member's streaming services are kept.
+func (ls *listService) GetAllLists(ctx context.Context, memberID uuid.UUID, streamable bool) ([]*model.DetailedList, error) {
 	exists, err := ls.store.Users().Exists(ctx, &model.UserF{ID: &memberID})
 	if err != nil {
 		ls.logger.Error("error checking user existence", err)
@@ -193,6 +226,21 @@ func (ls *listService) GetAllLists(ctx context.Context, memberID uuid.UUID) ([]*
 		return nil, fault.Internal("error fetching list")
 	}
 
+	if streamable {
+		// the same title is often in several lists, so every title is only looked up once
+		var medias []*model.Media
+		for _, lwm := range lwms {
+			medias = append(medias, lwm.Medias...)
+		}
+		keep, err := ls.streamable(ctx, memberID, medias)
+		if err != nil {
+			return nil, err
+		}
+		for _, lwm := range lwms {
+			lwm.Medias = ls.keepMedia(lwm.Medias, keep)
+		}
+	}
+
 	detailed := make([]*model.DetailedList, 0, len(lwms))
 	for _, lwm := range lwms {
 		detailed = append(detailed, &model.DetailedList{
@@ -205,7 +253,8 @@ func (ls *listService) GetAllLists(ctx context.Context, memberID uuid.UUID) ([]*
 	return detailed, nil
 }
 
-func (ls *listService) GetPublicLists(ctx context.Context, userID uuid.UUID) ([]*model.DetailedList, error) {
+// GetPublicLists gets the public lists the user is a member of, if the viewer may see the user's lists.
+func (ls *listService) GetPublicLists(ctx context.Context, viewerID uuid.UUID, userID uuid.UUID) ([]*model.DetailedList, error) {
 	exists, err := ls.store.Users().Exists(ctx, &model.UserF{ID: &userID})
 	if err != nil {
 		ls.logger.Error("error checking user existence", err)
@@ -214,6 +263,14 @@ func (ls *listService) GetPublicLists(ctx context.Context, userID uuid.UUID) ([]
 		return nil, fault.NotFound("user not found")
 	}
 
+	visible, err := ls.store.Users().CanView(ctx, viewerID, userID)
+	if err != nil {
+		ls.logger.Error("error checking user visibility", err)
+		return nil, fault.Internal("error fetching list")
+	} else if !visible {
+		return nil, fault.Forbidden("this account is private")
+	}
+
 	public := true
 
 	lwms, err := ls.store.Lists().AllWithMedia(ctx, &model.ListF{HasMember: &userID, Public: &public})
@@ -234,7 +291,9 @@ func (ls *listService) GetPublicLists(ctx context.Context, userID uuid.UUID) ([]
 	return detailed, nil
 }
 
-func (ls *listService) GetDetailedList(ctx context.Context, memberID uuid.UUID, id uuid.UUID) (*model.DetailedList, error) {
+// GetDetailedList returns the list with its members and media. If streamable is set, only the media
+// included in one of the member's streaming services are kept.
+func (ls *listService) GetDetailedList(ctx context.Context, memberID uuid.UUID, id uuid.UUID, streamable bool) (*model.DetailedList, error) {
 	list, err := ls.store.Lists().One(ctx, &model.ListF{ID: &id})
 	if err != nil {
 		if datastore.IsNotFound(err) {
@@ -250,8 +309,18 @@ func (ls *listService) GetDetailedList(ctx context.Context, memberID uuid.UUID,
 		return nil, fault.Internal("error fetching list")
 	}
 
-	if !list.Public && !ls.hasMember(users, memberID) {
-		return nil, fault.Forbidden("you are not a member of this list")
+	if !ls.hasMember(users, memberID) {
+		if !list.Public {
+			return nil, fault.Forbidden("you are not a member of this list")
+		}
+
+		visible, err := ls.store.Users().CanView(ctx, memberID, list.OwnerID)
+		if err != nil {
+			ls.logger.Error("error checking owner visibility", err)
+			return nil, fault.Internal("error fetching list")
+		} else if !visible {
+			return nil, fault.Forbidden("the owner of this list is private")
+		}
 	}
 
 	media, err := ls.store.Lists().AllMedia(ctx, list)
@@ -260,6 +329,14 @@ func (ls *listService) GetDetailedList(ctx context.Context, memberID uuid.UUID,
 		return nil, fault.Internal("error fetching list")
 	}
 
+	if streamable {
+		keep, err := ls.streamable(ctx, memberID, media)
+		if err != nil {
+			return nil, err
+		}
+		media = ls.keepMedia(media, keep)
+	}
+
 	return &model.DetailedList{
 		List:    list,
 		Members: users,
@@ -393,7 +470,7 @@ func (ls *listService) RemoveShowFromList(ctx context.Context, memberID uuid.UUI
 }
 
 func (ls *listService) filterMedia(medias []*model.Media, mediaType model.MediaType) []*model.Media {
-	var movies []*model.Media
+	movies := make([]*model.Media, 0, len(medias))
 	for _, media := range medias {
 		if media.MediaType == mediaType {
 			movies = append(movies, media)
@@ -402,6 +479,39 @@ func (ls *listService) filterMedia(medias []*model.Media, mediaType model.MediaT
 	return movies
 }
 
+// streamable returns the ids of the medias userID can stream on one of their services.
+func (ls *listService) streamable(ctx context.Context, userID uuid.UUID, medias []*model.Media) (map[uuid.UUID]bool, error) {
+	seen := make(map[uuid.UUID]bool, len(medias))
+	unique := make([]*model.Media, 0, len(medias))
+	for _, media := range medias {
+		if !seen[media.ID] {
+			seen[media.ID] = true
+			unique = append(unique, media)
+		}
+	}
+
+	streamable, err := ls.media.FilterStreamable(ctx, userID, unique)
+	if err != nil {
+		return nil, err
+	}
+
+	keep := make(map[uuid.UUID]bool, len(streamable))
+	for _, media := range streamable {
+		keep[media.ID] = true
+	}
+	return keep, nil
+}
+
+func (ls *listService) keepMedia(medias []*model.Media, keep map[uuid.UUID]bool) []*model.Media {
+	kept := make([]*model.Media, 0, len(medias))
+	for _, media := range medias {
+		if keep[media.ID] {
+			kept = append(kept, media)
+		}
+	}
+	return kept
+}
+
 func (ls *listService) hasMember(members []*model.User, userID uuid.UUID) bool {
 	for _, member := range members {
 		if member.ID == userID {
@@ -8,6 +8,10 @@ import (
 	"cine/pkg/tmdb"
 	"context"
 	"github.com/google/uuid"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
 )
 
 type MediaService interface {
@@ -16,19 +20,36 @@ type MediaService interface {
 	GetMedia(ctx context.Context, ref int, mediaType model.MediaType) (*model.Media, error)
 	GetMediaByID(ctx context.Context, id uuid.UUID) (*model.Media, error)
 
-	GetDetailedMovie(ctx context.Context, ref int) (*tmdb.DetailedMovie, error)
-	GetDetailedShow(ctx context.Context, ref int) (*tmdb.DetailedShow, error)
+	GetDetailedMovie(ctx context.Context, userID uuid.UUID, ref int, appends ...tmdb.Append) (*DetailedMovie, error)
+	GetDetailedShow(ctx context.Context, userID uuid.UUID, ref int, appends ...tmdb.Append) (*DetailedShow, error)
+
+	GetMovieVideos(ctx context.Context, ref int) (*tmdb.Videos, error)
+	GetShowVideos(ctx context.Context, ref int) (*tmdb.Videos, error)
+	GetMovieImages(ctx context.Context, ref int) (*tmdb.Images, error)
+	GetShowImages(ctx context.Context, ref int) (*tmdb.Images, error)
 
 	GetMovieCredits(ctx context.Context, ref int) (*tmdb.MovieCredits, error)
 	GetShowCredits(ctx context.Context, ref int) (*tmdb.ShowCredits, error)
 
 	GetShowDetailedSeason(ctx context.Context, ref int, seasonNumber int) (*tmdb.DetailedSeason, error)
 
-	GetMovieList(ctx context.Context, list tmdb.MovieList) ([]tmdb.Movie, error)
-	GetShowList(ctx context.Context, list tmdb.ShowList) ([]tmdb.Show, error)
+	GetMovieList(ctx context.Context, list tmdb.MovieList, page int) (*tmdb.Page[tmdb.Movie], error)
+	GetShowList(ctx context.Context, list tmdb.ShowList, page int) (*tmdb.Page[tmdb.Show], error)
+
+	SearchMovies(ctx context.Context, query string, page int) (*tmdb.Page[tmdb.Movie], error)
+	SearchShows(ctx context.Context, query string, page int) (*tmdb.Page[tmdb.Show], error)
+
+	Discover(ctx context.Context, mediaType model.MediaType, filter tmdb.DiscoverFilter) (*DiscoverOutput, error)
+	GetTrending(ctx context.Context, trendingType tmdb.TrendingType, window tmdb.TrendingWindow, page int) (*tmdb.Page[tmdb.MultiResult], error)
 
-	SearchMovies(ctx context.Context, query string, page int) ([]tmdb.Movie, error)
-	SearchShows(ctx context.Context, query string, page int) ([]tmdb.Show, error)
+	GetMovieRecommendations(ctx context.Context, userID uuid.UUID, ref int, page int) (*Recommendations[tmdb.Movie], error)
+	GetShowRecommendations(ctx context.Context, userID uuid.UUID, ref int, page int) (*Recommendations[tmdb.Show], error)
+
+	GetPerson(ctx context.Context, ref int) (*tmdb.DetailedPerson, error)
+	GetPersonFilmography(ctx context.Context, ref int) ([]FilmographyCredit, error)
+	SearchPeople(ctx context.Context, query string, page int) (*tmdb.Page[tmdb.Person], error)
+
+	FilterStreamable(ctx context.Context, userID uuid.UUID, medias []*model.Media) ([]*model.Media, error)
 }
 
 type mediaService struct {
@@ -58,7 +79,7 @@ func (ms *mediaService) CreateMedia(ctx context.Context, ref int, mediaType mode
 		return nil, fault.Conflict("media already exists")
 	}
 
-	media, err := ms.mediaFromRef(ref, mediaType)
+	media, err := ms.mediaFromRef(ctx, ref, mediaType)
 	if err != nil {
 		return nil, err
 	}
@@ -80,8 +101,11 @@ func (ms *mediaService) GetMedia(ctx context.Context, ref int, mediaType model.M
 			// so we need to check if it exists there, and if so, create a record for it
 			media, err = ms.CreateMedia(ctx, ref, mediaType)
 			if e, ok := fault.As(err); ok {
-				if e.Code == fault.CodeNotFound {
+				switch e.Code {
+				case fault.CodeNotFound:
 					return nil, fault.NotFound("media not found")
+				case fault.CodeUnavailable:
+					return nil, e
 				}
 				return nil, fault.Internal("error getting media")
 			}
@@ -107,139 +131,686 @@ func (ms *mediaService) GetMediaByID(ctx context.Context, id uuid.UUID) (*model.
 	return media, nil
 }
 
-func (ms *mediaService) GetDetailedMovie(ctx context.Context, ref int) (*tmdb.DetailedMovie, error) {
-	movie, err := ms.tmdb.GetMovie(ref)
+// DefaultWatchRegion is used for watch providers when a user hasn't picked a region.
+const DefaultWatchRegion = "US"
+
+// streamableConcurrency bounds the provider lookups made at once when filtering a list.
+const streamableConcurrency = 8
+
+// DetailedMovie is a movie with any appended sub-resources, the providers it can be watched on in the
+// user's region, and where it sits in their watchlist. WatchProviders is nil when the movie isn't offered
+// there, or the providers couldn't be fetched.
+type DetailedMovie struct {
+	*tmdb.MovieBundle
+	WatchRegion    string                `json:"watch_region"`
+	WatchProviders *tmdb.RegionProviders `json:"watch_providers"`
+	ViewerStatus   ViewerStatus          `json:"viewer_status"`
+}
+
+// DetailedShow is a show with any appended sub-resources, the providers it can be watched on in the
+// user's region, and where it sits in their watchlist. WatchProviders is nil when the show isn't offered
+// there, or the providers couldn't be fetched.
+type DetailedShow struct {
+	*tmdb.ShowBundle
+	WatchRegion    string                `json:"watch_region"`
+	WatchProviders *tmdb.RegionProviders `json:"watch_providers"`
+	ViewerStatus   ViewerStatus          `json:"viewer_status"`
+}
+
+// ViewerStatus says whether the viewer has the media on their watchlist or in their watched set.
+// Priority is only set when it is in one of them.
+type ViewerStatus struct {
+	InWatchlist bool `json:"in_watchlist"`
+	Watched     bool `json:"watched"`
+	Priority    *int `json:"priority"`
+}
+
+// GetDetailedMovie fetches the movie's details, and any appends in the same round trip to TMDB.
+func (ms *mediaService) GetDetailedMovie(ctx context.Context, userID uuid.UUID, ref int, appends ...tmdb.Append) (*DetailedMovie, error) {
+	region, err := ms.watchRegion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg        sync.WaitGroup
+		movie     *tmdb.MovieBundle
+		providers *tmdb.WatchProviders
+		status    ViewerStatus
+		movieErr  error
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		movie, movieErr = ms.movieBundle(ctx, ref, appends)
+	}()
+	go func() {
+		defer wg.Done()
+		providers = ms.watchProviders(ctx, model.MediaTypeMovie, ref)
+	}()
+	go func() {
+		defer wg.Done()
+		status = ms.viewerStatus(ctx, userID, model.MediaTypeMovie, ref)
+	}()
+	wg.Wait()
+
+	if movieErr != nil {
+		return nil, tmdbError(ms.logger, movieErr, "movie not found", "error getting movie")
+	}
+
+	detailed := &DetailedMovie{MovieBundle: movie, WatchRegion: region, ViewerStatus: status}
+	if providers != nil {
+		detailed.WatchProviders = providers.Region(region)
+	}
+	return detailed, nil
+}
+
+// GetDetailedShow fetches the show's details, and any appends in the same round trip to TMDB.
+func (ms *mediaService) GetDetailedShow(ctx context.Context, userID uuid.UUID, ref int, appends ...tmdb.Append) (*DetailedShow, error) {
+	region, err := ms.watchRegion(ctx, userID)
 	if err != nil {
-		if tmdb.IsNotFound(err) {
-			return nil, fault.NotFound("movie not found")
+		return nil, err
+	}
+
+	var (
+		wg        sync.WaitGroup
+		show      *tmdb.ShowBundle
+		providers *tmdb.WatchProviders
+		status    ViewerStatus
+		showErr   error
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		show, showErr = ms.showBundle(ctx, ref, appends)
+	}()
+	go func() {
+		defer wg.Done()
+		providers = ms.watchProviders(ctx, model.MediaTypeShow, ref)
+	}()
+	go func() {
+		defer wg.Done()
+		status = ms.viewerStatus(ctx, userID, model.MediaTypeShow, ref)
+	}()
+	wg.Wait()
+
+	if showErr != nil {
+		return nil, tmdbError(ms.logger, showErr, "show not found", "error getting show")
+	}
+
+	detailed := &DetailedShow{ShowBundle: show, WatchRegion: region, ViewerStatus: status}
+	if providers != nil {
+		detailed.WatchProviders = providers.Region(region)
+	}
+	return detailed, nil
+}
+
+// viewerStatus looks up the viewer's watch item for the media. Like the providers it is best-effort,
+// a failed lookup leaves every flag unset rather than failing the details.
+func (ms *mediaService) viewerStatus(ctx context.Context, userID uuid.UUID, mediaType model.MediaType, ref int) ViewerStatus {
+	var status ViewerStatus
+	item, err := ms.store.WatchItems().OneByRef(ctx, userID, mediaType, ref)
+	if err != nil {
+		if !datastore.IsNotFound(err) {
+			ms.logger.Error("failed getting viewer watch item", err)
 		}
-		ms.logger.Error("failed to search movie by ref", err)
-		return nil, fault.Internal("error getting movie")
+		return status
+	}
+
+	status.InWatchlist = item.Status == model.WatchStatusWatchlist
+	status.Watched = item.Status == model.WatchStatusWatched
+	if status.InWatchlist || status.Watched {
+		status.Priority = &item.Priority
+	}
+	return status
+}
+
+// movieBundle only asks TMDB for a bundle when there is something to append, so plain details
+// share their cache entry with everything else that needs the movie.
+func (ms *mediaService) movieBundle(ctx context.Context, ref int, appends []tmdb.Append) (*tmdb.MovieBundle, error) {
+	if len(appends) > 0 {
+		return ms.tmdb.GetMovieBundle(ctx, ref, appends...)
+	}
+	movie, err := ms.tmdb.GetMovie(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return &tmdb.MovieBundle{DetailedMovie: movie}, nil
+}
+
+// showBundle only asks TMDB for a bundle when there is something to append, so plain details
+// share their cache entry with everything else that needs the show.
+func (ms *mediaService) showBundle(ctx context.Context, ref int, appends []tmdb.Append) (*tmdb.ShowBundle, error) {
+	if len(appends) > 0 {
+		return ms.tmdb.GetShowBundle(ctx, ref, appends...)
+	}
+	show, err := ms.tmdb.GetShow(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return &tmdb.ShowBundle{DetailedShow: show}, nil
+}
+
+func (ms *mediaService) GetMovieVideos(ctx context.Context, ref int) (*tmdb.Videos, error) {
+	videos, err := ms.tmdb.GetMovieVideos(ctx, ref)
+	if err != nil {
+		return nil, tmdbError(ms.logger, err, "movie not found", "error getting movie videos")
+	}
+
+	return videos, nil
+}
+
+func (ms *mediaService) GetShowVideos(ctx context.Context, ref int) (*tmdb.Videos, error) {
+	videos, err := ms.tmdb.GetShowVideos(ctx, ref)
+	if err != nil {
+		return nil, tmdbError(ms.logger, err, "show not found", "error getting show videos")
+	}
+
+	return videos, nil
+}
+
+func (ms *mediaService) GetMovieImages(ctx context.Context, ref int) (*tmdb.Images, error) {
+	images, err := ms.tmdb.GetMovieImages(ctx, ref)
+	if err != nil {
+		return nil, tmdbError(ms.logger, err, "movie not found", "error getting movie images")
 	}
 
-	return movie, err
+	return images, nil
 }
 
-func (ms *mediaService) GetDetailedShow(ctx context.Context, ref int) (*tmdb.DetailedShow, error) {
-	show, err := ms.tmdb.GetShow(ref)
+func (ms *mediaService) GetShowImages(ctx context.Context, ref int) (*tmdb.Images, error) {
+	images, err := ms.tmdb.GetShowImages(ctx, ref)
 	if err != nil {
-		if tmdb.IsNotFound(err) {
-			return nil, fault.NotFound("show not found")
+		return nil, tmdbError(ms.logger, err, "show not found", "error getting show images")
+	}
+
+	return images, nil
+}
+
+// FilterStreamable keeps the medias that are included in a subscription to one of the user's
+// streaming services, in the user's region. Order is preserved.
+func (ms *mediaService) FilterStreamable(ctx context.Context, userID uuid.UUID, medias []*model.Media) ([]*model.Media, error) {
+	user, err := ms.store.Users().One(ctx, &model.UserF{ID: &userID})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return nil, fault.NotFound("user not found")
 		}
-		ms.logger.Error("failed to search show by ref", err)
-		return nil, fault.Internal("error getting show")
+		ms.logger.Error("failed to fetch user", err)
+		return nil, fault.Internal("error filtering streamable media")
 	}
 
-	return show, err
+	if len(user.Services) == 0 || len(medias) == 0 {
+		return []*model.Media{}, nil
+	}
+
+	region := DefaultWatchRegion
+	if user.Region != nil {
+		region = *user.Region
+	}
+
+	var (
+		wg          sync.WaitGroup
+		semaphore   = make(chan struct{}, streamableConcurrency)
+		streamable  = make([]bool, len(medias))
+		unavailable atomic.Bool
+	)
+	for i, media := range medias {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, media *model.Media) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			providers, err := ms.fetchWatchProviders(ctx, media.MediaType, media.Ref)
+			if err != nil {
+				if tmdb.IsUnavailable(err) {
+					unavailable.Store(true)
+				} else if !tmdb.IsNotFound(err) {
+					ms.logger.Warn("failed to fetch watch providers for ref: "+strconv.Itoa(media.Ref), err.Error())
+				}
+				return
+			}
+
+			if offers := providers.Region(region); offers != nil {
+				streamable[i] = offers.Streams(user.Services)
+			}
+		}(i, media)
+	}
+	wg.Wait()
+
+	if unavailable.Load() {
+		return nil, fault.Unavailable("the movie database is unavailable")
+	}
+
+	filtered := make([]*model.Media, 0, len(medias))
+	for i, media := range medias {
+		if streamable[i] {
+			filtered = append(filtered, media)
+		}
+	}
+	return filtered, nil
 }
 
-func (ms *mediaService) GetMovieCredits(_ context.Context, ref int) (*tmdb.MovieCredits, error) {
-	credits, err := ms.tmdb.GetMovieCredits(ref)
+// watchRegion is the region the user wants watch providers for.
+func (ms *mediaService) watchRegion(ctx context.Context, userID uuid.UUID) (string, error) {
+	user, err := ms.store.Users().One(ctx, &model.UserF{ID: &userID})
 	if err != nil {
-		if tmdb.IsNotFound(err) {
-			return nil, fault.NotFound("movie not found")
+		if datastore.IsNotFound(err) {
+			return "", fault.NotFound("user not found")
 		}
-		ms.logger.Error("failed to search movie credits by ref", err)
-		return nil, fault.Internal("error getting movie credits")
+		ms.logger.Error("failed to fetch user", err)
+		return "", fault.Internal("error fetching watch region")
 	}
 
-	return credits, nil
+	if user.Region == nil {
+		return DefaultWatchRegion, nil
+	}
+	return *user.Region, nil
 }
 
-func (ms *mediaService) GetShowCredits(_ context.Context, ref int) (*tmdb.ShowCredits, error) {
-	credits, err := ms.tmdb.GetShowCredits(ref)
+// watchProviders is best-effort, a title's details are still worth showing without them.
+func (ms *mediaService) watchProviders(ctx context.Context, mediaType model.MediaType, ref int) *tmdb.WatchProviders {
+	providers, err := ms.fetchWatchProviders(ctx, mediaType, ref)
 	if err != nil {
-		if tmdb.IsNotFound(err) {
-			return nil, fault.NotFound("show not found")
+		if !tmdb.IsNotFound(err) {
+			ms.logger.Warn("failed to fetch watch providers for ref: "+strconv.Itoa(ref), err.Error())
 		}
-		ms.logger.Error("failed to search show credits by ref", err)
-		return nil, fault.Internal("error getting show credits")
+		return nil
+	}
+	return providers
+}
+
+func (ms *mediaService) fetchWatchProviders(ctx context.Context, mediaType model.MediaType, ref int) (*tmdb.WatchProviders, error) {
+	if mediaType == model.MediaTypeMovie {
+		return ms.tmdb.GetMovieWatchProviders(ctx, ref)
+	}
+	return ms.tmdb.GetShowWatchProviders(ctx, ref)
+}
+
+func (ms *mediaService) GetMovieCredits(ctx context.Context, ref int) (*tmdb.MovieCredits, error) {
+	credits, err := ms.tmdb.GetMovieCredits(ctx, ref)
+	if err != nil {
+		return nil, tmdbError(ms.logger, err, "movie not found", "error getting movie credits")
 	}
 
 	return credits, nil
 }
 
-func (ms *mediaService) GetShowDetailedSeason(_ context.Context, ref int, seasonNumber int) (*tmdb.DetailedSeason, error) {
-	season, err := ms.tmdb.GetShowSeasonDetails(ref, seasonNumber)
+func (ms *mediaService) GetShowCredits(ctx context.Context, ref int) (*tmdb.ShowCredits, error) {
+	credits, err := ms.tmdb.GetShowCredits(ctx, ref)
 	if err != nil {
-		if tmdb.IsNotFound(err) {
-			return nil, fault.NotFound("show not found")
-		}
-		ms.logger.Error("failed to search show season by ref", err)
-		return nil, fault.Internal("error getting show season")
+		return nil, tmdbError(ms.logger, err, "show not found", "error getting show credits")
+	}
+
+	return credits, nil
+}
+
+func (ms *mediaService) GetShowDetailedSeason(ctx context.Context, ref int, seasonNumber int) (*tmdb.DetailedSeason, error) {
+	season, err := ms.tmdb.GetShowSeasonDetails(ctx, ref, seasonNumber)
+	if err != nil {
+		return nil, tmdbError(ms.logger, err, "show not found", "error getting show season")
 	}
 
 	return season, nil
 }
 
-func (ms *mediaService) GetMovieList(_ context.Context, list tmdb.MovieList) (movies []tmdb.Movie, err error) {
+func (ms *mediaService) GetMovieList(ctx context.Context, list tmdb.MovieList, page int) (movies *tmdb.Page[tmdb.Movie], err error) {
+	if page < 1 || page > tmdb.MaxPage {
+		return nil, fault.BadRequest("page must be between 1 and " + strconv.Itoa(tmdb.MaxPage))
+	}
+
 	switch list {
 	case tmdb.MovieListNowPlaying:
-		movies, err = ms.tmdb.ListNowPlayingMovies()
+		movies, err = ms.tmdb.ListNowPlayingMovies(ctx, page)
 	case tmdb.MovieListPopular:
-		movies, err = ms.tmdb.ListPopularMovies()
+		movies, err = ms.tmdb.ListPopularMovies(ctx, page)
 	case tmdb.MovieListTopRated:
-		movies, err = ms.tmdb.ListTopRatedMovies()
+		movies, err = ms.tmdb.ListTopRatedMovies(ctx, page)
 	case tmdb.MovieListUpcoming:
-		movies, err = ms.tmdb.ListUpcomingMovies()
+		movies, err = ms.tmdb.ListUpcomingMovies(ctx, page)
 	default:
 		return nil, fault.BadRequest("invalid movie list")
 	}
 	if err != nil {
-		ms.logger.Error("failed to search movie list", err)
-		return nil, fault.Internal("error getting movie list")
+		return nil, tmdbError(ms.logger, err, "movie list not found", "error getting movie list")
 	}
 	return movies, nil
 }
 
-func (ms *mediaService) GetShowList(_ context.Context, list tmdb.ShowList) (shows []tmdb.Show, err error) {
+func (ms *mediaService) GetShowList(ctx context.Context, list tmdb.ShowList, page int) (shows *tmdb.Page[tmdb.Show], err error) {
+	if page < 1 || page > tmdb.MaxPage {
+		return nil, fault.BadRequest("page must be between 1 and " + strconv.Itoa(tmdb.MaxPage))
+	}
+
 	switch list {
 	case tmdb.ShowListAiringToday:
-		shows, err = ms.tmdb.ListAiringTodayShows()
+		shows, err = ms.tmdb.ListAiringTodayShows(ctx, page)
 	case tmdb.ShowListOnTheAir:
-		shows, err = ms.tmdb.ListOnTheAirShows()
+		shows, err = ms.tmdb.ListOnTheAirShows(ctx, page)
 	case tmdb.ShowListPopular:
-		shows, err = ms.tmdb.ListPopularShows()
+		shows, err = ms.tmdb.ListPopularShows(ctx, page)
 	case tmdb.ShowListTopRated:
-		shows, err = ms.tmdb.ListTopRatedShows()
+		shows, err = ms.tmdb.ListTopRatedShows(ctx, page)
 	default:
-		shows, err = nil, fault.BadRequest("invalid show list")
+		return nil, fault.BadRequest("invalid show list")
 	}
 	if err != nil {
-		ms.logger.Error("failed to search show list", err)
-		return nil, fault.Internal("error getting show list")
+		return nil, tmdbError(ms.logger, err, "show list not found", "error getting show list")
 	}
 	return shows, nil
 }
 
-func (ms *mediaService) SearchMovies(_ context.Context, query string, page int) ([]tmdb.Movie, error) {
-	movies, err := ms.tmdb.SearchMovies(query, tmdb.SearchMovieFilter{Page: &page})
+func (ms *mediaService) SearchMovies(ctx context.Context, query string, page int) (*tmdb.Page[tmdb.Movie], error) {
+	if page < 1 || page > tmdb.MaxPage {
+		return nil, fault.BadRequest("page must be between 1 and " + strconv.Itoa(tmdb.MaxPage))
+	}
+
+	movies, err := ms.tmdb.SearchMovies(ctx, query, tmdb.SearchMovieFilter{Page: &page})
 	if err != nil {
-		ms.logger.Error("failed to search movies by query", err)
-		return nil, fault.Internal("error getting movies")
+		return nil, tmdbError(ms.logger, err, "movies not found", "error getting movies")
 	}
 	return movies, nil
 }
 
-func (ms *mediaService) SearchShows(_ context.Context, query string, page int) ([]tmdb.Show, error) {
-	shows, err := ms.tmdb.SearchShows(query, tmdb.SearchShowFilter{Page: &page})
+func (ms *mediaService) SearchShows(ctx context.Context, query string, page int) (*tmdb.Page[tmdb.Show], error) {
+	if page < 1 || page > tmdb.MaxPage {
+		return nil, fault.BadRequest("page must be between 1 and " + strconv.Itoa(tmdb.MaxPage))
+	}
+
+	shows, err := ms.tmdb.SearchShows(ctx, query, tmdb.SearchShowFilter{Page: &page})
 	if err != nil {
-		ms.logger.Error("failed to search shows by query", err)
-		return nil, fault.Internal("error getting shows")
+		return nil, tmdbError(ms.logger, err, "shows not found", "error getting shows")
 	}
 	return shows, nil
 }
 
-func (ms *mediaService) mediaFromRef(ref int, mediaType model.MediaType) (*model.Media, error) {
+// DiscoverOutput holds the page of discovered media, only the one matching the requested media type is set.
+type DiscoverOutput struct {
+	Movies *tmdb.Page[tmdb.Movie]
+	Shows  *tmdb.Page[tmdb.Show]
+}
+
+func (ms *mediaService) Discover(ctx context.Context, mediaType model.MediaType, filter tmdb.DiscoverFilter) (*DiscoverOutput, error) {
+	if filter.YearFrom != nil && filter.YearTo != nil && *filter.YearFrom > *filter.YearTo {
+		return nil, fault.BadRequest("year_from must not be after year_to")
+	}
+	if filter.MinRuntime != nil && filter.MaxRuntime != nil && *filter.MinRuntime > *filter.MaxRuntime {
+		return nil, fault.BadRequest("min_runtime must not be greater than max_runtime")
+	}
+	if len(filter.WatchProviders) > 0 && filter.WatchRegion == nil {
+		return nil, fault.BadRequest("region must be set when filtering by providers")
+	}
+
+	var (
+		output DiscoverOutput
+		err    error
+	)
 	switch mediaType {
 	case model.MediaTypeMovie:
-		movie, err := ms.tmdb.GetMovie(ref)
+		output.Movies, err = ms.tmdb.DiscoverMovies(ctx, filter)
+	case model.MediaTypeShow:
+		output.Shows, err = ms.tmdb.DiscoverShows(ctx, filter)
+	default:
+		return nil, fault.BadRequest("invalid media type")
+	}
+	if err != nil {
+		return nil, tmdbError(ms.logger, err, string(mediaType)+"s not found", "error discovering "+string(mediaType)+"s")
+	}
+
+	return &output, nil
+}
+
+func (ms *mediaService) GetTrending(ctx context.Context, trendingType tmdb.TrendingType, window tmdb.TrendingWindow, page int) (*tmdb.Page[tmdb.MultiResult], error) {
+	if page < 1 || page > tmdb.MaxPage {
+		return nil, fault.BadRequest("page must be between 1 and " + strconv.Itoa(tmdb.MaxPage))
+	}
+
+	trending, err := ms.tmdb.GetTrending(ctx, trendingType, window, page)
+	if err != nil {
+		return nil, tmdbError(ms.logger, err, "trending list not found", "error getting trending list")
+	}
+	return trending, nil
+}
+
+// Recommendation is a recommended or similar title, flagged with whether the viewer already has it in one of their lists.
+type Recommendation[T any] struct {
+	Media  T    `json:"media"`
+	InList bool `json:"in_list"`
+}
+
+// Recommendations are the recommended and similar titles, each paged on its own by TMDB.
+type Recommendations[T any] struct {
+	Recommended *tmdb.Page[Recommendation[T]] `json:"recommended"`
+	Similar     *tmdb.Page[Recommendation[T]] `json:"similar"`
+}
+
+func (ms *mediaService) GetMovieRecommendations(ctx context.Context, userID uuid.UUID, ref int, page int) (*Recommendations[tmdb.Movie], error) {
+	return recommendations(ctx, ms, userID, model.MediaTypeMovie, ref, page,
+		ms.tmdb.GetMovieRecommendations, ms.tmdb.GetSimilarMovies,
+		func(movie tmdb.Movie) int { return movie.ID },
+	)
+}
+
+func (ms *mediaService) GetShowRecommendations(ctx context.Context, userID uuid.UUID, ref int, page int) (*Recommendations[tmdb.Show], error) {
+	return recommendations(ctx, ms, userID, model.MediaTypeShow, ref, page,
+		ms.tmdb.GetShowRecommendations, ms.tmdb.GetSimilarShows,
+		func(show tmdb.Show) int { return show.ID },
+	)
+}
+
+// recommendations fetches the recommended and similar titles concurrently, then flags the ones the
+// user already has in a list with a single lookup covering both.
+func recommendations[T any](
+	ctx context.Context,
+	ms *mediaService,
+	userID uuid.UUID,
+	mediaType model.MediaType,
+	ref, page int,
+	fetchRecommended, fetchSimilar func(ctx context.Context, ref int, page int) (*tmdb.Page[T], error),
+	refOf func(T) int,
+) (*Recommendations[T], error) {
+	if page < 1 || page > tmdb.MaxPage {
+		return nil, fault.BadRequest("page must be between 1 and " + strconv.Itoa(tmdb.MaxPage))
+	}
+
+	var (
+		wg                         sync.WaitGroup
+		recommended, similar       *tmdb.Page[T]
+		recommendedErr, similarErr error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		recommended, recommendedErr = fetchRecommended(ctx, ref, page)
+	}()
+	go func() {
+		defer wg.Done()
+		similar, similarErr = fetchSimilar(ctx, ref, page)
+	}()
+	wg.Wait()
+
+	for _, err := range []error{recommendedErr, similarErr} {
+		if err == nil {
+			continue
+		}
+		return nil, tmdbError(ms.logger, err, string(mediaType)+" not found", "error getting "+string(mediaType)+" recommendations")
+	}
+
+	refs := make([]int, 0, len(recommended.Results)+len(similar.Results))
+	for _, results := range [][]T{recommended.Results, similar.Results} {
+		for _, result := range results {
+			refs = append(refs, refOf(result))
+		}
+	}
+
+	inList, err := ms.store.Lists().HasMedia(ctx, userID, mediaType, refs)
+	if err != nil {
+		ms.logger.Error("failed to check lists for recommended media", err)
+		return nil, fault.Internal("error getting " + string(mediaType) + " recommendations")
+	}
+
+	annotate := func(results *tmdb.Page[T]) *tmdb.Page[Recommendation[T]] {
+		annotated := make([]Recommendation[T], 0, len(results.Results))
+		for _, result := range results.Results {
+			annotated = append(annotated, Recommendation[T]{Media: result, InList: inList[refOf(result)]})
+		}
+		return &tmdb.Page[Recommendation[T]]{
+			Page:         results.Page,
+			Results:      annotated,
+			TotalPages:   results.TotalPages,
+			TotalResults: results.TotalResults,
+		}
+	}
+
+	return &Recommendations[T]{
+		Recommended: annotate(recommended),
+		Similar:     annotate(similar),
+	}, nil
+}
+
+func (ms *mediaService) GetPerson(ctx context.Context, ref int) (*tmdb.DetailedPerson, error) {
+	person, err := ms.tmdb.GetPerson(ctx, ref)
+	if err != nil {
+		return nil, tmdbError(ms.logger, err, "person not found", "error getting person")
+	}
+
+	return person, nil
+}
+
+// FilmographyCredit is everything a person did on a single movie or show, so that
+// movie and show credits can be listed together.
+type FilmographyCredit struct {
+	Ref          int             `json:"ref"`
+	MediaType    model.MediaType `json:"media_type"`
+	Title        string          `json:"title"`
+	PosterPath   *string         `json:"poster_path"`
+	ReleaseDate  string          `json:"release_date"`
+	VoteAverage  float64         `json:"vote_average"`
+	Characters   []string        `json:"characters"`
+	Jobs         []string        `json:"jobs"`
+	EpisodeCount int             `json:"episode_count"`
+}
+
+func (ms *mediaService) GetPersonFilmography(ctx context.Context, ref int) ([]FilmographyCredit, error) {
+	var (
+		wg                  sync.WaitGroup
+		movies              *tmdb.PersonMovieCredits
+		shows               *tmdb.PersonShowCredits
+		moviesErr, showsErr error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		movies, moviesErr = ms.tmdb.GetPersonMovieCredits(ctx, ref)
+	}()
+	go func() {
+		defer wg.Done()
+		shows, showsErr = ms.tmdb.GetPersonShowCredits(ctx, ref)
+	}()
+	wg.Wait()
+
+	for _, err := range []error{moviesErr, showsErr} {
+		if err == nil {
+			continue
+		}
+		return nil, tmdbError(ms.logger, err, "person not found", "error getting person filmography")
+	}
+
+	return filmography(movies, shows), nil
+}
+
+func (ms *mediaService) SearchPeople(ctx context.Context, query string, page int) (*tmdb.Page[tmdb.Person], error) {
+	if page < 1 || page > tmdb.MaxPage {
+		return nil, fault.BadRequest("page must be between 1 and " + strconv.Itoa(tmdb.MaxPage))
+	}
+
+	people, err := ms.tmdb.SearchPeople(ctx, query, tmdb.SearchPeopleFilter{Page: &page})
+	if err != nil {
+		return nil, tmdbError(ms.logger, err, "people not found", "error getting people")
+	}
+	return people, nil
+}
+
+// filmography merges a person's movie and show credits into one credit per title, newest first.
+// Titles without a release date yet are announced projects, so they go before everything else.
+func filmography(movies *tmdb.PersonMovieCredits, shows *tmdb.PersonShowCredits) []FilmographyCredit {
+	type key struct {
+		ref       int
+		mediaType model.MediaType
+	}
+	credits := make(map[key]*FilmographyCredit)
+	get := func(credit FilmographyCredit) *FilmographyCredit {
+		k := key{credit.Ref, credit.MediaType}
+		if existing, ok := credits[k]; ok {
+			return existing
+		}
+		credit.Characters, credit.Jobs = []string{}, []string{}
+		credits[k] = &credit
+		return &credit
+	}
+
+	for _, cast := range movies.Cast {
+		credit := get(FilmographyCredit{
+			Ref: cast.ID, MediaType: model.MediaTypeMovie, Title: cast.Title,
+			PosterPath: cast.PosterPath, ReleaseDate: cast.ReleaseDate, VoteAverage: cast.VoteAverage,
+		})
+		if cast.Character != "" {
+			credit.Characters = append(credit.Characters, cast.Character)
+		}
+	}
+	for _, crew := range movies.Crew {
+		credit := get(FilmographyCredit{
+			Ref: crew.ID, MediaType: model.MediaTypeMovie, Title: crew.Title,
+			PosterPath: crew.PosterPath, ReleaseDate: crew.ReleaseDate, VoteAverage: crew.VoteAverage,
+		})
+		credit.Jobs = append(credit.Jobs, crew.Job)
+	}
+	for _, cast := range shows.Cast {
+		credit := get(FilmographyCredit{
+			Ref: cast.ID, MediaType: model.MediaTypeShow, Title: cast.Name,
+			PosterPath: cast.PosterPath, ReleaseDate: cast.FirstAirDate, VoteAverage: cast.VoteAverage,
+		})
+		if cast.Character != "" {
+			credit.Characters = append(credit.Characters, cast.Character)
+		}
+		credit.EpisodeCount = max(credit.EpisodeCount, cast.EpisodeCount)
+	}
+	for _, crew := range shows.Crew {
+		credit := get(FilmographyCredit{
+			Ref: crew.ID, MediaType: model.MediaTypeShow, Title: crew.Name,
+			PosterPath: crew.PosterPath, ReleaseDate: crew.FirstAirDate, VoteAverage: crew.VoteAverage,
+		})
+		credit.Jobs = append(credit.Jobs, crew.Job)
+		credit.EpisodeCount = max(credit.EpisodeCount, crew.EpisodeCount)
+	}
+
+	result := make([]FilmographyCredit, 0, len(credits))
+	for _, credit := range credits {
+		result = append(result, *credit)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		switch {
+		case a.ReleaseDate != b.ReleaseDate && (a.ReleaseDate == "" || b.ReleaseDate == ""):
+			return a.ReleaseDate == ""
+		case a.ReleaseDate != b.ReleaseDate:
+			return a.ReleaseDate > b.ReleaseDate
+		case a.Title != b.Title:
+			return a.Title < b.Title
+		default:
+			return a.Ref < b.Ref
+		}
+	})
+
+	return result
+}
+
+func (ms *mediaService) mediaFromRef(ctx context.Context, ref int, mediaType model.MediaType) (*model.Media, error) {
+	switch mediaType {
+	case model.MediaTypeMovie:
+		movie, err := ms.tmdb.GetMovie(ctx, ref)
 		if err != nil {
-			if tmdb.IsNotFound(err) {
-				return nil, fault.NotFound("movie not found")
-			}
-			ms.logger.Error("failed to search movie by ref", err)
-			return nil, fault.Internal("error getting movie")
+			return nil, tmdbError(ms.logger, err, "movie not found", "error getting movie")
 		}
 		return &model.Media{
 			Ref:          movie.ID,
@@ -252,13 +823,9 @@ func (ms *mediaService) mediaFromRef(ref int, mediaType model.MediaType) (*model
 			Title:        movie.Title,
 		}, nil
 	case model.MediaTypeShow:
-		show, err := ms.tmdb.GetShow(ref)
+		show, err := ms.tmdb.GetShow(ctx, ref)
 		if err != nil {
-			if tmdb.IsNotFound(err) {
-				return nil, fault.NotFound("show not found")
-			}
-			ms.logger.Error("failed to search movie by ref", err)
-			return nil, fault.Internal("error getting movie")
+			return nil, tmdbError(ms.logger, err, "show not found", "error getting show")
 		}
 		return &model.Media{
 			Ref:          show.ID,
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"cine/datastore"
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/pkg/logger"
+	"cine/pkg/pubsub"
+	"context"
+	"github.com/google/uuid"
+	"slices"
+)
+
+type NotificationService interface {
+	Notify(ctx context.Context, notification *model.Notification)
+	GetNotifications(ctx context.Context, userID uuid.UUID, cursor string, limit int, unreadOnly bool) (*model.Page[*model.DetailedNotification], error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int, error)
+	MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) (int, error)
+	GetMuted(ctx context.Context, userID uuid.UUID) ([]model.NotificationKind, error)
+	SetMuted(ctx context.Context, userID uuid.UUID, kinds []model.NotificationKind) ([]model.NotificationKind, error)
+}
+
+type notificationService struct {
+	store  datastore.Store
+	logger logger.Logger
+	hub    pubsub.Hub
+}
+
+func NewNotificationService(store datastore.Store, logger logger.Logger, hub pubsub.Hub) NotificationService {
+	return &notificationService{store: store, logger: logger, hub: hub}
+}
+
+// Notify saves a notification and pushes it to the recipient's streams, unless it is about the recipient's
+// own action, they muted its kind, or the actor is someone they muted or is on either side of a block with them.
+// It is called after the action it describes has happened, so failing to notify is only logged.
+func (ns *notificationService) Notify(ctx context.Context, notification *model.Notification) {
+	if notification.UserID == notification.ActorID {
+		return
+	}
+
+	recipient, err := ns.store.Users().One(ctx, &model.UserF{ID: &notification.UserID})
+	if err != nil {
+		ns.logger.Error("failed getting notification recipient", err)
+		return
+	}
+	if slices.Contains(recipient.MutedNotifications, notification.Kind) {
+		return
+	}
+
+	hidden, err := ns.store.Users().Exists(ctx, &model.UserF{ID: &notification.ActorID, HiddenFrom: &recipient.ID})
+	if err != nil {
+		ns.logger.Error("failed checking notification actor", err)
+		return
+	} else if hidden {
+		return
+	}
+
+	notification, err = ns.store.Notifications().Insert(ctx, notification)
+	if err != nil {
+		ns.logger.Error("failed inserting "+string(notification.Kind)+" notification", err)
+		return
+	}
+
+	publish(ctx, ns.hub, ns.logger, notificationsTopic(notification.UserID), EventNotification, notification)
+}
+
+func (ns *notificationService) GetNotifications(
+	ctx context.Context,
+	userID uuid.UUID,
+	cursor string,
+	limit int,
+	unreadOnly bool,
+) (*model.Page[*model.DetailedNotification], error) {
+	after, err := model.ParseCursor(cursor)
+	if err != nil {
+		return nil, fault.BadRequest("cursor is invalid")
+	}
+
+	notificationF := &model.NotificationF{UserID: &userID}
+	if unreadOnly {
+		unread := false
+		notificationF.Read = &unread
+	}
+
+	notifications, err := ns.store.Notifications().AllDetailed(ctx, after, limit+1, notificationF)
+	if err != nil {
+		ns.logger.Error("failed getting notifications", err)
+		return nil, fault.Internal("error getting notifications")
+	}
+
+	page := paginate(notifications, limit, func(notification *model.DetailedNotification) model.Cursor {
+		return model.Cursor{CreatedAt: notification.Notification.CreatedAt, ID: notification.Notification.ID}
+	})
+
+	if err = ns.hideSpoilers(ctx, userID, page.Items); err != nil {
+		return nil, fault.Internal("error getting notifications")
+	}
+
+	return page, nil
+}
+
+// hideSpoilers redacts the reviews and comments of the notifications for a user who hides spoilers of media they haven't watched.
+func (ns *notificationService) hideSpoilers(ctx context.Context, userID uuid.UUID, notifications []*model.DetailedNotification) error {
+	var mediaIDs []uuid.UUID
+	for _, notification := range notifications {
+		if notification.Review != nil {
+			mediaIDs = append(mediaIDs, notification.Review.MediaID)
+		}
+		if notification.Comment != nil {
+			mediaIDs = append(mediaIDs, notification.Comment.MediaID)
+		}
+	}
+
+	guard, err := newSpoilerGuard(ctx, ns.store, userID, mediaIDs)
+	if err != nil {
+		ns.logger.Error("failed checking spoilers", err)
+		return err
+	}
+	for _, notification := range notifications {
+		guard.review(notification.Review)
+		guard.comment(notification.Comment)
+	}
+	return nil
+}
+
+func (ns *notificationService) CountUnread(ctx context.Context, userID uuid.UUID) (int, error) {
+	unread := false
+	count, err := ns.store.Notifications().Count(ctx, &model.NotificationF{UserID: &userID, Read: &unread})
+	if err != nil {
+		ns.logger.Error("failed counting unread notifications", err)
+		return 0, fault.Internal("error counting unread notifications")
+	}
+
+	return count, nil
+}
+
+// MarkRead marks the given notifications of the user as read, or all of them when ids is empty.
+// Ids that aren't the user's are skipped, the returned count says how many were marked.
+func (ns *notificationService) MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) (int, error) {
+	unread := false
+	notificationF := &model.NotificationF{UserID: &userID, Read: &unread}
+	if len(ids) > 0 {
+		notificationF.IDIn = &ids
+	}
+
+	read := true
+	marked, err := ns.store.Notifications().UpdateExec(ctx, &model.NotificationU{Read: &read}, notificationF)
+	if err != nil {
+		ns.logger.Error("failed marking notifications as read", err)
+		return 0, fault.Internal("error marking notifications as read")
+	}
+
+	return marked, nil
+}
+
+func (ns *notificationService) GetMuted(ctx context.Context, userID uuid.UUID) ([]model.NotificationKind, error) {
+	user, err := ns.store.Users().One(ctx, &model.UserF{ID: &userID})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return nil, fault.NotFound("user not found")
+		}
+		ns.logger.Error("failed getting user", err)
+		return nil, fault.Internal("error getting notification preferences")
+	}
+
+	return user.MutedNotifications, nil
+}
+
+// SetMuted replaces the kinds of notification the user has muted.
+func (ns *notificationService) SetMuted(ctx context.Context, userID uuid.UUID, kinds []model.NotificationKind) ([]model.NotificationKind, error) {
+	slices.Sort(kinds)
+	kinds = slices.Compact(kinds)
+
+	user, err := ns.store.Users().Update(ctx, userID, &model.UserU{MutedNotifications: &kinds})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return nil, fault.NotFound("user not found")
+		}
+		ns.logger.Error("failed updating muted notifications", err)
+		return nil, fault.Internal("error updating notification preferences")
+	}
+
+	return user.MutedNotifications, nil
+}
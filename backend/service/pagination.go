@@ -0,0 +1,18 @@
+package service
+
+import (
+	"cine/entity/model"
+	"fmt"
+)
+
+// paginate turns items fetched with one more than limit into a page, the extra item only
+// being there to tell whether a next page exists. cursorOf gives the cursor of an item.
+func paginate[T any, C fmt.Stringer](items []T, limit int, cursorOf func(T) C) *model.Page[T] {
+	page := &model.Page[T]{Items: items}
+	if len(items) > limit {
+		page.Items = items[:limit]
+		next := cursorOf(page.Items[limit-1]).String()
+		page.NextCursor = &next
+	}
+	return page
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"cine/datastore"
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/pkg/logger"
+	"cine/pkg/tmdb"
+	"context"
+	"github.com/google/uuid"
+	"math"
+	"sync"
+	"time"
+)
+
+// seasonConcurrency bounds the seasons fetched at once when a whole show is needed.
+const seasonConcurrency = 8
+
+type ProgressService interface {
+	GetShowProgress(ctx context.Context, userID uuid.UUID, ref int) (*ShowProgress, error)
+
+	MarkEpisode(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int, episodeNumber int) error
+	UnmarkEpisode(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int, episodeNumber int) error
+
+	MarkSeason(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int) error
+	UnmarkSeason(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int) error
+
+	MarkShow(ctx context.Context, userID uuid.UUID, ref int) error
+	UnmarkShow(ctx context.Context, userID uuid.UUID, ref int) error
+}
+
+type progressService struct {
+	store  datastore.Store
+	logger logger.Logger
+	tmdb   tmdb.API
+	media  MediaService
+}
+
+func NewProgressService(
+	store datastore.Store,
+	logger logger.Logger,
+	tmdbAPI tmdb.API,
+	mediaService MediaService,
+) ProgressService {
+	return &progressService{
+		store:  store,
+		logger: logger,
+		tmdb:   tmdbAPI,
+		media:  mediaService,
+	}
+}
+
+// ShowProgress is how far a user is through the aired episodes of a show. Specials aren't counted.
+type ShowProgress struct {
+	Ref             int              `json:"ref"`
+	WatchedCount    int              `json:"watched_count"`
+	AiredCount      int              `json:"aired_count"`
+	PercentComplete float64          `json:"percent_complete"`
+	NextEpisode     *tmdb.Episode    `json:"next_episode"`
+	Seasons         []SeasonProgress `json:"seasons"`
+}
+
+type SeasonProgress struct {
+	SeasonNumber    int     `json:"season_number"`
+	WatchedEpisodes []int   `json:"watched_episodes"`
+	WatchedCount    int     `json:"watched_count"`
+	AiredCount      int     `json:"aired_count"`
+	PercentComplete float64 `json:"percent_complete"`
+}
+
+func (ps *progressService) GetShowProgress(ctx context.Context, userID uuid.UUID, ref int) (*ShowProgress, error) {
+	seasons, err := ps.airedSeasons(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	watched, err := ps.watched(ctx, userID, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return showProgress(ref, seasons, watched), nil
+}
+
+func (ps *progressService) MarkEpisode(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int, episodeNumber int) error {
+	episodes, err := ps.airedEpisodes(ctx, ref, seasonNumber)
+	if err != nil {
+		return err
+	}
+
+	aired := false
+	for _, episode := range episodes {
+		if episode.EpisodeNumber == episodeNumber {
+			aired = true
+			break
+		}
+	}
+	if !aired {
+		return fault.NotFound("episode not found or not aired yet")
+	}
+
+	media, err := ps.media.GetMedia(ctx, ref, model.MediaTypeShow)
+	if err != nil {
+		return err
+	}
+
+	_, err = ps.store.EpisodeProgresses().Insert(ctx, &model.EpisodeProgress{
+		UserID:        userID,
+		MediaID:       media.ID,
+		SeasonNumber:  seasonNumber,
+		EpisodeNumber: episodeNumber,
+	})
+	if err != nil && !datastore.IsConstraint(err) {
+		ps.logger.Error("failed to mark episode as watched", err)
+		return fault.Internal("error marking episode as watched")
+	}
+
+	return nil
+}
+
+func (ps *progressService) UnmarkEpisode(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int, episodeNumber int) error {
+	return ps.unmark(ctx, userID, ref, &seasonNumber, &episodeNumber)
+}
+
+func (ps *progressService) MarkSeason(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int) error {
+	episodes, err := ps.airedEpisodes(ctx, ref, seasonNumber)
+	if err != nil {
+		return err
+	}
+
+	return ps.mark(ctx, userID, ref, [][]tmdb.Episode{episodes})
+}
+
+func (ps *progressService) UnmarkSeason(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int) error {
+	return ps.unmark(ctx, userID, ref, &seasonNumber, nil)
+}
+
+func (ps *progressService) MarkShow(ctx context.Context, userID uuid.UUID, ref int) error {
+	seasons, err := ps.airedSeasons(ctx, ref)
+	if err != nil {
+		return err
+	}
+
+	return ps.mark(ctx, userID, ref, seasons)
+}
+
+func (ps *progressService) UnmarkShow(ctx context.Context, userID uuid.UUID, ref int) error {
+	return ps.unmark(ctx, userID, ref, nil, nil)
+}
+
+// mark records every given episode the user hasn't already watched, in a single insert.
+func (ps *progressService) mark(ctx context.Context, userID uuid.UUID, ref int, seasons [][]tmdb.Episode) error {
+	media, err := ps.media.GetMedia(ctx, ref, model.MediaTypeShow)
+	if err != nil {
+		return err
+	}
+
+	progresses, err := ps.store.EpisodeProgresses().All(ctx, &model.EpisodeProgressF{UserID: &userID, MediaID: &media.ID})
+	if err != nil {
+		ps.logger.Error("failed to fetch episode progress", err)
+		return fault.Internal("error marking episodes as watched")
+	}
+	watched := watchedSet(progresses)
+
+	var missing []*model.EpisodeProgress
+	for _, episodes := range seasons {
+		for _, episode := range episodes {
+			if !watched[episodeKey{episode.SeasonNumber, episode.EpisodeNumber}] {
+				missing = append(missing, &model.EpisodeProgress{
+					UserID:        userID,
+					MediaID:       media.ID,
+					SeasonNumber:  episode.SeasonNumber,
+					EpisodeNumber: episode.EpisodeNumber,
+				})
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if _, err = ps.store.EpisodeProgresses().InsertBulk(ctx, missing); err != nil {
+		if datastore.IsConstraint(err) {
+			return fault.Conflict("episodes were marked as watched at the same time, try again")
+		}
+		ps.logger.Error("failed to mark episodes as watched", err)
+		return fault.Internal("error marking episodes as watched")
+	}
+
+	return nil
+}
+
+// unmark removes the user's progress on the show, narrowed down to a season and episode when given.
+// Unmarking something that isn't watched is not an error.
+func (ps *progressService) unmark(ctx context.Context, userID uuid.UUID, ref int, seasonNumber *int, episodeNumber *int) error {
+	mediaType := model.MediaTypeShow
+	media, err := ps.store.Medias().One(ctx, &model.MediaF{Ref: &ref, MediaType: &mediaType})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return nil
+		}
+		ps.logger.Error("failed to fetch media", err)
+		return fault.Internal("error unmarking episodes")
+	}
+
+	_, err = ps.store.EpisodeProgresses().DeleteExec(ctx, &model.EpisodeProgressF{
+		UserID:        &userID,
+		MediaID:       &media.ID,
+		SeasonNumber:  seasonNumber,
+		EpisodeNumber: episodeNumber,
+	})
+	if err != nil {
+		ps.logger.Error("failed to unmark episodes", err)
+		return fault.Internal("error unmarking episodes")
+	}
+
+	return nil
+}
+
+// watched returns the episodes the user has watched of the show, if any.
+func (ps *progressService) watched(ctx context.Context, userID uuid.UUID, ref int) (map[episodeKey]bool, error) {
+	mediaType := model.MediaTypeShow
+	media, err := ps.store.Medias().One(ctx, &model.MediaF{Ref: &ref, MediaType: &mediaType})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			// no one has done anything with the show yet, so there's nothing watched
+			return map[episodeKey]bool{}, nil
+		}
+		ps.logger.Error("failed to fetch media", err)
+		return nil, fault.Internal("error getting show progress")
+	}
+
+	progresses, err := ps.store.EpisodeProgresses().All(ctx, &model.EpisodeProgressF{UserID: &userID, MediaID: &media.ID})
+	if err != nil {
+		ps.logger.Error("failed to fetch episode progress", err)
+		return nil, fault.Internal("error getting show progress")
+	}
+
+	return watchedSet(progresses), nil
+}
+
+// airedSeasons returns the aired episodes of every season of the show except specials, in airing order.
+func (ps *progressService) airedSeasons(ctx context.Context, ref int) ([][]tmdb.Episode, error) {
+	show, err := ps.tmdb.GetShow(ctx, ref)
+	if err != nil {
+		return nil, tmdbError(ps.logger, err, "show not found", "error getting show")
+	}
+
+	var numbers []int
+	for _, season := range show.Seasons {
+		if season.SeasonNumber > 0 {
+			numbers = append(numbers, season.SeasonNumber)
+		}
+	}
+
+	var (
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, seasonConcurrency)
+		seasons   = make([][]tmdb.Episode, len(numbers))
+		errs      = make([]error, len(numbers))
+	)
+	for i, number := range numbers {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, number int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			seasons[i], errs[i] = ps.airedEpisodes(ctx, ref, number)
+		}(i, number)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return seasons, nil
+}
+
+// airedEpisodes returns the episodes of a season that have aired by today.
+func (ps *progressService) airedEpisodes(ctx context.Context, ref int, seasonNumber int) ([]tmdb.Episode, error) {
+	season, err := ps.tmdb.GetShowSeasonDetails(ctx, ref, seasonNumber)
+	if err != nil {
+		return nil, tmdbError(ps.logger, err, "season not found", "error getting season")
+	}
+
+	today := time.Now().Format(time.DateOnly)
+
+	aired := make([]tmdb.Episode, 0, len(season.Episodes))
+	for _, episode := range season.Episodes {
+		if episode.AirDate != "" && episode.AirDate <= today {
+			aired = append(aired, episode)
+		}
+	}
+	return aired, nil
+}
+
+type episodeKey struct {
+	season  int
+	episode int
+}
+
+func watchedSet(progresses []*model.EpisodeProgress) map[episodeKey]bool {
+	watched := make(map[episodeKey]bool, len(progresses))
+	for _, progress := range progresses {
+		watched[episodeKey{progress.SeasonNumber, progress.EpisodeNumber}] = true
+	}
+	return watched
+}
+
+// showProgress counts the watched episodes of each season. The next episode is the one aired after the
+// last one watched, so skipping ahead doesn't leave the user stuck on an episode they passed over.
+func showProgress(ref int, seasons [][]tmdb.Episode, watched map[episodeKey]bool) *ShowProgress {
+	progress := &ShowProgress{Ref: ref, Seasons: make([]SeasonProgress, 0, len(seasons))}
+
+	var next *tmdb.Episode
+	for _, episodes := range seasons {
+		if len(episodes) == 0 {
+			continue
+		}
+
+		season := SeasonProgress{SeasonNumber: episodes[0].SeasonNumber, WatchedEpisodes: []int{}, AiredCount: len(episodes)}
+		for _, episode := range episodes {
+			if watched[episodeKey{episode.SeasonNumber, episode.EpisodeNumber}] {
+				season.WatchedEpisodes = append(season.WatchedEpisodes, episode.EpisodeNumber)
+				season.WatchedCount++
+				next = nil
+			} else if next == nil {
+				next = &episode
+			}
+		}
+		season.PercentComplete = percent(season.WatchedCount, season.AiredCount)
+
+		progress.WatchedCount += season.WatchedCount
+		progress.AiredCount += season.AiredCount
+		progress.Seasons = append(progress.Seasons, season)
+	}
+
+	progress.NextEpisode = next
+	progress.PercentComplete = percent(progress.WatchedCount, progress.AiredCount)
+
+	return progress
+}
+
+// percent is rounded to one decimal place.
+func percent(part int, whole int) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(whole)*1000) / 10
+}
@@ -7,23 +7,30 @@ import (
 	"cine/pkg/logger"
 	"context"
 	"github.com/google/uuid"
+	"time"
 )
 
 type ReviewService interface {
 	CreateReview(ctx context.Context, input *CreateReviewInput) (*model.Review, error)
 	UpdateReview(ctx context.Context, userID, reviewID uuid.UUID, reviewU *model.ReviewU) (*model.Review, error)
 	DeleteReview(ctx context.Context, userID, reviewID uuid.UUID) error
-	GetAllReviews(ctx context.Context, ref int, mediaType model.MediaType) ([]*model.DetailedReview, error)
+	GetAllReviews(ctx context.Context, input *GetReviewsInput) (*MediaReviews, error)
+	GetUserReviews(ctx context.Context, viewerID, userID uuid.UUID, sort model.ReviewSort, cursor string, limit int) (*model.Page[*model.DetailedReview], error)
+	LikeReview(ctx context.Context, like *model.ReviewLike) (*model.ReviewLike, error)
+	UnlikeReview(ctx context.Context, userID, reviewID uuid.UUID) error
+	GetReviewRevisions(ctx context.Context, viewerID, reviewID uuid.UUID) ([]*model.Revision, error)
+	PurgeTombstones(ctx context.Context) (int, error)
 }
 
 type reviewService struct {
-	store  datastore.Store
-	logger logger.Logger
-	media  MediaService
+	store         datastore.Store
+	logger        logger.Logger
+	media         MediaService
+	notifications NotificationService
 }
 
-func NewReviewService(store datastore.Store, logger logger.Logger, media MediaService) ReviewService {
-	return &reviewService{store: store, logger: logger, media: media}
+func NewReviewService(store datastore.Store, logger logger.Logger, media MediaService, notifications NotificationService) ReviewService {
+	return &reviewService{store: store, logger: logger, media: media, notifications: notifications}
 }
 
 type CreateReviewInput struct {
@@ -52,7 +59,8 @@ func (rs *reviewService) CreateReview(ctx context.Context, input *CreateReviewIn
 	}
 	input.Review.MediaID = media.ID
 
-	exists, err = rs.store.Reviews().Exists(ctx, &model.ReviewF{UserID: &input.UserID, MediaID: &media.ID})
+	deleted := false
+	exists, err = rs.store.Reviews().Exists(ctx, &model.ReviewF{UserID: &input.UserID, MediaID: &media.ID, Deleted: &deleted})
 	if err != nil {
 		rs.logger.Error("exists check on review failed", err)
 		return nil, fault.Internal("error creating review")
@@ -66,6 +74,13 @@ func (rs *reviewService) CreateReview(ctx context.Context, input *CreateReviewIn
 		return nil, fault.Internal("failed to create review")
 	}
 
+	recordActivity(ctx, rs.store, rs.logger, &model.Activity{
+		UserID:   review.UserID,
+		Kind:     model.ActivityReviewCreated,
+		MediaID:  &review.MediaID,
+		ReviewID: &review.ID,
+	})
+
 	return review, nil
 }
 
@@ -85,14 +100,35 @@ func (rs *reviewService) UpdateReview(ctx context.Context, userID, reviewID uuid
 
 	if review.UserID != userID {
 		return nil, fault.Forbidden("you are not allowed to update this review")
+	} else if review.DeletedAt != nil {
+		return nil, fault.NotFound("review not found")
 	}
 
-	review, err = rs.store.Reviews().Update(ctx, review.ID, reviewU)
+	tx, err := rs.store.Transaction(ctx)
+	if err != nil {
+		rs.logger.Error("error starting transaction", err)
+		return nil, fault.Internal("error updating review")
+	}
+	defer tx.Rollback()
+
+	// the version being replaced is kept, so the review's thread still makes sense
+	revision := &model.Revision{ReviewID: &review.ID, Content: review.Content, Rating: &review.Rating, Spoiler: review.Spoiler}
+	if _, err = tx.Revisions().Insert(ctx, revision); err != nil {
+		rs.logger.Error("failed inserting review revision", err)
+		return nil, fault.Internal("error updating review")
+	}
+
+	review, err = tx.Reviews().Update(ctx, review.ID, reviewU)
 	if err != nil {
 		rs.logger.Error("failed updating review", err)
 		return nil, fault.Internal("error updating review")
 	}
 
+	if err = tx.Commit(); err != nil {
+		rs.logger.Error("error committing transaction", err)
+		return nil, fault.Internal("error updating review")
+	}
+
 	return review, nil
 }
 
@@ -108,6 +144,16 @@ func (rs *reviewService) DeleteReview(ctx context.Context, userID, reviewID uuid
 
 	if review.UserID != userID {
 		return fault.Forbidden("you are not allowed to delete this review")
+	} else if review.DeletedAt != nil {
+		return fault.NotFound("review not found")
+	}
+
+	thread, err := rs.store.Comments().Exists(ctx, &model.CommentF{ReviewID: &review.ID})
+	if err != nil {
+		rs.logger.Error("failed checking review thread", err)
+		return fault.Internal("error deleting review")
+	} else if thread {
+		return rs.tombstone(ctx, review)
 	}
 
 	if err = rs.store.Reviews().Delete(ctx, review.ID); err != nil {
@@ -118,25 +164,255 @@ func (rs *reviewService) DeleteReview(ctx context.Context, userID, reviewID uuid
 	return nil
 }
 
-func (rs *reviewService) GetAllReviews(ctx context.Context, ref int, mediaType model.MediaType) ([]*model.DetailedReview, error) {
-	media, err := rs.media.GetMedia(ctx, ref, mediaType)
+// tombstone deletes what the author wrote of a review that has a thread, only a tombstone is left
+// so the comments other users wrote in it stay.
+func (rs *reviewService) tombstone(ctx context.Context, review *model.Review) error {
+	tx, err := rs.store.Transaction(ctx)
 	if err != nil {
-		if datastore.IsNotFound(err) {
+		rs.logger.Error("error starting transaction", err)
+		return fault.Internal("error deleting review")
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.Revisions().DeleteExec(ctx, &model.RevisionF{ReviewID: &review.ID}); err != nil {
+		rs.logger.Error("failed deleting review revisions", err)
+		return fault.Internal("error deleting review")
+	}
+	if _, err = tx.ReviewLikes().DeleteExec(ctx, &model.ReviewLikeF{ReviewID: &review.ID}); err != nil {
+		rs.logger.Error("failed deleting review likes", err)
+		return fault.Internal("error deleting review")
+	}
+	if _, err = tx.Activities().DeleteExec(ctx, &model.ActivityF{ReviewID: &review.ID}); err != nil {
+		rs.logger.Error("failed deleting review activities", err)
+		return fault.Internal("error deleting review")
+	}
+	if _, err = tx.Notifications().DeleteExec(ctx, &model.NotificationF{ReviewID: &review.ID}); err != nil {
+		rs.logger.Error("failed deleting review notifications", err)
+		return fault.Internal("error deleting review")
+	}
+	if _, err = tx.DiaryEntries().UpdateExec(ctx, &model.DiaryEntryU{ClearReview: true}, &model.DiaryEntryF{ReviewID: &review.ID}); err != nil {
+		rs.logger.Error("failed unlinking review from diary", err)
+		return fault.Internal("error deleting review")
+	}
+
+	content, rating, spoiler, now := model.ReviewTombstone, 0, false, time.Now()
+	reviewU := &model.ReviewU{Content: &content, Rating: &rating, Spoiler: &spoiler, DeletedAt: &now}
+	if _, err = tx.Reviews().Update(ctx, review.ID, reviewU); err != nil {
+		rs.logger.Error("failed deleting review", err)
+		return fault.Internal("error deleting review")
+	}
+
+	if err = tx.Commit(); err != nil {
+		rs.logger.Error("error committing transaction", err)
+		return fault.Internal("error deleting review")
+	}
+
+	return nil
+}
+
+// PurgeTombstones hard deletes the tombstones of reviews that have no comments left in their thread.
+func (rs *reviewService) PurgeTombstones(ctx context.Context) (int, error) {
+	affected, err := rs.store.Reviews().DeleteTombstones(ctx)
+	if err != nil {
+		rs.logger.Error("failed purging review tombstones", err)
+		return 0, fault.Internal("failed to purge tombstones")
+	}
+	return affected, nil
+}
+
+type GetReviewsInput struct {
+	ViewerID  uuid.UUID
+	Ref       int
+	MediaType model.MediaType
+	Sort      model.ReviewSort
+	Cursor    string
+	Limit     int
+}
+
+// MediaReviews is a page of a media's reviews, along with the summary of every review of it on Cine.
+type MediaReviews struct {
+	Reviews *model.Page[*model.DetailedReview] `json:"reviews"`
+	Summary *model.ReviewSummary               `json:"summary"`
+}
+
+// GetAllReviews pages through the reviews of a media, leaving out those the viewer can't see.
+// The summary counts every review, whether the viewer can see it or not.
+func (rs *reviewService) GetAllReviews(ctx context.Context, input *GetReviewsInput) (*MediaReviews, error) {
+	after, err := model.ParseRankedCursor(input.Cursor)
+	if err != nil {
+		return nil, fault.BadRequest("cursor is invalid")
+	}
+
+	media, err := rs.media.GetMedia(ctx, input.Ref, input.MediaType)
+	if e, ok := fault.As(err); ok {
+		if e.Code == fault.CodeNotFound {
 			return nil, fault.NotFound("media not found")
 		}
 		rs.logger.Error("failed getting media", err)
-		return nil, fault.Internal("error getting media")
+		return nil, fault.Internal("error getting reviews")
+	}
+
+	// tombstones are only kept for their threads, they are not listed or counted
+	deleted := false
+	reviews, err := rs.store.Reviews().AllDetailed(ctx, input.ViewerID, input.Sort, after, input.Limit+1, &model.ReviewF{MediaID: &media.ID, Deleted: &deleted, VisibleTo: &input.ViewerID})
+	if err != nil {
+		rs.logger.Error("failed getting reviews", err)
+		return nil, fault.Internal("error getting reviews")
+	}
+
+	summary, err := rs.store.Reviews().Summary(ctx, &model.ReviewF{MediaID: &media.ID, Deleted: &deleted})
+	if err != nil {
+		rs.logger.Error("failed summarizing reviews", err)
+		return nil, fault.Internal("error getting reviews")
+	}
+
+	page := paginate(reviews, input.Limit, reviewCursor(input.Sort))
+	if err = rs.hideSpoilers(ctx, input.ViewerID, page.Items); err != nil {
+		return nil, fault.Internal("error getting reviews")
+	}
+
+	return &MediaReviews{Reviews: page, Summary: summary}, nil
+}
+
+// GetUserReviews pages through the reviews by a user, the viewer has to be able to see the account.
+func (rs *reviewService) GetUserReviews(
+	ctx context.Context,
+	viewerID, userID uuid.UUID,
+	sort model.ReviewSort,
+	cursor string,
+	limit int,
+) (*model.Page[*model.DetailedReview], error) {
+	after, err := model.ParseRankedCursor(cursor)
+	if err != nil {
+		return nil, fault.BadRequest("cursor is invalid")
 	}
 
-	reviews, err := rs.store.Reviews().AllWithUser(ctx, &model.ReviewF{MediaID: &media.ID})
+	exists, err := rs.store.Users().Exists(ctx, &model.UserF{ID: &userID})
+	if err != nil {
+		rs.logger.Error("user retrieval failed", err)
+		return nil, fault.Internal("error getting reviews")
+	} else if !exists {
+		return nil, fault.NotFound("user not found")
+	}
+
+	visible, err := rs.store.Users().CanView(ctx, viewerID, userID)
+	if err != nil {
+		rs.logger.Error("user visibility check failed", err)
+		return nil, fault.Internal("error getting reviews")
+	} else if !visible {
+		return nil, fault.Forbidden("this account is private")
+	}
+
+	deleted := false
+	reviews, err := rs.store.Reviews().AllDetailed(ctx, viewerID, sort, after, limit+1, &model.ReviewF{UserID: &userID, Deleted: &deleted, VisibleTo: &viewerID})
 	if err != nil {
 		rs.logger.Error("failed getting reviews", err)
 		return nil, fault.Internal("error getting reviews")
 	}
 
-	return reviews, nil
+	page := paginate(reviews, limit, reviewCursor(sort))
+	if err = rs.hideSpoilers(ctx, viewerID, page.Items); err != nil {
+		return nil, fault.Internal("error getting reviews")
+	}
+
+	return page, nil
+}
+
+// hideSpoilers redacts the reviews for a viewer who hides spoilers of media they haven't watched.
+func (rs *reviewService) hideSpoilers(ctx context.Context, viewerID uuid.UUID, reviews []*model.DetailedReview) error {
+	guard, err := newSpoilerGuard(ctx, rs.store, viewerID, reviewMediaIDs(reviews))
+	if err != nil {
+		rs.logger.Error("failed checking spoilers", err)
+		return err
+	}
+	guard.reviews(reviews)
+	return nil
+}
+
+// GetReviewRevisions gets the versions of a review the viewer can see, that its edits replaced, most recent first.
+func (rs *reviewService) GetReviewRevisions(ctx context.Context, viewerID, reviewID uuid.UUID) ([]*model.Revision, error) {
+	review, err := rs.store.Reviews().One(ctx, &model.ReviewF{ID: &reviewID, VisibleTo: &viewerID})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return nil, fault.NotFound("review not found")
+		}
+		rs.logger.Error("failed getting review", err)
+		return nil, fault.Internal("error getting review revisions")
+	}
+
+	revisions, err := rs.store.Revisions().All(ctx, &model.RevisionF{ReviewID: &review.ID})
+	if err != nil {
+		rs.logger.Error("failed getting review revisions", err)
+		return nil, fault.Internal("error getting review revisions")
+	}
+
+	guard, err := newSpoilerGuard(ctx, rs.store, viewerID, []uuid.UUID{review.MediaID})
+	if err != nil {
+		rs.logger.Error("failed checking spoilers", err)
+		return nil, fault.Internal("error getting review revisions")
+	}
+	guard.revisions(review.UserID, review.MediaID, review.Spoiler, revisions)
+
+	return revisions, nil
+}
+
+// reviewCursor gives the cursor of a review in the ordering of sort.
+func reviewCursor(sort model.ReviewSort) func(*model.DetailedReview) model.RankedCursor {
+	return func(review *model.DetailedReview) model.RankedCursor {
+		cursor := model.RankedCursor{Cursor: model.Cursor{CreatedAt: review.Review.CreatedAt, ID: review.Review.ID}}
+		switch sort {
+		case model.ReviewSortHighest, model.ReviewSortLowest:
+			cursor.Rank = review.Review.Rating
+		case model.ReviewSortLiked:
+			cursor.Rank = review.LikesCount
+		}
+		return cursor
+	}
+}
+
+// LikeReview likes a review the user can see, a user can only like a review once.
+func (rs *reviewService) LikeReview(ctx context.Context, like *model.ReviewLike) (*model.ReviewLike, error) {
+	deleted := false
+	review, err := rs.store.Reviews().One(ctx, &model.ReviewF{ID: &like.ReviewID, Deleted: &deleted, VisibleTo: &like.UserID})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return nil, fault.NotFound("review not found")
+		}
+		rs.logger.Error("failed getting review", err)
+		return nil, fault.Internal("failed to like review")
+	}
+
+	like, err = rs.store.ReviewLikes().Insert(ctx, like)
+	if err != nil {
+		if datastore.IsConstraint(err) {
+			return nil, fault.Conflict("you can only like a review once")
+		}
+		rs.logger.Error("failed liking review", err)
+		return nil, fault.Internal("failed to like review")
+	}
+
+	rs.notifications.Notify(ctx, &model.Notification{
+		UserID:   review.UserID,
+		ActorID:  like.UserID,
+		Kind:     model.NotificationReviewLiked,
+		ReviewID: &review.ID,
+	})
+
+	return like, nil
+}
+
+func (rs *reviewService) UnlikeReview(ctx context.Context, userID, reviewID uuid.UUID) error {
+	affected, err := rs.store.ReviewLikes().DeleteExec(ctx, &model.ReviewLikeF{UserID: &userID, ReviewID: &reviewID})
+	if err != nil {
+		rs.logger.Error("failed unliking review", err)
+		return fault.Internal("failed to unlike review")
+	} else if affected == 0 {
+		return fault.NotFound("like not found")
+	}
+
+	return nil
 }
 
 func (rs *reviewService) hasFieldToUpdate(reviewU *model.ReviewU) bool {
-	return reviewU.Rating != nil || reviewU.Content != nil
+	return reviewU.Rating != nil || reviewU.Content != nil || reviewU.Spoiler != nil
 }
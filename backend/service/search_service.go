@@ -0,0 +1,151 @@
+package service
+
+import (
+	"cine/datastore"
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/pkg/logger"
+	"cine/pkg/tmdb"
+	"context"
+	"github.com/google/uuid"
+	"sync"
+	"time"
+)
+
+const (
+	searchMediaLimit = 10
+	searchUserLimit  = 10
+	searchListLimit  = 10
+
+	// searchTimeout bounds each backend on its own, so a slow one only costs its group of results.
+	searchTimeout = 3 * time.Second
+)
+
+type SearchService interface {
+	Search(ctx context.Context, viewerID uuid.UUID, query string) (*SearchOutput, error)
+}
+
+type searchService struct {
+	store  datastore.Store
+	logger logger.Logger
+	tmdb   tmdb.API
+}
+
+func NewSearchService(store datastore.Store, logger logger.Logger, tmdbAPI tmdb.API) SearchService {
+	return &searchService{
+		store:  store,
+		logger: logger,
+		tmdb:   tmdbAPI,
+	}
+}
+
+// SearchOutput is the result of a search grouped by kind. A group whose backend failed is left empty,
+// and a warning saying so is added to Warnings.
+type SearchOutput struct {
+	Movies   []tmdb.Movie  `json:"movies"`
+	Shows    []tmdb.Show   `json:"shows"`
+	People   []tmdb.Person `json:"people"`
+	Users    []*model.User `json:"users"`
+	Lists    []*model.List `json:"lists"`
+	Warnings []string      `json:"warnings"`
+}
+
+// Search fans the query out to TMDB, users and public lists concurrently. It only fails if every backend does.
+// Users and lists the viewer isn't allowed to see are left out.
+func (ss *searchService) Search(ctx context.Context, viewerID uuid.UUID, query string) (*SearchOutput, error) {
+	output := &SearchOutput{
+		Movies:   []tmdb.Movie{},
+		Shows:    []tmdb.Show{},
+		People:   []tmdb.Person{},
+		Users:    []*model.User{},
+		Lists:    []*model.List{},
+		Warnings: []string{},
+	}
+
+	var (
+		wg                          sync.WaitGroup
+		mediaErr, usersErr, listErr error
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		mediaErr = ss.searchMedia(ctx, query, output)
+	}()
+	go func() {
+		defer wg.Done()
+		usersErr = ss.searchUsers(ctx, viewerID, query, output)
+	}()
+	go func() {
+		defer wg.Done()
+		listErr = ss.searchLists(ctx, viewerID, query, output)
+	}()
+	wg.Wait()
+
+	if mediaErr != nil && usersErr != nil && listErr != nil {
+		return nil, fault.Internal("error searching")
+	}
+
+	for _, err := range []error{mediaErr, usersErr, listErr} {
+		if e, ok := fault.As(err); ok {
+			output.Warnings = append(output.Warnings, e.Message)
+		}
+	}
+
+	return output, nil
+}
+
+// searchMedia fills in the movies, shows and people of output. Each search* method only
+// writes to its own fields, so they can run at the same time.
+func (ss *searchService) searchMedia(ctx context.Context, query string, output *SearchOutput) error {
+	ctx, cancel := context.WithTimeout(ctx, searchTimeout)
+	defer cancel()
+
+	results, err := ss.tmdb.SearchMulti(ctx, query)
+	if err != nil {
+		if tmdb.IsUnavailable(err) {
+			return fault.Unavailable("movies, shows and people are unavailable right now")
+		}
+		ss.logger.Error("failed to multi search by query", err)
+		return fault.Internal("movies, shows and people could not be searched")
+	}
+
+	for _, result := range results.Results {
+		switch {
+		case result.Movie != nil && len(output.Movies) < searchMediaLimit:
+			output.Movies = append(output.Movies, *result.Movie)
+		case result.Show != nil && len(output.Shows) < searchMediaLimit:
+			output.Shows = append(output.Shows, *result.Show)
+		case result.Person != nil && len(output.People) < searchMediaLimit:
+			output.People = append(output.People, *result.Person)
+		}
+	}
+	return nil
+}
+
+func (ss *searchService) searchUsers(ctx context.Context, viewerID uuid.UUID, query string, output *SearchOutput) error {
+	ctx, cancel := context.WithTimeout(ctx, searchTimeout)
+	defer cancel()
+
+	users, err := ss.store.Users().Search(ctx, viewerID, query, searchUserLimit)
+	if err != nil {
+		ss.logger.Error("failed to search users by query", err)
+		return fault.Internal("users could not be searched")
+	}
+
+	output.Users = users
+	return nil
+}
+
+func (ss *searchService) searchLists(ctx context.Context, viewerID uuid.UUID, query string, output *SearchOutput) error {
+	ctx, cancel := context.WithTimeout(ctx, searchTimeout)
+	defer cancel()
+
+	lists, err := ss.store.Lists().SearchPublic(ctx, viewerID, query, searchListLimit)
+	if err != nil {
+		ss.logger.Error("failed to search public lists by query", err)
+		return fault.Internal("lists could not be searched")
+	}
+
+	output.Lists = lists
+	return nil
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"cine/datastore"
+	"cine/entity/model"
+	"cine/pkg/spoiler"
+	"context"
+	"github.com/google/uuid"
+)
+
+// spoilerGuard redacts what a viewer reads about media they haven't watched, when they hide spoilers.
+// Their own comments and reviews are never redacted.
+type spoilerGuard struct {
+	viewerID uuid.UUID
+	hide     bool
+	watched  map[uuid.UUID]bool
+}
+
+// newSpoilerGuard looks up whether the viewer hides spoilers and, if so, which of the medias they watched.
+func newSpoilerGuard(ctx context.Context, store datastore.Store, viewerID uuid.UUID, mediaIDs []uuid.UUID) (*spoilerGuard, error) {
+	viewer, err := store.Users().One(ctx, &model.UserF{ID: &viewerID})
+	if err != nil {
+		return nil, err
+	}
+
+	guard := &spoilerGuard{viewerID: viewerID, hide: viewer.HideSpoilers, watched: make(map[uuid.UUID]bool)}
+	if !guard.hide || len(mediaIDs) == 0 {
+		return guard, nil
+	}
+
+	status := model.WatchStatusWatched
+	items, err := store.WatchItems().All(ctx, &model.WatchItemF{UserID: &viewerID, Status: &status, MediaIDIn: &mediaIDs})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		guard.watched[item.MediaID] = true
+	}
+
+	return guard, nil
+}
+
+func (g *spoilerGuard) redacts(authorID, mediaID uuid.UUID) bool {
+	return g.hide && authorID != g.viewerID && !g.watched[mediaID]
+}
+
+func (g *spoilerGuard) comments(comments []*model.DetailedComment) {
+	for _, comment := range comments {
+		if g.redacts(comment.Comment.UserID, comment.Comment.MediaID) {
+			comment.Comment.Content, comment.Redacted = redact(comment.Comment.Content, comment.Comment.Spoiler)
+		}
+	}
+}
+
+func (g *spoilerGuard) reviews(reviews []*model.DetailedReview) {
+	for _, review := range reviews {
+		if g.redacts(review.Review.UserID, review.Review.MediaID) {
+			review.Review.Content, review.Redacted = redact(review.Review.Content, review.Review.Spoiler)
+		}
+	}
+}
+
+// comment and review redact a comment or review shown on its own, as in the feed or notifications.
+func (g *spoilerGuard) comment(comment *model.Comment) {
+	if comment != nil && g.redacts(comment.UserID, comment.MediaID) {
+		comment.Content, _ = redact(comment.Content, comment.Spoiler)
+	}
+}
+
+func (g *spoilerGuard) review(review *model.Review) {
+	if review != nil && g.redacts(review.UserID, review.MediaID) {
+		review.Content, _ = redact(review.Content, review.Spoiler)
+	}
+}
+
+// revisions redacts the revisions of a comment or review by the author, about the media. A revision is taken out
+// entirely when either it or the current version is flagged as a spoiler.
+func (g *spoilerGuard) revisions(authorID, mediaID uuid.UUID, flagged bool, revisions []*model.Revision) {
+	if !g.redacts(authorID, mediaID) {
+		return
+	}
+	for _, revision := range revisions {
+		revision.Content, _ = redact(revision.Content, flagged || revision.Spoiler)
+	}
+}
+
+// redact takes all of content out when it is flagged as a spoiler, and only its inline spoilers otherwise.
+func redact(content string, flagged bool) (string, bool) {
+	if flagged {
+		return "", true
+	}
+	if spoiler.Has(content) {
+		return spoiler.Redact(content), true
+	}
+	return content, false
+}
+
+func commentMediaIDs(comments []*model.DetailedComment) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	var ids []uuid.UUID
+	for _, comment := range comments {
+		if !seen[comment.Comment.MediaID] {
+			seen[comment.Comment.MediaID] = true
+			ids = append(ids, comment.Comment.MediaID)
+		}
+	}
+	return ids
+}
+
+func reviewMediaIDs(reviews []*model.DetailedReview) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	var ids []uuid.UUID
+	for _, review := range reviews {
+		if !seen[review.Review.MediaID] {
+			seen[review.Review.MediaID] = true
+			ids = append(ids, review.Review.MediaID)
+		}
+	}
+	return ids
+}
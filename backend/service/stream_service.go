@@ -0,0 +1,248 @@
+package service
+
+import (
+	"cine/datastore"
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/pkg/logger"
+	"cine/pkg/pubsub"
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	EventNotification   = "notification"
+	EventCommentCreated = "comment_created"
+	EventCommentUpdated = "comment_updated"
+	EventCommentDeleted = "comment_deleted"
+	EventCommentPurged  = "comment_purged"
+)
+
+const (
+	// streamFilterTimeout bounds the lookups deciding whether a subscriber gets a comment event.
+	streamFilterTimeout = 5 * time.Second
+	// streamViewerTTL is how long the blocks, mutes, follows and spoiler settings of a subscriber are used
+	// for before being looked up again.
+	streamViewerTTL = 30 * time.Second
+)
+
+// CommentEvent is what is published on the comments topic of a media. Who can read the thread the comment
+// is in is resolved once when publishing, so subscribers don't each have to look it up.
+type CommentEvent struct {
+	Comment *model.Comment `json:"comment"`
+	// ReviewAuthorID is set for comments in a review's thread, along with whether the author's account is private.
+	ReviewAuthorID      *uuid.UUID `json:"review_author_id,omitempty"`
+	ReviewAuthorPrivate bool       `json:"review_author_private,omitempty"`
+}
+
+// streamViewer is what decides which comment events a subscriber gets. It is loaded once and refreshed every
+// streamViewerTTL rather than looked up for every event, and is only used by the filter of its subscription.
+type streamViewer struct {
+	id       uuid.UUID
+	mediaID  uuid.UUID
+	loadedAt time.Time
+	// hidden are the users the viewer muted, or who are on either side of a block with them.
+	hidden map[uuid.UUID]bool
+	// canView caches whether the viewer may see the private review authors met so far.
+	canView map[uuid.UUID]bool
+	guard   *spoilerGuard
+}
+
+type StreamService interface {
+	SubscribeNotifications(ctx context.Context, userID uuid.UUID) pubsub.Subscription
+	SubscribeComments(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType) (pubsub.Subscription, error)
+}
+
+type streamService struct {
+	store  datastore.Store
+	hub    pubsub.Hub
+	logger logger.Logger
+	media  MediaService
+}
+
+func NewStreamService(store datastore.Store, hub pubsub.Hub, logger logger.Logger, media MediaService) StreamService {
+	return &streamService{
+		store:  store,
+		hub:    hub,
+		logger: logger,
+		media:  media,
+	}
+}
+
+// SubscribeNotifications subscribes to the notifications of the user.
+func (ss *streamService) SubscribeNotifications(_ context.Context, userID uuid.UUID) pubsub.Subscription {
+	return ss.hub.Subscribe(notificationsTopic(userID))
+}
+
+// SubscribeComments subscribes to the notifications of the user along with the comment thread of the media.
+// Comment events are only passed on as the user would read the comment in the thread.
+func (ss *streamService) SubscribeComments(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType) (pubsub.Subscription, error) {
+	media, err := ss.media.GetMedia(ctx, ref, mediaType)
+	if e, ok := fault.As(err); ok {
+		if e.Code == fault.CodeNotFound {
+			return nil, fault.NotFound("media not found")
+		}
+		ss.logger.Error("failed getting media", err)
+		return nil, fault.Internal("failed to subscribe to comments")
+	}
+
+	topic := commentsTopic(media.ID)
+	sub := ss.hub.Subscribe(notificationsTopic(userID), topic)
+
+	viewer := &streamViewer{id: userID, mediaID: media.ID}
+	return pubsub.Filter(sub, func(event *pubsub.Event) *pubsub.Event {
+		if event.Topic != topic {
+			return event
+		}
+		return ss.commentEvent(viewer, event)
+	}), nil
+}
+
+// commentEvent tailors a comment event to the viewer: it is dropped when the viewer can't see the comment,
+// otherwise its spoilers are redacted and tombstones don't say who wrote them. Like CommentF.VisibleTo,
+// tombstones are passed on whoever wrote them.
+func (ss *streamService) commentEvent(viewer *streamViewer, event *pubsub.Event) *pubsub.Event {
+	var data CommentEvent
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		ss.logger.Error("failed decoding comment event", err)
+		return nil
+	} else if data.Comment == nil {
+		return nil
+	}
+	comment := data.Comment
+
+	// the comment is gone, so only which one it was is passed on
+	if event.Type == EventCommentPurged {
+		return ss.event(event, map[string]uuid.UUID{"id": comment.ID})
+	}
+
+	if err := ss.refresh(viewer); err != nil {
+		ss.logger.Error("failed loading stream viewer", err)
+		return nil
+	}
+
+	if comment.DeletedAt == nil && viewer.hidden[comment.UserID] {
+		return nil
+	}
+	if data.ReviewAuthorID != nil {
+		visible, err := ss.canView(viewer, *data.ReviewAuthorID, data.ReviewAuthorPrivate)
+		if err != nil {
+			ss.logger.Error("user visibility check failed", err)
+			return nil
+		} else if !visible {
+			return nil
+		}
+	}
+
+	viewer.guard.comment(comment)
+	if comment.DeletedAt != nil {
+		comment.UserID = uuid.Nil
+	}
+
+	return ss.event(event, comment)
+}
+
+// refresh loads what the viewer can see once it is older than streamViewerTTL.
+func (ss *streamService) refresh(viewer *streamViewer) error {
+	if time.Since(viewer.loadedAt) < streamViewerTTL {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), streamFilterTimeout)
+	defer cancel()
+
+	hidden, err := ss.store.Users().All(ctx, &model.UserF{HiddenFrom: &viewer.id})
+	if err != nil {
+		return err
+	}
+	guard, err := newSpoilerGuard(ctx, ss.store, viewer.id, []uuid.UUID{viewer.mediaID})
+	if err != nil {
+		return err
+	}
+
+	viewer.hidden = make(map[uuid.UUID]bool, len(hidden))
+	for _, user := range hidden {
+		viewer.hidden[user.ID] = true
+	}
+	viewer.canView = make(map[uuid.UUID]bool)
+	viewer.guard = guard
+	viewer.loadedAt = time.Now()
+	return nil
+}
+
+// canView reports whether the viewer may read the thread of a review by the author, the follow
+// of a private author only being looked up the first time they are met.
+func (ss *streamService) canView(viewer *streamViewer, authorID uuid.UUID, private bool) (bool, error) {
+	if viewer.hidden[authorID] {
+		return false, nil
+	}
+	if !private || authorID == viewer.id {
+		return true, nil
+	}
+	if visible, ok := viewer.canView[authorID]; ok {
+		return visible, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), streamFilterTimeout)
+	defer cancel()
+
+	visible, err := ss.store.Users().CanView(ctx, viewer.id, authorID)
+	if err != nil {
+		return false, err
+	}
+	viewer.canView[authorID] = visible
+	return visible, nil
+}
+
+// event is event with its data replaced.
+func (ss *streamService) event(event *pubsub.Event, data any) *pubsub.Event {
+	tailored, err := pubsub.NewEvent(event.Topic, event.Type, data)
+	if err != nil {
+		ss.logger.Error("failed encoding "+event.Type+" event", err)
+		return nil
+	}
+	return tailored
+}
+
+func notificationsTopic(userID uuid.UUID) string {
+	return "notifications:" + userID.String()
+}
+
+func commentsTopic(mediaID uuid.UUID) string {
+	return "comments:" + mediaID.String()
+}
+
+// publishComment publishes a comment event on the comments topic of its media, along with who can read its thread.
+func publishComment(ctx context.Context, store datastore.Store, hub pubsub.Hub, logger logger.Logger, kind string, comment *model.Comment) {
+	data := &CommentEvent{Comment: comment}
+	if comment.ReviewID != nil && kind != EventCommentPurged {
+		review, err := store.Reviews().One(ctx, &model.ReviewF{ID: comment.ReviewID})
+		if err != nil {
+			logger.Error("failed getting review of "+kind+" event", err)
+			return
+		}
+		author, err := store.Users().One(ctx, &model.UserF{ID: &review.UserID})
+		if err != nil {
+			logger.Error("failed getting review author of "+kind+" event", err)
+			return
+		}
+		data.ReviewAuthorID, data.ReviewAuthorPrivate = &author.ID, author.Private
+	}
+
+	publish(ctx, hub, logger, commentsTopic(comment.MediaID), kind, data)
+}
+
+// publish pushes data to the subscribers of topic. It is called after the change it describes
+// was saved, so failing to publish is only logged.
+func publish(ctx context.Context, hub pubsub.Hub, logger logger.Logger, topic string, kind string, data any) {
+	event, err := pubsub.NewEvent(topic, kind, data)
+	if err != nil {
+		logger.Error("failed encoding "+kind+" event", err)
+		return
+	}
+	if err = hub.Publish(ctx, event); err != nil {
+		logger.Error("failed publishing "+kind+" event", err)
+	}
+}
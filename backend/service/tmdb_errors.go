@@ -0,0 +1,20 @@
+package service
+
+import (
+	"cine/pkg/fault"
+	"cine/pkg/logger"
+	"cine/pkg/tmdb"
+)
+
+// tmdbError turns an error from TMDB into a fault. A missing title is not found with the notFound message,
+// an outage is unavailable, and anything else is logged and internal with the internal message.
+func tmdbError(logger logger.Logger, err error, notFound string, internal string) error {
+	if tmdb.IsNotFound(err) {
+		return fault.NotFound(notFound)
+	}
+	if tmdb.IsUnavailable(err) {
+		return fault.Unavailable("the movie database is unavailable")
+	}
+	logger.Error(internal, err)
+	return fault.Internal(internal)
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"cine/pkg/logger"
+	"context"
+	"go.uber.org/fx"
+	"strconv"
+	"time"
+)
+
+// tombstoneRetentionInterval is how often tombstones without replies are looked for.
+const tombstoneRetentionInterval = time.Hour
+
+// InvokeTombstoneRetention periodically removes the tombstones of deleted comments once they have no replies left,
+// and those of deleted reviews once their thread is empty.
+func InvokeTombstoneRetention(lc fx.Lifecycle, comments CommentService, reviews ReviewService, logger logger.Logger) {
+	ctx, cancel := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go retainTombstones(ctx, comments, reviews, logger, tombstoneRetentionInterval)
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
+
+func retainTombstones(ctx context.Context, comments CommentService, reviews ReviewService, logger logger.Logger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// failures are logged by the service, the next tick tries again
+			if purged, err := comments.PurgeTombstones(ctx); err == nil && purged > 0 {
+				logger.Info("purged " + strconv.Itoa(purged) + " comment tombstones")
+			}
+			// comments go first, a review's thread may have just been emptied
+			if purged, err := reviews.PurgeTombstones(ctx); err == nil && purged > 0 {
+				logger.Info("purged " + strconv.Itoa(purged) + " review tombstones")
+			}
+		}
+	}
+}
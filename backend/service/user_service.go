@@ -15,19 +15,33 @@ type UserService interface {
 	GetDetailedUser(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*model.DetailedUser, error)
 	UpdateUser(ctx context.Context, id uuid.UUID, userU *model.UserU) (*model.User, error)
 	DeleteUser(ctx context.Context, id uuid.UUID) error
-	FollowUser(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) error
+	FollowUser(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) (*model.FollowRequest, error)
 	UnfollowUser(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) error
+	GetFollowers(ctx context.Context, viewerID, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedFollow], error)
+	GetFollowing(ctx context.Context, viewerID, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedFollow], error)
+	BlockUser(ctx context.Context, userID uuid.UUID, blockedID uuid.UUID) error
+	UnblockUser(ctx context.Context, userID uuid.UUID, blockedID uuid.UUID) error
+	GetBlocked(ctx context.Context, userID uuid.UUID) ([]*model.User, error)
+	MuteUser(ctx context.Context, userID uuid.UUID, mutedID uuid.UUID) error
+	UnmuteUser(ctx context.Context, userID uuid.UUID, mutedID uuid.UUID) error
+	GetMuted(ctx context.Context, userID uuid.UUID) ([]*model.User, error)
+	GetFollowRequests(ctx context.Context, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedFollowRequest], error)
+	ApproveFollowRequest(ctx context.Context, userID uuid.UUID, requestID uuid.UUID) error
+	DenyFollowRequest(ctx context.Context, userID uuid.UUID, requestID uuid.UUID) error
+	CancelFollowRequest(ctx context.Context, requesterID uuid.UUID, userID uuid.UUID) error
 }
 
 type userService struct {
-	store  datastore.Store
-	logger logger.Logger
+	store         datastore.Store
+	logger        logger.Logger
+	notifications NotificationService
 }
 
-func NewUserService(store datastore.Store, logger logger.Logger) UserService {
+func NewUserService(store datastore.Store, logger logger.Logger, notifications NotificationService) UserService {
 	return &userService{
-		store:  store,
-		logger: logger,
+		store:         store,
+		logger:        logger,
+		notifications: notifications,
 	}
 }
 
@@ -104,6 +118,13 @@ func (us userService) UpdateUser(ctx context.Context, id uuid.UUID, userU *model
 		return nil, fault.Internal("error updating user")
 	}
 
+	// once an account is public, pending requests have nothing left to wait for, the requesters can just follow
+	if userU.Private != nil && !*userU.Private {
+		if _, err = us.store.FollowRequests().DeleteExec(ctx, &model.FollowRequestF{UserID: &id}); err != nil {
+			us.logger.Error("failed deleting follow requests", err)
+		}
+	}
+
 	return user, nil
 }
 
@@ -117,22 +138,165 @@ func (us userService) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (us userService) FollowUser(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) error {
+// FollowUser follows a public account right away. Following a private account only requests it,
+// and the pending request is returned until the account approves or denies it.
+func (us userService) FollowUser(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) (*model.FollowRequest, error) {
 	user, err := us.store.Users().One(ctx, &model.UserF{ID: &followerID})
 	if err != nil {
 		if datastore.IsNotFound(err) {
-			return fault.NotFound("user not found")
+			return nil, fault.NotFound("user not found")
+		}
+		us.logger.Error("user retrieval failed", err)
+		return nil, fault.Internal("error following user")
+	}
+
+	followee, err := us.store.Users().One(ctx, &model.UserF{ID: &followeeID})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return nil, fault.NotFound("user to follow not found")
 		}
 		us.logger.Error("user retrieval failed", err)
-		return fault.Internal("error following user")
+		return nil, fault.Internal("error following user")
+	}
+
+	blocked, err := us.store.Users().Blocked(ctx, followerID, followeeID)
+	if err != nil {
+		us.logger.Error("block check failed", err)
+		return nil, fault.Internal("error following user")
+	} else if blocked {
+		return nil, fault.Forbidden("you can't follow this user")
+	}
+
+	if followee.Private {
+		return us.requestFollow(ctx, user, followeeID)
 	}
 
 	if err = us.store.Users().FollowUser(ctx, user, followeeID); err != nil {
 		if datastore.IsConstraint(err) {
-			return fault.Conflict("already following user")
+			return nil, fault.Conflict("already following user")
+		}
+		us.logger.Error("user follow failed", err)
+		return nil, fault.Internal("error following user")
+	}
+
+	recordActivity(ctx, us.store, us.logger, &model.Activity{UserID: followerID, Kind: model.ActivityUserFollowed, FollowedID: &followeeID})
+	us.notifications.Notify(ctx, &model.Notification{UserID: followeeID, ActorID: followerID, Kind: model.NotificationUserFollowed})
+
+	return nil, nil
+}
+
+func (us userService) requestFollow(ctx context.Context, user *model.User, followeeID uuid.UUID) (*model.FollowRequest, error) {
+	_, err := us.store.Users().OneFollowed(ctx, user, followeeID)
+	if err == nil {
+		return nil, fault.Conflict("already following user")
+	} else if !datastore.IsNotFound(err) {
+		us.logger.Error("follow check failed", err)
+		return nil, fault.Internal("error following user")
+	}
+
+	request, err := us.store.FollowRequests().Insert(ctx, &model.FollowRequest{RequesterID: user.ID, UserID: followeeID})
+	if err != nil {
+		if datastore.IsConstraint(err) {
+			return nil, fault.Conflict("already requested to follow user")
+		}
+		us.logger.Error("follow request failed", err)
+		return nil, fault.Internal("error following user")
+	}
+
+	us.notifications.Notify(ctx, &model.Notification{UserID: followeeID, ActorID: user.ID, Kind: model.NotificationFollowRequested})
+
+	return request, nil
+}
+
+// GetFollowRequests pages through the pending requests to follow the user, newest first.
+func (us userService) GetFollowRequests(ctx context.Context, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedFollowRequest], error) {
+	after, err := model.ParseCursor(cursor)
+	if err != nil {
+		return nil, fault.BadRequest("cursor is invalid")
+	}
+
+	requests, err := us.store.FollowRequests().AllDetailed(ctx, after, limit+1, &model.FollowRequestF{UserID: &userID})
+	if err != nil {
+		us.logger.Error("failed getting follow requests", err)
+		return nil, fault.Internal("error getting follow requests")
+	}
+
+	return paginate(requests, limit, func(request *model.DetailedFollowRequest) model.Cursor {
+		return model.Cursor{CreatedAt: request.Request.CreatedAt, ID: request.Request.ID}
+	}), nil
+}
+
+// ApproveFollowRequest turns a pending request to follow the user into a follow.
+func (us userService) ApproveFollowRequest(ctx context.Context, userID uuid.UUID, requestID uuid.UUID) error {
+	request, err := us.store.FollowRequests().One(ctx, &model.FollowRequestF{ID: &requestID, UserID: &userID})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return fault.NotFound("follow request not found")
+		}
+		us.logger.Error("follow request retrieval failed", err)
+		return fault.Internal("error approving follow request")
+	}
+
+	blocked, err := us.store.Users().Blocked(ctx, userID, request.RequesterID)
+	if err != nil {
+		us.logger.Error("block check failed", err)
+		return fault.Internal("error approving follow request")
+	} else if blocked {
+		return fault.Forbidden("you can't approve a request from this user")
+	}
+
+	tx, err := us.store.Transaction(ctx)
+	if err != nil {
+		us.logger.Error("error starting transaction", err)
+		return fault.Internal("error approving follow request")
+	}
+	defer tx.Rollback()
+
+	if err = tx.Users().FollowUser(ctx, &model.User{ID: request.RequesterID}, userID); err != nil {
+		if datastore.IsConstraint(err) {
+			return fault.Conflict("user is already following")
 		}
 		us.logger.Error("user follow failed", err)
-		return fault.Internal("error following user")
+		return fault.Internal("error approving follow request")
+	}
+
+	if err = tx.FollowRequests().Delete(ctx, request.ID); err != nil {
+		us.logger.Error("follow request deletion failed", err)
+		return fault.Internal("error approving follow request")
+	}
+
+	if err = tx.Commit(); err != nil {
+		us.logger.Error("error committing transaction", err)
+		return fault.Internal("error approving follow request")
+	}
+
+	recordActivity(ctx, us.store, us.logger, &model.Activity{UserID: request.RequesterID, Kind: model.ActivityUserFollowed, FollowedID: &userID})
+	us.notifications.Notify(ctx, &model.Notification{UserID: request.RequesterID, ActorID: userID, Kind: model.NotificationFollowApproved})
+
+	return nil
+}
+
+// DenyFollowRequest drops a pending request to follow the user, the requester isn't told.
+func (us userService) DenyFollowRequest(ctx context.Context, userID uuid.UUID, requestID uuid.UUID) error {
+	affected, err := us.store.FollowRequests().DeleteExec(ctx, &model.FollowRequestF{ID: &requestID, UserID: &userID})
+	if err != nil {
+		us.logger.Error("follow request deletion failed", err)
+		return fault.Internal("error denying follow request")
+	} else if affected == 0 {
+		return fault.NotFound("follow request not found")
+	}
+
+	return nil
+}
+
+// CancelFollowRequest takes back the requester's pending request to follow the user.
+func (us userService) CancelFollowRequest(ctx context.Context, requesterID uuid.UUID, userID uuid.UUID) error {
+	affected, err := us.store.FollowRequests().DeleteExec(ctx, &model.FollowRequestF{RequesterID: &requesterID, UserID: &userID})
+	if err != nil {
+		us.logger.Error("follow request deletion failed", err)
+		return fault.Internal("error cancelling follow request")
+	} else if affected == 0 {
+		return fault.NotFound("follow request not found")
 	}
 
 	return nil
@@ -156,13 +320,228 @@ func (us userService) UnfollowUser(ctx context.Context, followerID uuid.UUID, fo
 		return fault.Internal("error unfollowing user")
 	}
 
+	us.deleteFollowActivity(ctx, followerID, followeeID)
+
+	return nil
+}
+
+// BlockUser blocks a user, which also removes the follows between the pair in both directions.
+func (us userService) BlockUser(ctx context.Context, userID uuid.UUID, blockedID uuid.UUID) error {
+	user, err := us.relatedUser(ctx, userID, blockedID, "block")
+	if err != nil {
+		return err
+	}
+
+	tx, err := us.store.Transaction(ctx)
+	if err != nil {
+		us.logger.Error("error starting transaction", err)
+		return fault.Internal("error blocking user")
+	}
+	defer tx.Rollback()
+
+	if err = tx.Users().BlockUser(ctx, user, blockedID); err != nil {
+		if datastore.IsConstraint(err) {
+			return fault.Conflict("already blocking user")
+		}
+		us.logger.Error("user block failed", err)
+		return fault.Internal("error blocking user")
+	}
+
+	// pending requests could otherwise still be approved into a follow between the pair
+	for _, request := range []*model.FollowRequestF{
+		{RequesterID: &userID, UserID: &blockedID},
+		{RequesterID: &blockedID, UserID: &userID},
+	} {
+		if _, err = tx.FollowRequests().DeleteExec(ctx, request); err != nil {
+			us.logger.Error("failed deleting follow requests", err)
+			return fault.Internal("error blocking user")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		us.logger.Error("error committing transaction", err)
+		return fault.Internal("error blocking user")
+	}
+
+	us.deleteFollowActivity(ctx, userID, blockedID)
+	us.deleteFollowActivity(ctx, blockedID, userID)
+
 	return nil
 }
 
+func (us userService) UnblockUser(ctx context.Context, userID uuid.UUID, blockedID uuid.UUID) error {
+	user, err := us.relatedUser(ctx, userID, blockedID, "unblock")
+	if err != nil {
+		return err
+	}
+
+	if err = us.store.Users().UnblockUser(ctx, user, blockedID); err != nil {
+		us.logger.Error("user unblock failed", err)
+		return fault.Internal("error unblocking user")
+	}
+
+	return nil
+}
+
+func (us userService) GetBlocked(ctx context.Context, userID uuid.UUID) ([]*model.User, error) {
+	blocked, err := us.store.Users().AllBlocked(ctx, &model.User{ID: userID})
+	if err != nil {
+		us.logger.Error("failed getting blocked users", err)
+		return nil, fault.Internal("error getting blocked users")
+	}
+
+	return blocked, nil
+}
+
+// MuteUser hides the comments, reviews and activity of a user from the muter, without them knowing.
+func (us userService) MuteUser(ctx context.Context, userID uuid.UUID, mutedID uuid.UUID) error {
+	user, err := us.relatedUser(ctx, userID, mutedID, "mute")
+	if err != nil {
+		return err
+	}
+
+	if err = us.store.Users().MuteUser(ctx, user, mutedID); err != nil {
+		if datastore.IsConstraint(err) {
+			return fault.Conflict("already muting user")
+		}
+		us.logger.Error("user mute failed", err)
+		return fault.Internal("error muting user")
+	}
+
+	return nil
+}
+
+func (us userService) UnmuteUser(ctx context.Context, userID uuid.UUID, mutedID uuid.UUID) error {
+	user, err := us.relatedUser(ctx, userID, mutedID, "unmute")
+	if err != nil {
+		return err
+	}
+
+	if err = us.store.Users().UnmuteUser(ctx, user, mutedID); err != nil {
+		us.logger.Error("user unmute failed", err)
+		return fault.Internal("error unmuting user")
+	}
+
+	return nil
+}
+
+func (us userService) GetMuted(ctx context.Context, userID uuid.UUID) ([]*model.User, error) {
+	muted, err := us.store.Users().AllMuted(ctx, &model.User{ID: userID})
+	if err != nil {
+		us.logger.Error("failed getting muted users", err)
+		return nil, fault.Internal("error getting muted users")
+	}
+
+	return muted, nil
+}
+
+// relatedUser gets the user who is about to block or mute another user, after checking the other user exists.
+func (us userService) relatedUser(ctx context.Context, userID uuid.UUID, otherID uuid.UUID, action string) (*model.User, error) {
+	if userID == otherID {
+		return nil, fault.BadRequest("you can't " + action + " yourself")
+	}
+
+	user, err := us.store.Users().One(ctx, &model.UserF{ID: &userID})
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return nil, fault.NotFound("user not found")
+		}
+		us.logger.Error("user retrieval failed", err)
+		return nil, fault.Internal("error trying to " + action + " user")
+	}
+
+	exists, err := us.store.Users().Exists(ctx, &model.UserF{ID: &otherID})
+	if err != nil {
+		us.logger.Error("user retrieval failed", err)
+		return nil, fault.Internal("error trying to " + action + " user")
+	} else if !exists {
+		return nil, fault.NotFound("user to " + action + " not found")
+	}
+
+	return user, nil
+}
+
+// deleteFollowActivity removes the activity of a follow that ended, it shouldn't linger in anyone's feed.
+func (us userService) deleteFollowActivity(ctx context.Context, followerID uuid.UUID, followedID uuid.UUID) {
+	kind := model.ActivityUserFollowed
+	_, err := us.store.Activities().DeleteExec(ctx, &model.ActivityF{UserID: &followerID, Kind: &kind, FollowedID: &followedID})
+	if err != nil {
+		us.logger.Error("failed deleting follow activity", err)
+	}
+}
+
+// GetFollowers pages through the users following the user, most recent follow first.
+func (us userService) GetFollowers(ctx context.Context, viewerID, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedFollow], error) {
+	after, err := us.followPage(ctx, viewerID, userID, cursor, "followers")
+	if err != nil {
+		return nil, err
+	}
+
+	followers, err := us.store.Users().AllFollowersDetailed(ctx, userID, viewerID, after, limit+1)
+	if err != nil {
+		us.logger.Error("failed getting followers", err)
+		return nil, fault.Internal("error getting followers")
+	}
+
+	return paginate(followers, limit, followCursor), nil
+}
+
+// GetFollowing pages through the users the user follows, most recent follow first.
+func (us userService) GetFollowing(ctx context.Context, viewerID, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedFollow], error) {
+	after, err := us.followPage(ctx, viewerID, userID, cursor, "following")
+	if err != nil {
+		return nil, err
+	}
+
+	following, err := us.store.Users().AllFollowedDetailed(ctx, userID, viewerID, after, limit+1)
+	if err != nil {
+		us.logger.Error("failed getting followed users", err)
+		return nil, fault.Internal("error getting following")
+	}
+
+	return paginate(following, limit, followCursor), nil
+}
+
+// followPage checks the user whose follows are listed exists, and that the viewer may see them,
+// then parses the cursor to list them from.
+func (us userService) followPage(ctx context.Context, viewerID, userID uuid.UUID, cursor string, listing string) (*model.Cursor, error) {
+	after, err := model.ParseCursor(cursor)
+	if err != nil {
+		return nil, fault.BadRequest("cursor is invalid")
+	}
+
+	exists, err := us.store.Users().Exists(ctx, &model.UserF{ID: &userID})
+	if err != nil {
+		us.logger.Error("user retrieval failed", err)
+		return nil, fault.Internal("error getting " + listing)
+	} else if !exists {
+		return nil, fault.NotFound("user not found")
+	}
+
+	visible, err := us.store.Users().CanView(ctx, viewerID, userID)
+	if err != nil {
+		us.logger.Error("user visibility check failed", err)
+		return nil, fault.Internal("error getting " + listing)
+	} else if !visible {
+		return nil, fault.Forbidden("this account is private")
+	}
+
+	return after, nil
+}
+
+func followCursor(follow *model.DetailedFollow) model.Cursor {
+	return model.Cursor{CreatedAt: follow.FollowedAt, ID: follow.User.ID}
+}
+
 func (us userService) hasFieldToUpdate(userU *model.UserU) bool {
 	return userU.DisplayName != nil ||
 		userU.Username != nil ||
 		userU.Email != nil ||
 		userU.Password != nil ||
-		userU.ProfilePicture != nil
+		userU.ProfilePicture != nil ||
+		userU.Region != nil ||
+		userU.Services != nil ||
+		userU.MutedNotifications != nil ||
+		userU.Private != nil ||
+		userU.HideSpoilers != nil
 }
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"cine/datastore"
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/pkg/logger"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type WatchlistService interface {
+	GetItems(ctx context.Context, userID uuid.UUID, status model.WatchStatus) ([]*model.DetailedWatchItem, error)
+	AddToWatchlist(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType, priority *int) (*model.WatchItem, error)
+	MarkWatched(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType, priority *int) (*model.WatchItem, error)
+	UpdatePriority(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType, priority int) (*model.WatchItem, error)
+	RemoveItem(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType) error
+}
+
+type watchlistService struct {
+	store  datastore.Store
+	logger logger.Logger
+	media  MediaService
+}
+
+func NewWatchlistService(store datastore.Store, logger logger.Logger, media MediaService) WatchlistService {
+	return &watchlistService{store: store, logger: logger, media: media}
+}
+
+func (ws *watchlistService) GetItems(ctx context.Context, userID uuid.UUID, status model.WatchStatus) ([]*model.DetailedWatchItem, error) {
+	items, err := ws.store.WatchItems().AllWithMedia(ctx, &model.WatchItemF{UserID: &userID, Status: &status})
+	if err != nil {
+		ws.logger.Error("failed getting watch items", err)
+		return nil, fault.Internal("error getting " + string(status) + " items")
+	}
+
+	return items, nil
+}
+
+// AddToWatchlist puts a media on the user's watchlist. A media that was already watched is moved
+// back onto the watchlist, since wanting to see it again is the only reason to add it.
+func (ws *watchlistService) AddToWatchlist(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType, priority *int) (*model.WatchItem, error) {
+	return ws.setStatus(ctx, userID, ref, mediaType, model.WatchStatusWatchlist, priority)
+}
+
+// MarkWatched puts a media in the user's watched set, which takes it off their watchlist.
+func (ws *watchlistService) MarkWatched(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType, priority *int) (*model.WatchItem, error) {
+	return ws.setStatus(ctx, userID, ref, mediaType, model.WatchStatusWatched, priority)
+}
+
+func (ws *watchlistService) UpdatePriority(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType, priority int) (*model.WatchItem, error) {
+	item, err := ws.store.WatchItems().OneByRef(ctx, userID, mediaType, ref)
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return nil, fault.NotFound(string(mediaType) + " is not in your watchlist or watched set")
+		}
+		ws.logger.Error("failed getting watch item", err)
+		return nil, fault.Internal("error updating priority")
+	}
+
+	item, err = ws.store.WatchItems().Update(ctx, item.ID, &model.WatchItemU{Priority: &priority})
+	if err != nil {
+		ws.logger.Error("failed updating watch item", err)
+		return nil, fault.Internal("error updating priority")
+	}
+
+	return item, nil
+}
+
+func (ws *watchlistService) RemoveItem(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType) error {
+	item, err := ws.store.WatchItems().OneByRef(ctx, userID, mediaType, ref)
+	if err != nil {
+		if datastore.IsNotFound(err) {
+			return fault.NotFound(string(mediaType) + " is not in your watchlist or watched set")
+		}
+		ws.logger.Error("failed getting watch item", err)
+		return fault.Internal("error removing item")
+	}
+
+	if err = ws.store.WatchItems().Delete(ctx, item.ID); err != nil {
+		ws.logger.Error("failed deleting watch item", err)
+		return fault.Internal("error removing item")
+	}
+
+	return nil
+}
+
+// setStatus creates the user's item for the media, or moves an existing one to status. Moving an item
+// resets when it was added, and its priority unless a new one is given.
+func (ws *watchlistService) setStatus(
+	ctx context.Context,
+	userID uuid.UUID,
+	ref int,
+	mediaType model.MediaType,
+	status model.WatchStatus,
+	priority *int,
+) (*model.WatchItem, error) {
+	media, err := ws.media.GetMedia(ctx, ref, mediaType)
+	if e, ok := fault.As(err); ok {
+		if e.Code == fault.CodeNotFound {
+			return nil, fault.NotFound("media not found")
+		}
+		return nil, e
+	}
+
+	item, err := ws.store.WatchItems().One(ctx, &model.WatchItemF{UserID: &userID, MediaID: &media.ID})
+	if err != nil {
+		if !datastore.IsNotFound(err) {
+			ws.logger.Error("failed getting watch item", err)
+			return nil, fault.Internal("error saving " + string(mediaType))
+		}
+
+		newItem := &model.WatchItem{UserID: userID, MediaID: media.ID, Status: status}
+		if priority != nil {
+			newItem.Priority = *priority
+		}
+		item, err = ws.store.WatchItems().Insert(ctx, newItem)
+		if err != nil {
+			if datastore.IsConstraint(err) {
+				return nil, fault.Conflict(string(mediaType) + " is already being saved")
+			}
+			ws.logger.Error("failed inserting watch item", err)
+			return nil, fault.Internal("error saving " + string(mediaType))
+		}
+		return item, nil
+	}
+
+	itemU := &model.WatchItemU{Priority: priority}
+	if item.Status != status {
+		now := time.Now()
+		itemU.Status = &status
+		itemU.AddedAt = &now
+		if priority == nil {
+			zero := 0
+			itemU.Priority = &zero
+		}
+	} else if priority == nil || *priority == item.Priority {
+		return item, nil
+	}
+
+	item, err = ws.store.WatchItems().Update(ctx, item.ID, itemU)
+	if err != nil {
+		ws.logger.Error("failed updating watch item", err)
+		return nil, fault.Internal("error saving " + string(mediaType))
+	}
+
+	return item, nil
+}
@@ -0,0 +1,105 @@
+package mocks
+
+import (
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ repository.ActivityRepository = (*ActivityRepository)(nil)
+
+type ActivityRepository struct {
+	OneFn         func(ctx context.Context, filters ...*model.ActivityF) (*model.Activity, error)
+	AllFn         func(ctx context.Context, filters ...*model.ActivityF) ([]*model.Activity, error)
+	ExistsFn      func(ctx context.Context, filters ...*model.ActivityF) (bool, error)
+	CountFn       func(ctx context.Context, filters ...*model.ActivityF) (int, error)
+	InsertFn      func(ctx context.Context, entity *model.Activity) (*model.Activity, error)
+	InsertBulkFn  func(ctx context.Context, entities []*model.Activity) ([]*model.Activity, error)
+	UpdateFn      func(ctx context.Context, id uuid.UUID, updater *model.ActivityU) (*model.Activity, error)
+	UpdateExecFn  func(ctx context.Context, updater *model.ActivityU, filters ...*model.ActivityF) (int, error)
+	DeleteFn      func(ctx context.Context, id uuid.UUID) error
+	DeleteExecFn  func(ctx context.Context, filters ...*model.ActivityF) (int, error)
+	AllDetailedFn func(ctx context.Context, viewerID uuid.UUID, after *model.Cursor, limit int, filters ...*model.ActivityF) ([]*model.DetailedActivity, error)
+}
+
+func NewActivityRepository() *ActivityRepository {
+	return &ActivityRepository{}
+}
+
+func (e *ActivityRepository) One(ctx context.Context, filters ...*model.ActivityF) (*model.Activity, error) {
+	if e.OneFn != nil {
+		return e.OneFn(ctx, filters...)
+	}
+	return &model.Activity{}, nil
+}
+
+func (e *ActivityRepository) All(ctx context.Context, filters ...*model.ActivityF) ([]*model.Activity, error) {
+	if e.AllFn != nil {
+		return e.AllFn(ctx, filters...)
+	}
+	return []*model.Activity{}, nil
+}
+
+func (e *ActivityRepository) Exists(ctx context.Context, filters ...*model.ActivityF) (bool, error) {
+	if e.ExistsFn != nil {
+		return e.ExistsFn(ctx, filters...)
+	}
+	return false, nil
+}
+
+func (e *ActivityRepository) Count(ctx context.Context, filters ...*model.ActivityF) (int, error) {
+	if e.CountFn != nil {
+		return e.CountFn(ctx, filters...)
+	}
+	return 0, nil
+}
+
+func (e *ActivityRepository) Insert(ctx context.Context, entity *model.Activity) (*model.Activity, error) {
+	if e.InsertFn != nil {
+		return e.InsertFn(ctx, entity)
+	}
+	return &model.Activity{}, nil
+}
+
+func (e *ActivityRepository) InsertBulk(ctx context.Context, entities []*model.Activity) ([]*model.Activity, error) {
+	if e.InsertBulkFn != nil {
+		return e.InsertBulkFn(ctx, entities)
+	}
+	return []*model.Activity{}, nil
+}
+
+func (e *ActivityRepository) Update(ctx context.Context, id uuid.UUID, updater *model.ActivityU) (*model.Activity, error) {
+	if e.UpdateFn != nil {
+		return e.UpdateFn(ctx, id, updater)
+	}
+	return &model.Activity{}, nil
+}
+
+func (e *ActivityRepository) UpdateExec(ctx context.Context, updater *model.ActivityU, filters ...*model.ActivityF) (int, error) {
+	if e.UpdateExecFn != nil {
+		return e.UpdateExecFn(ctx, updater, filters...)
+	}
+	return 0, nil
+}
+
+func (e *ActivityRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if e.DeleteFn != nil {
+		return e.DeleteFn(ctx, id)
+	}
+	return nil
+}
+
+func (e *ActivityRepository) DeleteExec(ctx context.Context, filters ...*model.ActivityF) (int, error) {
+	if e.DeleteExecFn != nil {
+		return e.DeleteExecFn(ctx, filters...)
+	}
+	return 0, nil
+}
+
+func (e *ActivityRepository) AllDetailed(ctx context.Context, viewerID uuid.UUID, after *model.Cursor, limit int, filters ...*model.ActivityF) ([]*model.DetailedActivity, error) {
+	if e.AllDetailedFn != nil {
+		return e.AllDetailedFn(ctx, viewerID, after, limit, filters...)
+	}
+	return []*model.DetailedActivity{}, nil
+}
@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"cine/entity/model"
+	"cine/service"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ service.ActivityService = (*ActivityServiceMock)(nil)
+
+type ActivityServiceMock struct {
+	GetFeedFn         func(ctx context.Context, viewerID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedActivity], error)
+	GetUserActivityFn func(ctx context.Context, viewerID, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedActivity], error)
+}
+
+func NewActivityService() *ActivityServiceMock {
+	return &ActivityServiceMock{}
+}
+
+func (m *ActivityServiceMock) GetFeed(ctx context.Context, viewerID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedActivity], error) {
+	if m.GetFeedFn != nil {
+		return m.GetFeedFn(ctx, viewerID, cursor, limit)
+	}
+	return &model.Page[*model.DetailedActivity]{Items: []*model.DetailedActivity{}}, nil
+}
+
+func (m *ActivityServiceMock) GetUserActivity(ctx context.Context, viewerID, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedActivity], error) {
+	if m.GetUserActivityFn != nil {
+		return m.GetUserActivityFn(ctx, viewerID, userID, cursor, limit)
+	}
+	return &model.Page[*model.DetailedActivity]{Items: []*model.DetailedActivity{}}, nil
+}
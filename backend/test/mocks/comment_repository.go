@@ -22,6 +22,9 @@ type CommentRepository struct {
 	DeleteExecFn           func(ctx context.Context, filters ...*model.CommentF) (int, error)
 	AllAsDetailedFn        func(ctx context.Context, mediaID uuid.UUID, userID uuid.UUID) ([]*model.DetailedComment, error)
 	AllRepliesAsDetailedFn func(ctx context.Context, comment *model.Comment, userID uuid.UUID) ([]*model.DetailedComment, error)
+
+	AllOnReviewAsDetailedFn func(ctx context.Context, reviewID uuid.UUID, userID uuid.UUID) ([]*model.DetailedComment, error)
+	DeleteTombstonesFn      func(ctx context.Context) (int, error)
 }
 
 func NewCommentRepository() *CommentRepository {
@@ -105,9 +108,23 @@ func (c *CommentRepository) AllAsDetailed(ctx context.Context, mediaID uuid.UUID
 	return []*model.DetailedComment{}, nil
 }
 
+func (c *CommentRepository) AllOnReviewAsDetailed(ctx context.Context, reviewID uuid.UUID, userID uuid.UUID) ([]*model.DetailedComment, error) {
+	if c.AllOnReviewAsDetailedFn != nil {
+		return c.AllOnReviewAsDetailedFn(ctx, reviewID, userID)
+	}
+	return []*model.DetailedComment{}, nil
+}
+
 func (c *CommentRepository) AllRepliesAsDetailed(ctx context.Context, comment *model.Comment, userID uuid.UUID) ([]*model.DetailedComment, error) {
 	if c.AllRepliesAsDetailedFn != nil {
 		return c.AllRepliesAsDetailedFn(ctx, comment, userID)
 	}
 	return []*model.DetailedComment{}, nil
 }
+
+func (c *CommentRepository) DeleteTombstones(ctx context.Context) (int, error) {
+	if c.DeleteTombstonesFn != nil {
+		return c.DeleteTombstonesFn(ctx)
+	}
+	return 0, nil
+}
@@ -11,12 +11,19 @@ var _ service.CommentService = (*CommentServiceMock)(nil)
 
 type CommentServiceMock struct {
 	CreateCommentFn     func(ctx context.Context, ref int, mediaType model.MediaType, comment *model.Comment) (*model.Comment, error)
-	UpdateCommentFn     func(ctx context.Context, userID, commentID uuid.UUID, content string) (*model.Comment, error)
+	UpdateCommentFn     func(ctx context.Context, userID, commentID uuid.UUID, commentU *model.CommentU) (*model.Comment, error)
 	DeleteCommentFn     func(ctx context.Context, userID, commentID uuid.UUID) error
+	PurgeCommentFn      func(ctx context.Context, userID, commentID uuid.UUID) error
+	PurgeTombstonesFn   func(ctx context.Context) (int, error)
 	GetCommentsFn       func(ctx context.Context, ref int, mediaType model.MediaType, userID uuid.UUID) ([]*model.DetailedComment, error)
 	GetCommentRepliesFn func(ctx context.Context, commentID, userID uuid.UUID) ([]*model.DetailedComment, error)
 	LikeCommentFn       func(ctx context.Context, like *model.Like) (*model.Like, error)
 	UnlikeCommentFn     func(ctx context.Context, userID, commentID uuid.UUID) error
+
+	CreateReviewCommentFn func(ctx context.Context, reviewID uuid.UUID, comment *model.Comment) (*model.Comment, error)
+	GetReviewCommentsFn   func(ctx context.Context, reviewID, userID uuid.UUID) ([]*model.DetailedComment, error)
+
+	GetCommentRevisionsFn func(ctx context.Context, commentID, userID uuid.UUID) ([]*model.Revision, error)
 }
 
 func NewCommentService() *CommentServiceMock {
@@ -30,9 +37,9 @@ func (m *CommentServiceMock) CreateComment(ctx context.Context, ref int, mediaTy
 	return &model.Comment{}, nil
 }
 
-func (m *CommentServiceMock) UpdateComment(ctx context.Context, userID, commentID uuid.UUID, content string) (*model.Comment, error) {
+func (m *CommentServiceMock) UpdateComment(ctx context.Context, userID, commentID uuid.UUID, commentU *model.CommentU) (*model.Comment, error) {
 	if m.UpdateCommentFn != nil {
-		return m.UpdateCommentFn(ctx, userID, commentID, content)
+		return m.UpdateCommentFn(ctx, userID, commentID, commentU)
 	}
 	return &model.Comment{}, nil
 }
@@ -44,6 +51,20 @@ func (m *CommentServiceMock) DeleteComment(ctx context.Context, userID, commentI
 	return nil
 }
 
+func (m *CommentServiceMock) PurgeComment(ctx context.Context, userID, commentID uuid.UUID) error {
+	if m.PurgeCommentFn != nil {
+		return m.PurgeCommentFn(ctx, userID, commentID)
+	}
+	return nil
+}
+
+func (m *CommentServiceMock) PurgeTombstones(ctx context.Context) (int, error) {
+	if m.PurgeTombstonesFn != nil {
+		return m.PurgeTombstonesFn(ctx)
+	}
+	return 0, nil
+}
+
 func (m *CommentServiceMock) GetComments(ctx context.Context, ref int, mediaType model.MediaType, userID uuid.UUID) ([]*model.DetailedComment, error) {
 	if m.GetCommentsFn != nil {
 		return m.GetCommentsFn(ctx, ref, mediaType, userID)
@@ -71,3 +92,24 @@ func (m *CommentServiceMock) UnlikeComment(ctx context.Context, userID, commentI
 	}
 	return nil
 }
+
+func (m *CommentServiceMock) CreateReviewComment(ctx context.Context, reviewID uuid.UUID, comment *model.Comment) (*model.Comment, error) {
+	if m.CreateReviewCommentFn != nil {
+		return m.CreateReviewCommentFn(ctx, reviewID, comment)
+	}
+	return &model.Comment{}, nil
+}
+
+func (m *CommentServiceMock) GetReviewComments(ctx context.Context, reviewID, userID uuid.UUID) ([]*model.DetailedComment, error) {
+	if m.GetReviewCommentsFn != nil {
+		return m.GetReviewCommentsFn(ctx, reviewID, userID)
+	}
+	return []*model.DetailedComment{}, nil
+}
+
+func (m *CommentServiceMock) GetCommentRevisions(ctx context.Context, commentID, userID uuid.UUID) ([]*model.Revision, error) {
+	if m.GetCommentRevisionsFn != nil {
+		return m.GetCommentRevisionsFn(ctx, commentID, userID)
+	}
+	return []*model.Revision{}, nil
+}
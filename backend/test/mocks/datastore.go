@@ -7,26 +7,42 @@ import (
 )
 
 type Store struct {
-	User    *UserRepository
-	Session *SessionRepository
-	Comment *CommentRepository
-	Like    *LikeRepository
-	Review  *ReviewRepository
-	Media   *MediaRepository
-	List    *ListRepository
+	User            *UserRepository
+	Session         *SessionRepository
+	Comment         *CommentRepository
+	Like            *LikeRepository
+	Review          *ReviewRepository
+	Media           *MediaRepository
+	List            *ListRepository
+	EpisodeProgress *EpisodeProgressRepository
+	DiaryEntry      *DiaryEntryRepository
+	WatchItem       *WatchItemRepository
+	Activity        *ActivityRepository
+	Notification    *NotificationRepository
+	FollowRequest   *FollowRequestRepository
+	ReviewLike      *ReviewLikeRepository
+	Revision        *RevisionRepository
 }
 
 var _ datastore.Store = (*Store)(nil)
 
 func NewStore() *Store {
 	return &Store{
-		User:    NewUserRepository(),
-		Session: NewSessionRepository(),
-		Comment: NewCommentRepository(),
-		Like:    NewLikeRepository(),
-		Review:  NewReviewRepository(),
-		Media:   NewMediaRepository(),
-		List:    NewListRepository(),
+		User:            NewUserRepository(),
+		Session:         NewSessionRepository(),
+		Comment:         NewCommentRepository(),
+		Like:            NewLikeRepository(),
+		Review:          NewReviewRepository(),
+		Media:           NewMediaRepository(),
+		List:            NewListRepository(),
+		EpisodeProgress: NewEpisodeProgressRepository(),
+		DiaryEntry:      NewDiaryEntryRepository(),
+		WatchItem:       NewWatchItemRepository(),
+		Activity:        NewActivityRepository(),
+		Notification:    NewNotificationRepository(),
+		FollowRequest:   NewFollowRequestRepository(),
+		ReviewLike:      NewReviewLikeRepository(),
+		Revision:        NewRevisionRepository(),
 	}
 }
 
@@ -37,6 +53,24 @@ func (s Store) Likes() repository.LikeRepository       { return s.Like }
 func (s Store) Reviews() repository.ReviewRepository   { return s.Review }
 func (s Store) Medias() repository.MediaRepository     { return s.Media }
 func (s Store) Lists() repository.ListRepository       { return s.List }
+func (s Store) EpisodeProgresses() repository.EpisodeProgressRepository {
+	return s.EpisodeProgress
+}
+func (s Store) DiaryEntries() repository.DiaryEntryRepository { return s.DiaryEntry }
+func (s Store) WatchItems() repository.WatchItemRepository    { return s.WatchItem }
+func (s Store) Activities() repository.ActivityRepository     { return s.Activity }
+func (s Store) Notifications() repository.NotificationRepository {
+	return s.Notification
+}
+func (s Store) FollowRequests() repository.FollowRequestRepository {
+	return s.FollowRequest
+}
+func (s Store) ReviewLikes() repository.ReviewLikeRepository {
+	return s.ReviewLike
+}
+func (s Store) Revisions() repository.RevisionRepository {
+	return s.Revision
+}
 
 type transaction struct {
 	store *Store
@@ -53,5 +87,23 @@ func (t transaction) Likes() repository.LikeRepository       { return t.store.Li
 func (t transaction) Reviews() repository.ReviewRepository   { return t.store.Review }
 func (t transaction) Medias() repository.MediaRepository     { return t.store.Media }
 func (t transaction) Lists() repository.ListRepository       { return t.store.List }
-func (t transaction) Commit() error                          { return nil }
-func (t transaction) Rollback() error                        { return nil }
+func (t transaction) EpisodeProgresses() repository.EpisodeProgressRepository {
+	return t.store.EpisodeProgress
+}
+func (t transaction) DiaryEntries() repository.DiaryEntryRepository { return t.store.DiaryEntry }
+func (t transaction) WatchItems() repository.WatchItemRepository    { return t.store.WatchItem }
+func (t transaction) Activities() repository.ActivityRepository     { return t.store.Activity }
+func (t transaction) Notifications() repository.NotificationRepository {
+	return t.store.Notification
+}
+func (t transaction) FollowRequests() repository.FollowRequestRepository {
+	return t.store.FollowRequest
+}
+func (t transaction) ReviewLikes() repository.ReviewLikeRepository {
+	return t.store.ReviewLike
+}
+func (t transaction) Revisions() repository.RevisionRepository {
+	return t.store.Revision
+}
+func (t transaction) Commit() error   { return nil }
+func (t transaction) Rollback() error { return nil }
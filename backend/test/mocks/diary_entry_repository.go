@@ -0,0 +1,105 @@
+package mocks
+
+import (
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ repository.DiaryEntryRepository = (*DiaryEntryRepository)(nil)
+
+type DiaryEntryRepository struct {
+	OneFn          func(ctx context.Context, filters ...*model.DiaryEntryF) (*model.DiaryEntry, error)
+	AllFn          func(ctx context.Context, filters ...*model.DiaryEntryF) ([]*model.DiaryEntry, error)
+	ExistsFn       func(ctx context.Context, filters ...*model.DiaryEntryF) (bool, error)
+	CountFn        func(ctx context.Context, filters ...*model.DiaryEntryF) (int, error)
+	InsertFn       func(ctx context.Context, entity *model.DiaryEntry) (*model.DiaryEntry, error)
+	InsertBulkFn   func(ctx context.Context, entities []*model.DiaryEntry) ([]*model.DiaryEntry, error)
+	UpdateFn       func(ctx context.Context, id uuid.UUID, updater *model.DiaryEntryU) (*model.DiaryEntry, error)
+	UpdateExecFn   func(ctx context.Context, updater *model.DiaryEntryU, filters ...*model.DiaryEntryF) (int, error)
+	DeleteFn       func(ctx context.Context, id uuid.UUID) error
+	DeleteExecFn   func(ctx context.Context, filters ...*model.DiaryEntryF) (int, error)
+	AllWithMediaFn func(ctx context.Context, filters ...*model.DiaryEntryF) ([]*model.DetailedDiaryEntry, error)
+}
+
+func NewDiaryEntryRepository() *DiaryEntryRepository {
+	return &DiaryEntryRepository{}
+}
+
+func (e *DiaryEntryRepository) One(ctx context.Context, filters ...*model.DiaryEntryF) (*model.DiaryEntry, error) {
+	if e.OneFn != nil {
+		return e.OneFn(ctx, filters...)
+	}
+	return &model.DiaryEntry{}, nil
+}
+
+func (e *DiaryEntryRepository) All(ctx context.Context, filters ...*model.DiaryEntryF) ([]*model.DiaryEntry, error) {
+	if e.AllFn != nil {
+		return e.AllFn(ctx, filters...)
+	}
+	return []*model.DiaryEntry{}, nil
+}
+
+func (e *DiaryEntryRepository) Exists(ctx context.Context, filters ...*model.DiaryEntryF) (bool, error) {
+	if e.ExistsFn != nil {
+		return e.ExistsFn(ctx, filters...)
+	}
+	return false, nil
+}
+
+func (e *DiaryEntryRepository) Count(ctx context.Context, filters ...*model.DiaryEntryF) (int, error) {
+	if e.CountFn != nil {
+		return e.CountFn(ctx, filters...)
+	}
+	return 0, nil
+}
+
+func (e *DiaryEntryRepository) Insert(ctx context.Context, entity *model.DiaryEntry) (*model.DiaryEntry, error) {
+	if e.InsertFn != nil {
+		return e.InsertFn(ctx, entity)
+	}
+	return &model.DiaryEntry{}, nil
+}
+
+func (e *DiaryEntryRepository) InsertBulk(ctx context.Context, entities []*model.DiaryEntry) ([]*model.DiaryEntry, error) {
+	if e.InsertBulkFn != nil {
+		return e.InsertBulkFn(ctx, entities)
+	}
+	return []*model.DiaryEntry{}, nil
+}
+
+func (e *DiaryEntryRepository) Update(ctx context.Context, id uuid.UUID, updater *model.DiaryEntryU) (*model.DiaryEntry, error) {
+	if e.UpdateFn != nil {
+		return e.UpdateFn(ctx, id, updater)
+	}
+	return &model.DiaryEntry{}, nil
+}
+
+func (e *DiaryEntryRepository) UpdateExec(ctx context.Context, updater *model.DiaryEntryU, filters ...*model.DiaryEntryF) (int, error) {
+	if e.UpdateExecFn != nil {
+		return e.UpdateExecFn(ctx, updater, filters...)
+	}
+	return 0, nil
+}
+
+func (e *DiaryEntryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if e.DeleteFn != nil {
+		return e.DeleteFn(ctx, id)
+	}
+	return nil
+}
+
+func (e *DiaryEntryRepository) DeleteExec(ctx context.Context, filters ...*model.DiaryEntryF) (int, error) {
+	if e.DeleteExecFn != nil {
+		return e.DeleteExecFn(ctx, filters...)
+	}
+	return 0, nil
+}
+
+func (e *DiaryEntryRepository) AllWithMedia(ctx context.Context, filters ...*model.DiaryEntryF) ([]*model.DetailedDiaryEntry, error) {
+	if e.AllWithMediaFn != nil {
+		return e.AllWithMediaFn(ctx, filters...)
+	}
+	return []*model.DetailedDiaryEntry{}, nil
+}
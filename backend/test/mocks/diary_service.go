@@ -0,0 +1,49 @@
+package mocks
+
+import (
+	"cine/entity/model"
+	"cine/service"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ service.DiaryService = (*DiaryServiceMock)(nil)
+
+type DiaryServiceMock struct {
+	CreateEntryFn func(ctx context.Context, input *service.CreateDiaryEntryInput) (*model.DiaryEntry, error)
+	UpdateEntryFn func(ctx context.Context, userID, entryID uuid.UUID, entryU *model.DiaryEntryU) (*model.DiaryEntry, error)
+	DeleteEntryFn func(ctx context.Context, userID, entryID uuid.UUID) error
+	GetDiaryFn    func(ctx context.Context, viewerID, userID uuid.UUID, year int, month *int) ([]*model.DetailedDiaryEntry, error)
+}
+
+func NewDiaryService() *DiaryServiceMock {
+	return &DiaryServiceMock{}
+}
+
+func (m *DiaryServiceMock) CreateEntry(ctx context.Context, input *service.CreateDiaryEntryInput) (*model.DiaryEntry, error) {
+	if m.CreateEntryFn != nil {
+		return m.CreateEntryFn(ctx, input)
+	}
+	return &model.DiaryEntry{}, nil
+}
+
+func (m *DiaryServiceMock) UpdateEntry(ctx context.Context, userID, entryID uuid.UUID, entryU *model.DiaryEntryU) (*model.DiaryEntry, error) {
+	if m.UpdateEntryFn != nil {
+		return m.UpdateEntryFn(ctx, userID, entryID, entryU)
+	}
+	return &model.DiaryEntry{}, nil
+}
+
+func (m *DiaryServiceMock) DeleteEntry(ctx context.Context, userID, entryID uuid.UUID) error {
+	if m.DeleteEntryFn != nil {
+		return m.DeleteEntryFn(ctx, userID, entryID)
+	}
+	return nil
+}
+
+func (m *DiaryServiceMock) GetDiary(ctx context.Context, viewerID, userID uuid.UUID, year int, month *int) ([]*model.DetailedDiaryEntry, error) {
+	if m.GetDiaryFn != nil {
+		return m.GetDiaryFn(ctx, viewerID, userID, year, month)
+	}
+	return []*model.DetailedDiaryEntry{}, nil
+}
@@ -0,0 +1,97 @@
+package mocks
+
+import (
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ repository.EpisodeProgressRepository = (*EpisodeProgressRepository)(nil)
+
+type EpisodeProgressRepository struct {
+	OneFn        func(ctx context.Context, filters ...*model.EpisodeProgressF) (*model.EpisodeProgress, error)
+	AllFn        func(ctx context.Context, filters ...*model.EpisodeProgressF) ([]*model.EpisodeProgress, error)
+	ExistsFn     func(ctx context.Context, filters ...*model.EpisodeProgressF) (bool, error)
+	CountFn      func(ctx context.Context, filters ...*model.EpisodeProgressF) (int, error)
+	InsertFn     func(ctx context.Context, entity *model.EpisodeProgress) (*model.EpisodeProgress, error)
+	InsertBulkFn func(ctx context.Context, entities []*model.EpisodeProgress) ([]*model.EpisodeProgress, error)
+	UpdateFn     func(ctx context.Context, id uuid.UUID, updater *model.EpisodeProgressU) (*model.EpisodeProgress, error)
+	UpdateExecFn func(ctx context.Context, updater *model.EpisodeProgressU, filters ...*model.EpisodeProgressF) (int, error)
+	DeleteFn     func(ctx context.Context, id uuid.UUID) error
+	DeleteExecFn func(ctx context.Context, filters ...*model.EpisodeProgressF) (int, error)
+}
+
+func NewEpisodeProgressRepository() *EpisodeProgressRepository {
+	return &EpisodeProgressRepository{}
+}
+
+func (e *EpisodeProgressRepository) One(ctx context.Context, filters ...*model.EpisodeProgressF) (*model.EpisodeProgress, error) {
+	if e.OneFn != nil {
+		return e.OneFn(ctx, filters...)
+	}
+	return &model.EpisodeProgress{}, nil
+}
+
+func (e *EpisodeProgressRepository) All(ctx context.Context, filters ...*model.EpisodeProgressF) ([]*model.EpisodeProgress, error) {
+	if e.AllFn != nil {
+		return e.AllFn(ctx, filters...)
+	}
+	return []*model.EpisodeProgress{}, nil
+}
+
+func (e *EpisodeProgressRepository) Exists(ctx context.Context, filters ...*model.EpisodeProgressF) (bool, error) {
+	if e.ExistsFn != nil {
+		return e.ExistsFn(ctx, filters...)
+	}
+	return false, nil
+}
+
+func (e *EpisodeProgressRepository) Count(ctx context.Context, filters ...*model.EpisodeProgressF) (int, error) {
+	if e.CountFn != nil {
+		return e.CountFn(ctx, filters...)
+	}
+	return 0, nil
+}
+
+func (e *EpisodeProgressRepository) Insert(ctx context.Context, entity *model.EpisodeProgress) (*model.EpisodeProgress, error) {
+	if e.InsertFn != nil {
+		return e.InsertFn(ctx, entity)
+	}
+	return &model.EpisodeProgress{}, nil
+}
+
+func (e *EpisodeProgressRepository) InsertBulk(ctx context.Context, entities []*model.EpisodeProgress) ([]*model.EpisodeProgress, error) {
+	if e.InsertBulkFn != nil {
+		return e.InsertBulkFn(ctx, entities)
+	}
+	return []*model.EpisodeProgress{}, nil
+}
+
+func (e *EpisodeProgressRepository) Update(ctx context.Context, id uuid.UUID, updater *model.EpisodeProgressU) (*model.EpisodeProgress, error) {
+	if e.UpdateFn != nil {
+		return e.UpdateFn(ctx, id, updater)
+	}
+	return &model.EpisodeProgress{}, nil
+}
+
+func (e *EpisodeProgressRepository) UpdateExec(ctx context.Context, updater *model.EpisodeProgressU, filters ...*model.EpisodeProgressF) (int, error) {
+	if e.UpdateExecFn != nil {
+		return e.UpdateExecFn(ctx, updater, filters...)
+	}
+	return 0, nil
+}
+
+func (e *EpisodeProgressRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if e.DeleteFn != nil {
+		return e.DeleteFn(ctx, id)
+	}
+	return nil
+}
+
+func (e *EpisodeProgressRepository) DeleteExec(ctx context.Context, filters ...*model.EpisodeProgressF) (int, error) {
+	if e.DeleteExecFn != nil {
+		return e.DeleteExecFn(ctx, filters...)
+	}
+	return 0, nil
+}
@@ -0,0 +1,105 @@
+package mocks
+
+import (
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ repository.FollowRequestRepository = (*FollowRequestRepository)(nil)
+
+type FollowRequestRepository struct {
+	OneFn         func(ctx context.Context, filters ...*model.FollowRequestF) (*model.FollowRequest, error)
+	AllFn         func(ctx context.Context, filters ...*model.FollowRequestF) ([]*model.FollowRequest, error)
+	ExistsFn      func(ctx context.Context, filters ...*model.FollowRequestF) (bool, error)
+	CountFn       func(ctx context.Context, filters ...*model.FollowRequestF) (int, error)
+	InsertFn      func(ctx context.Context, entity *model.FollowRequest) (*model.FollowRequest, error)
+	InsertBulkFn  func(ctx context.Context, entities []*model.FollowRequest) ([]*model.FollowRequest, error)
+	UpdateFn      func(ctx context.Context, id uuid.UUID, updater *model.FollowRequestU) (*model.FollowRequest, error)
+	UpdateExecFn  func(ctx context.Context, updater *model.FollowRequestU, filters ...*model.FollowRequestF) (int, error)
+	DeleteFn      func(ctx context.Context, id uuid.UUID) error
+	DeleteExecFn  func(ctx context.Context, filters ...*model.FollowRequestF) (int, error)
+	AllDetailedFn func(ctx context.Context, after *model.Cursor, limit int, filters ...*model.FollowRequestF) ([]*model.DetailedFollowRequest, error)
+}
+
+func NewFollowRequestRepository() *FollowRequestRepository {
+	return &FollowRequestRepository{}
+}
+
+func (e *FollowRequestRepository) One(ctx context.Context, filters ...*model.FollowRequestF) (*model.FollowRequest, error) {
+	if e.OneFn != nil {
+		return e.OneFn(ctx, filters...)
+	}
+	return &model.FollowRequest{}, nil
+}
+
+func (e *FollowRequestRepository) All(ctx context.Context, filters ...*model.FollowRequestF) ([]*model.FollowRequest, error) {
+	if e.AllFn != nil {
+		return e.AllFn(ctx, filters...)
+	}
+	return []*model.FollowRequest{}, nil
+}
+
+func (e *FollowRequestRepository) Exists(ctx context.Context, filters ...*model.FollowRequestF) (bool, error) {
+	if e.ExistsFn != nil {
+		return e.ExistsFn(ctx, filters...)
+	}
+	return false, nil
+}
+
+func (e *FollowRequestRepository) Count(ctx context.Context, filters ...*model.FollowRequestF) (int, error) {
+	if e.CountFn != nil {
+		return e.CountFn(ctx, filters...)
+	}
+	return 0, nil
+}
+
+func (e *FollowRequestRepository) Insert(ctx context.Context, entity *model.FollowRequest) (*model.FollowRequest, error) {
+	if e.InsertFn != nil {
+		return e.InsertFn(ctx, entity)
+	}
+	return &model.FollowRequest{}, nil
+}
+
+func (e *FollowRequestRepository) InsertBulk(ctx context.Context, entities []*model.FollowRequest) ([]*model.FollowRequest, error) {
+	if e.InsertBulkFn != nil {
+		return e.InsertBulkFn(ctx, entities)
+	}
+	return []*model.FollowRequest{}, nil
+}
+
+func (e *FollowRequestRepository) Update(ctx context.Context, id uuid.UUID, updater *model.FollowRequestU) (*model.FollowRequest, error) {
+	if e.UpdateFn != nil {
+		return e.UpdateFn(ctx, id, updater)
+	}
+	return &model.FollowRequest{}, nil
+}
+
+func (e *FollowRequestRepository) UpdateExec(ctx context.Context, updater *model.FollowRequestU, filters ...*model.FollowRequestF) (int, error) {
+	if e.UpdateExecFn != nil {
+		return e.UpdateExecFn(ctx, updater, filters...)
+	}
+	return 0, nil
+}
+
+func (e *FollowRequestRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if e.DeleteFn != nil {
+		return e.DeleteFn(ctx, id)
+	}
+	return nil
+}
+
+func (e *FollowRequestRepository) DeleteExec(ctx context.Context, filters ...*model.FollowRequestF) (int, error) {
+	if e.DeleteExecFn != nil {
+		return e.DeleteExecFn(ctx, filters...)
+	}
+	return 0, nil
+}
+
+func (e *FollowRequestRepository) AllDetailed(ctx context.Context, after *model.Cursor, limit int, filters ...*model.FollowRequestF) ([]*model.DetailedFollowRequest, error) {
+	if e.AllDetailedFn != nil {
+		return e.AllDetailedFn(ctx, after, limit, filters...)
+	}
+	return []*model.DetailedFollowRequest{}, nil
+}
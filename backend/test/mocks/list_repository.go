@@ -28,6 +28,8 @@ type ListRepository struct {
 	AddMediaFn     func(ctx context.Context, list *model.List, mediaID uuid.UUID) error
 	RemoveMediaFn  func(ctx context.Context, list *model.List, mediaID uuid.UUID) error
 	AllMediaFn     func(ctx context.Context, list *model.List) ([]*model.Media, error)
+	SearchPublicFn func(ctx context.Context, viewerID uuid.UUID, query string, limit int) ([]*model.List, error)
+	HasMediaFn     func(ctx context.Context, userID uuid.UUID, mediaType model.MediaType, refs []int) (map[int]bool, error)
 }
 
 func NewListRepository() *ListRepository {
@@ -159,3 +161,17 @@ func (l *ListRepository) AllMedia(ctx context.Context, list *model.List) ([]*mod
 	}
 	return []*model.Media{}, nil
 }
+
+func (l *ListRepository) HasMedia(ctx context.Context, userID uuid.UUID, mediaType model.MediaType, refs []int) (map[int]bool, error) {
+	if l.HasMediaFn != nil {
+		return l.HasMediaFn(ctx, userID, mediaType, refs)
+	}
+	return map[int]bool{}, nil
+}
+
+func (l *ListRepository) SearchPublic(ctx context.Context, viewerID uuid.UUID, query string, limit int) ([]*model.List, error) {
+	if l.SearchPublicFn != nil {
+		return l.SearchPublicFn(ctx, viewerID, query, limit)
+	}
+	return []*model.List{}, nil
+}
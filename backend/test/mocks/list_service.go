@@ -15,9 +15,9 @@ type ListServiceMock struct {
 	UpdateListFn           func(ctx context.Context, ownerID uuid.UUID, id uuid.UUID, listU *model.ListU) (*model.List, error)
 	AddMemberToListFn      func(ctx context.Context, ownerID uuid.UUID, listID uuid.UUID, userID uuid.UUID) error
 	RemoveMemberFromListFn func(ctx context.Context, ownerID uuid.UUID, listID uuid.UUID, userID uuid.UUID) error
-	GetAllListsFn          func(ctx context.Context, memberID uuid.UUID) ([]*model.DetailedList, error)
-	GetPublicListsFn       func(ctx context.Context, userID uuid.UUID) ([]*model.DetailedList, error)
-	GetDetailedListFn      func(ctx context.Context, memberID uuid.UUID, id uuid.UUID) (*model.DetailedList, error)
+	GetAllListsFn          func(ctx context.Context, memberID uuid.UUID, streamable bool) ([]*model.DetailedList, error)
+	GetPublicListsFn       func(ctx context.Context, viewerID uuid.UUID, userID uuid.UUID) ([]*model.DetailedList, error)
+	GetDetailedListFn      func(ctx context.Context, memberID uuid.UUID, id uuid.UUID, streamable bool) (*model.DetailedList, error)
 	AddMovieToListFn       func(ctx context.Context, memberID uuid.UUID, listID uuid.UUID, ref int) error
 	RemoveMovieFromListFn  func(ctx context.Context, memberID uuid.UUID, listID uuid.UUID, ref int) error
 	AddShowToListFn        func(ctx context.Context, memberID uuid.UUID, listID uuid.UUID, ref int) error
@@ -63,23 +63,23 @@ func (m *ListServiceMock) RemoveMemberFromList(ctx context.Context, ownerID uuid
 	return nil
 }
 
-func (m *ListServiceMock) GetAllLists(ctx context.Context, memberID uuid.UUID) ([]*model.DetailedList, error) {
+func (m *ListServiceMock) GetAllLists(ctx context.Context, memberID uuid.UUID, streamable bool) ([]*model.DetailedList, error) {
 	if m.GetAllListsFn != nil {
-		return m.GetAllListsFn(ctx, memberID)
+		return m.GetAllListsFn(ctx, memberID, streamable)
 	}
 	return []*model.DetailedList{}, nil
 }
 
-func (m *ListServiceMock) GetPublicLists(ctx context.Context, userID uuid.UUID) ([]*model.DetailedList, error) {
+func (m *ListServiceMock) GetPublicLists(ctx context.Context, viewerID uuid.UUID, userID uuid.UUID) ([]*model.DetailedList, error) {
 	if m.GetPublicListsFn != nil {
-		return m.GetPublicListsFn(ctx, userID)
+		return m.GetPublicListsFn(ctx, viewerID, userID)
 	}
 	return []*model.DetailedList{}, nil
 }
 
-func (m *ListServiceMock) GetDetailedList(ctx context.Context, memberID uuid.UUID, id uuid.UUID) (*model.DetailedList, error) {
+func (m *ListServiceMock) GetDetailedList(ctx context.Context, memberID uuid.UUID, id uuid.UUID, streamable bool) (*model.DetailedList, error) {
 	if m.GetDetailedListFn != nil {
-		return m.GetDetailedListFn(ctx, memberID, id)
+		return m.GetDetailedListFn(ctx, memberID, id, streamable)
 	}
 	return &model.DetailedList{}, nil
 }
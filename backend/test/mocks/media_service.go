@@ -11,18 +11,30 @@ import (
 var _ service.MediaService = (*MediaServiceMock)(nil)
 
 type MediaServiceMock struct {
-	CreateMediaFn           func(ctx context.Context, ref int, mediaType model.MediaType) (*model.Media, error)
-	GetMediaFn              func(ctx context.Context, ref int, mediaType model.MediaType) (*model.Media, error)
-	GetMediaByIDFn          func(ctx context.Context, id uuid.UUID) (*model.Media, error)
-	GetDetailedMovieFn      func(ctx context.Context, ref int) (*tmdb.DetailedMovie, error)
-	GetDetailedShowFn       func(ctx context.Context, ref int) (*tmdb.DetailedShow, error)
-	GetMovieCreditsFn       func(ctx context.Context, ref int) (*tmdb.MovieCredits, error)
-	GetShowCreditsFn        func(ctx context.Context, ref int) (*tmdb.ShowCredits, error)
-	GetShowDetailedSeasonFn func(ctx context.Context, ref int, seasonNumber int) (*tmdb.DetailedSeason, error)
-	GetMovieListFn          func(ctx context.Context, list tmdb.MovieList) ([]tmdb.Movie, error)
-	GetShowListFn           func(ctx context.Context, list tmdb.ShowList) ([]tmdb.Show, error)
-	SearchMoviesFn          func(ctx context.Context, query string, page int) ([]tmdb.Movie, error)
-	SearchShowsFn           func(ctx context.Context, query string, page int) ([]tmdb.Show, error)
+	CreateMediaFn             func(ctx context.Context, ref int, mediaType model.MediaType) (*model.Media, error)
+	GetMediaFn                func(ctx context.Context, ref int, mediaType model.MediaType) (*model.Media, error)
+	GetMediaByIDFn            func(ctx context.Context, id uuid.UUID) (*model.Media, error)
+	GetDetailedMovieFn        func(ctx context.Context, userID uuid.UUID, ref int, appends ...tmdb.Append) (*service.DetailedMovie, error)
+	GetMovieVideosFn          func(ctx context.Context, ref int) (*tmdb.Videos, error)
+	GetMovieImagesFn          func(ctx context.Context, ref int) (*tmdb.Images, error)
+	GetDetailedShowFn         func(ctx context.Context, userID uuid.UUID, ref int, appends ...tmdb.Append) (*service.DetailedShow, error)
+	GetShowVideosFn           func(ctx context.Context, ref int) (*tmdb.Videos, error)
+	GetShowImagesFn           func(ctx context.Context, ref int) (*tmdb.Images, error)
+	GetMovieCreditsFn         func(ctx context.Context, ref int) (*tmdb.MovieCredits, error)
+	GetShowCreditsFn          func(ctx context.Context, ref int) (*tmdb.ShowCredits, error)
+	GetShowDetailedSeasonFn   func(ctx context.Context, ref int, seasonNumber int) (*tmdb.DetailedSeason, error)
+	GetMovieListFn            func(ctx context.Context, list tmdb.MovieList, page int) (*tmdb.Page[tmdb.Movie], error)
+	GetShowListFn             func(ctx context.Context, list tmdb.ShowList, page int) (*tmdb.Page[tmdb.Show], error)
+	SearchMoviesFn            func(ctx context.Context, query string, page int) (*tmdb.Page[tmdb.Movie], error)
+	SearchShowsFn             func(ctx context.Context, query string, page int) (*tmdb.Page[tmdb.Show], error)
+	DiscoverFn                func(ctx context.Context, mediaType model.MediaType, filter tmdb.DiscoverFilter) (*service.DiscoverOutput, error)
+	GetTrendingFn             func(ctx context.Context, trendingType tmdb.TrendingType, window tmdb.TrendingWindow, page int) (*tmdb.Page[tmdb.MultiResult], error)
+	GetMovieRecommendationsFn func(ctx context.Context, userID uuid.UUID, ref int, page int) (*service.Recommendations[tmdb.Movie], error)
+	GetShowRecommendationsFn  func(ctx context.Context, userID uuid.UUID, ref int, page int) (*service.Recommendations[tmdb.Show], error)
+	GetPersonFn               func(ctx context.Context, ref int) (*tmdb.DetailedPerson, error)
+	GetPersonFilmographyFn    func(ctx context.Context, ref int) ([]service.FilmographyCredit, error)
+	SearchPeopleFn            func(ctx context.Context, query string, page int) (*tmdb.Page[tmdb.Person], error)
+	FilterStreamableFn        func(ctx context.Context, userID uuid.UUID, medias []*model.Media) ([]*model.Media, error)
 }
 
 func NewMediaService() *MediaServiceMock {
@@ -50,18 +62,46 @@ func (m *MediaServiceMock) GetMediaByID(ctx context.Context, id uuid.UUID) (*mod
 	return &model.Media{}, nil
 }
 
-func (m *MediaServiceMock) GetDetailedMovie(ctx context.Context, ref int) (*tmdb.DetailedMovie, error) {
+func (m *MediaServiceMock) GetDetailedMovie(ctx context.Context, userID uuid.UUID, ref int, appends ...tmdb.Append) (*service.DetailedMovie, error) {
 	if m.GetDetailedMovieFn != nil {
-		return m.GetDetailedMovieFn(ctx, ref)
+		return m.GetDetailedMovieFn(ctx, userID, ref, appends...)
 	}
-	return &tmdb.DetailedMovie{}, nil
+	return &service.DetailedMovie{MovieBundle: &tmdb.MovieBundle{DetailedMovie: &tmdb.DetailedMovie{}}}, nil
 }
 
-func (m *MediaServiceMock) GetDetailedShow(ctx context.Context, ref int) (*tmdb.DetailedShow, error) {
+func (m *MediaServiceMock) GetMovieVideos(ctx context.Context, ref int) (*tmdb.Videos, error) {
+	if m.GetMovieVideosFn != nil {
+		return m.GetMovieVideosFn(ctx, ref)
+	}
+	return &tmdb.Videos{}, nil
+}
+
+func (m *MediaServiceMock) GetMovieImages(ctx context.Context, ref int) (*tmdb.Images, error) {
+	if m.GetMovieImagesFn != nil {
+		return m.GetMovieImagesFn(ctx, ref)
+	}
+	return &tmdb.Images{}, nil
+}
+
+func (m *MediaServiceMock) GetDetailedShow(ctx context.Context, userID uuid.UUID, ref int, appends ...tmdb.Append) (*service.DetailedShow, error) {
 	if m.GetDetailedShowFn != nil {
-		return m.GetDetailedShowFn(ctx, ref)
+		return m.GetDetailedShowFn(ctx, userID, ref, appends...)
+	}
+	return &service.DetailedShow{ShowBundle: &tmdb.ShowBundle{DetailedShow: &tmdb.DetailedShow{}}}, nil
+}
+
+func (m *MediaServiceMock) GetShowVideos(ctx context.Context, ref int) (*tmdb.Videos, error) {
+	if m.GetShowVideosFn != nil {
+		return m.GetShowVideosFn(ctx, ref)
 	}
-	return &tmdb.DetailedShow{}, nil
+	return &tmdb.Videos{}, nil
+}
+
+func (m *MediaServiceMock) GetShowImages(ctx context.Context, ref int) (*tmdb.Images, error) {
+	if m.GetShowImagesFn != nil {
+		return m.GetShowImagesFn(ctx, ref)
+	}
+	return &tmdb.Images{}, nil
 }
 
 func (m *MediaServiceMock) GetMovieCredits(ctx context.Context, ref int) (*tmdb.MovieCredits, error) {
@@ -85,30 +125,86 @@ func (m *MediaServiceMock) GetShowDetailedSeason(ctx context.Context, ref int, s
 	return &tmdb.DetailedSeason{}, nil
 }
 
-func (m *MediaServiceMock) GetMovieList(ctx context.Context, list tmdb.MovieList) ([]tmdb.Movie, error) {
+func (m *MediaServiceMock) GetMovieList(ctx context.Context, list tmdb.MovieList, page int) (*tmdb.Page[tmdb.Movie], error) {
 	if m.GetMovieListFn != nil {
-		return m.GetMovieListFn(ctx, list)
+		return m.GetMovieListFn(ctx, list, page)
 	}
-	return []tmdb.Movie{}, nil
+	return &tmdb.Page[tmdb.Movie]{}, nil
 }
 
-func (m *MediaServiceMock) GetShowList(ctx context.Context, list tmdb.ShowList) ([]tmdb.Show, error) {
+func (m *MediaServiceMock) GetShowList(ctx context.Context, list tmdb.ShowList, page int) (*tmdb.Page[tmdb.Show], error) {
 	if m.GetShowListFn != nil {
-		return m.GetShowListFn(ctx, list)
+		return m.GetShowListFn(ctx, list, page)
 	}
-	return []tmdb.Show{}, nil
+	return &tmdb.Page[tmdb.Show]{}, nil
 }
 
-func (m *MediaServiceMock) SearchMovies(ctx context.Context, query string, page int) ([]tmdb.Movie, error) {
+func (m *MediaServiceMock) SearchMovies(ctx context.Context, query string, page int) (*tmdb.Page[tmdb.Movie], error) {
 	if m.SearchMoviesFn != nil {
 		return m.SearchMoviesFn(ctx, query, page)
 	}
-	return []tmdb.Movie{}, nil
+	return &tmdb.Page[tmdb.Movie]{}, nil
 }
 
-func (m *MediaServiceMock) SearchShows(ctx context.Context, query string, page int) ([]tmdb.Show, error) {
+func (m *MediaServiceMock) SearchShows(ctx context.Context, query string, page int) (*tmdb.Page[tmdb.Show], error) {
 	if m.SearchShowsFn != nil {
 		return m.SearchShowsFn(ctx, query, page)
 	}
-	return []tmdb.Show{}, nil
+	return &tmdb.Page[tmdb.Show]{}, nil
+}
+
+func (m *MediaServiceMock) Discover(ctx context.Context, mediaType model.MediaType, filter tmdb.DiscoverFilter) (*service.DiscoverOutput, error) {
+	if m.DiscoverFn != nil {
+		return m.DiscoverFn(ctx, mediaType, filter)
+	}
+	return &service.DiscoverOutput{}, nil
+}
+
+func (m *MediaServiceMock) GetPerson(ctx context.Context, ref int) (*tmdb.DetailedPerson, error) {
+	if m.GetPersonFn != nil {
+		return m.GetPersonFn(ctx, ref)
+	}
+	return &tmdb.DetailedPerson{}, nil
+}
+
+func (m *MediaServiceMock) GetPersonFilmography(ctx context.Context, ref int) ([]service.FilmographyCredit, error) {
+	if m.GetPersonFilmographyFn != nil {
+		return m.GetPersonFilmographyFn(ctx, ref)
+	}
+	return []service.FilmographyCredit{}, nil
+}
+
+func (m *MediaServiceMock) SearchPeople(ctx context.Context, query string, page int) (*tmdb.Page[tmdb.Person], error) {
+	if m.SearchPeopleFn != nil {
+		return m.SearchPeopleFn(ctx, query, page)
+	}
+	return &tmdb.Page[tmdb.Person]{}, nil
+}
+
+func (m *MediaServiceMock) GetMovieRecommendations(ctx context.Context, userID uuid.UUID, ref int, page int) (*service.Recommendations[tmdb.Movie], error) {
+	if m.GetMovieRecommendationsFn != nil {
+		return m.GetMovieRecommendationsFn(ctx, userID, ref, page)
+	}
+	return &service.Recommendations[tmdb.Movie]{}, nil
+}
+
+func (m *MediaServiceMock) GetShowRecommendations(ctx context.Context, userID uuid.UUID, ref int, page int) (*service.Recommendations[tmdb.Show], error) {
+	if m.GetShowRecommendationsFn != nil {
+		return m.GetShowRecommendationsFn(ctx, userID, ref, page)
+	}
+	return &service.Recommendations[tmdb.Show]{}, nil
+}
+
+func (m *MediaServiceMock) GetTrending(ctx context.Context, trendingType tmdb.TrendingType, window tmdb.TrendingWindow, page int) (*tmdb.Page[tmdb.MultiResult], error) {
+	if m.GetTrendingFn != nil {
+		return m.GetTrendingFn(ctx, trendingType, window, page)
+	}
+	return &tmdb.Page[tmdb.MultiResult]{}, nil
+}
+
+func (m *MediaServiceMock) FilterStreamable(ctx context.Context, userID uuid.UUID, medias []*model.Media) ([]*model.Media, error) {
+	if m.FilterStreamableFn != nil {
+		return m.FilterStreamableFn(ctx, userID, medias)
+	}
+	return medias, nil
 }
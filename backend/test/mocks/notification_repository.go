@@ -0,0 +1,105 @@
+package mocks
+
+import (
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ repository.NotificationRepository = (*NotificationRepository)(nil)
+
+type NotificationRepository struct {
+	OneFn         func(ctx context.Context, filters ...*model.NotificationF) (*model.Notification, error)
+	AllFn         func(ctx context.Context, filters ...*model.NotificationF) ([]*model.Notification, error)
+	ExistsFn      func(ctx context.Context, filters ...*model.NotificationF) (bool, error)
+	CountFn       func(ctx context.Context, filters ...*model.NotificationF) (int, error)
+	InsertFn      func(ctx context.Context, entity *model.Notification) (*model.Notification, error)
+	InsertBulkFn  func(ctx context.Context, entities []*model.Notification) ([]*model.Notification, error)
+	UpdateFn      func(ctx context.Context, id uuid.UUID, updater *model.NotificationU) (*model.Notification, error)
+	UpdateExecFn  func(ctx context.Context, updater *model.NotificationU, filters ...*model.NotificationF) (int, error)
+	DeleteFn      func(ctx context.Context, id uuid.UUID) error
+	DeleteExecFn  func(ctx context.Context, filters ...*model.NotificationF) (int, error)
+	AllDetailedFn func(ctx context.Context, after *model.Cursor, limit int, filters ...*model.NotificationF) ([]*model.DetailedNotification, error)
+}
+
+func NewNotificationRepository() *NotificationRepository {
+	return &NotificationRepository{}
+}
+
+func (e *NotificationRepository) One(ctx context.Context, filters ...*model.NotificationF) (*model.Notification, error) {
+	if e.OneFn != nil {
+		return e.OneFn(ctx, filters...)
+	}
+	return &model.Notification{}, nil
+}
+
+func (e *NotificationRepository) All(ctx context.Context, filters ...*model.NotificationF) ([]*model.Notification, error) {
+	if e.AllFn != nil {
+		return e.AllFn(ctx, filters...)
+	}
+	return []*model.Notification{}, nil
+}
+
+func (e *NotificationRepository) Exists(ctx context.Context, filters ...*model.NotificationF) (bool, error) {
+	if e.ExistsFn != nil {
+		return e.ExistsFn(ctx, filters...)
+	}
+	return false, nil
+}
+
+func (e *NotificationRepository) Count(ctx context.Context, filters ...*model.NotificationF) (int, error) {
+	if e.CountFn != nil {
+		return e.CountFn(ctx, filters...)
+	}
+	return 0, nil
+}
+
+func (e *NotificationRepository) Insert(ctx context.Context, entity *model.Notification) (*model.Notification, error) {
+	if e.InsertFn != nil {
+		return e.InsertFn(ctx, entity)
+	}
+	return &model.Notification{}, nil
+}
+
+func (e *NotificationRepository) InsertBulk(ctx context.Context, entities []*model.Notification) ([]*model.Notification, error) {
+	if e.InsertBulkFn != nil {
+		return e.InsertBulkFn(ctx, entities)
+	}
+	return []*model.Notification{}, nil
+}
+
+func (e *NotificationRepository) Update(ctx context.Context, id uuid.UUID, updater *model.NotificationU) (*model.Notification, error) {
+	if e.UpdateFn != nil {
+		return e.UpdateFn(ctx, id, updater)
+	}
+	return &model.Notification{}, nil
+}
+
+func (e *NotificationRepository) UpdateExec(ctx context.Context, updater *model.NotificationU, filters ...*model.NotificationF) (int, error) {
+	if e.UpdateExecFn != nil {
+		return e.UpdateExecFn(ctx, updater, filters...)
+	}
+	return 0, nil
+}
+
+func (e *NotificationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if e.DeleteFn != nil {
+		return e.DeleteFn(ctx, id)
+	}
+	return nil
+}
+
+func (e *NotificationRepository) DeleteExec(ctx context.Context, filters ...*model.NotificationF) (int, error) {
+	if e.DeleteExecFn != nil {
+		return e.DeleteExecFn(ctx, filters...)
+	}
+	return 0, nil
+}
+
+func (e *NotificationRepository) AllDetailed(ctx context.Context, after *model.Cursor, limit int, filters ...*model.NotificationF) ([]*model.DetailedNotification, error) {
+	if e.AllDetailedFn != nil {
+		return e.AllDetailedFn(ctx, after, limit, filters...)
+	}
+	return []*model.DetailedNotification{}, nil
+}
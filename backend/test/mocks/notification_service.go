@@ -0,0 +1,64 @@
+package mocks
+
+import (
+	"cine/entity/model"
+	"cine/service"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ service.NotificationService = (*NotificationServiceMock)(nil)
+
+type NotificationServiceMock struct {
+	NotifyFn           func(ctx context.Context, notification *model.Notification)
+	GetNotificationsFn func(ctx context.Context, userID uuid.UUID, cursor string, limit int, unreadOnly bool) (*model.Page[*model.DetailedNotification], error)
+	CountUnreadFn      func(ctx context.Context, userID uuid.UUID) (int, error)
+	MarkReadFn         func(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) (int, error)
+	GetMutedFn         func(ctx context.Context, userID uuid.UUID) ([]model.NotificationKind, error)
+	SetMutedFn         func(ctx context.Context, userID uuid.UUID, kinds []model.NotificationKind) ([]model.NotificationKind, error)
+}
+
+func NewNotificationService() *NotificationServiceMock {
+	return &NotificationServiceMock{}
+}
+
+func (m *NotificationServiceMock) Notify(ctx context.Context, notification *model.Notification) {
+	if m.NotifyFn != nil {
+		m.NotifyFn(ctx, notification)
+	}
+}
+
+func (m *NotificationServiceMock) GetNotifications(ctx context.Context, userID uuid.UUID, cursor string, limit int, unreadOnly bool) (*model.Page[*model.DetailedNotification], error) {
+	if m.GetNotificationsFn != nil {
+		return m.GetNotificationsFn(ctx, userID, cursor, limit, unreadOnly)
+	}
+	return &model.Page[*model.DetailedNotification]{Items: []*model.DetailedNotification{}}, nil
+}
+
+func (m *NotificationServiceMock) CountUnread(ctx context.Context, userID uuid.UUID) (int, error) {
+	if m.CountUnreadFn != nil {
+		return m.CountUnreadFn(ctx, userID)
+	}
+	return 0, nil
+}
+
+func (m *NotificationServiceMock) MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) (int, error) {
+	if m.MarkReadFn != nil {
+		return m.MarkReadFn(ctx, userID, ids)
+	}
+	return 0, nil
+}
+
+func (m *NotificationServiceMock) GetMuted(ctx context.Context, userID uuid.UUID) ([]model.NotificationKind, error) {
+	if m.GetMutedFn != nil {
+		return m.GetMutedFn(ctx, userID)
+	}
+	return []model.NotificationKind{}, nil
+}
+
+func (m *NotificationServiceMock) SetMuted(ctx context.Context, userID uuid.UUID, kinds []model.NotificationKind) ([]model.NotificationKind, error) {
+	if m.SetMutedFn != nil {
+		return m.SetMutedFn(ctx, userID, kinds)
+	}
+	return kinds, nil
+}
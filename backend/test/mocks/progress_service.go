@@ -0,0 +1,72 @@
+package mocks
+
+import (
+	"cine/service"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ service.ProgressService = (*ProgressServiceMock)(nil)
+
+type ProgressServiceMock struct {
+	GetShowProgressFn func(ctx context.Context, userID uuid.UUID, ref int) (*service.ShowProgress, error)
+	MarkEpisodeFn     func(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int, episodeNumber int) error
+	UnmarkEpisodeFn   func(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int, episodeNumber int) error
+	MarkSeasonFn      func(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int) error
+	UnmarkSeasonFn    func(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int) error
+	MarkShowFn        func(ctx context.Context, userID uuid.UUID, ref int) error
+	UnmarkShowFn      func(ctx context.Context, userID uuid.UUID, ref int) error
+}
+
+func NewProgressService() *ProgressServiceMock {
+	return &ProgressServiceMock{}
+}
+
+func (m *ProgressServiceMock) GetShowProgress(ctx context.Context, userID uuid.UUID, ref int) (*service.ShowProgress, error) {
+	if m.GetShowProgressFn != nil {
+		return m.GetShowProgressFn(ctx, userID, ref)
+	}
+	return &service.ShowProgress{}, nil
+}
+
+func (m *ProgressServiceMock) MarkEpisode(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int, episodeNumber int) error {
+	if m.MarkEpisodeFn != nil {
+		return m.MarkEpisodeFn(ctx, userID, ref, seasonNumber, episodeNumber)
+	}
+	return nil
+}
+
+func (m *ProgressServiceMock) UnmarkEpisode(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int, episodeNumber int) error {
+	if m.UnmarkEpisodeFn != nil {
+		return m.UnmarkEpisodeFn(ctx, userID, ref, seasonNumber, episodeNumber)
+	}
+	return nil
+}
+
+func (m *ProgressServiceMock) MarkSeason(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int) error {
+	if m.MarkSeasonFn != nil {
+		return m.MarkSeasonFn(ctx, userID, ref, seasonNumber)
+	}
+	return nil
+}
+
+func (m *ProgressServiceMock) UnmarkSeason(ctx context.Context, userID uuid.UUID, ref int, seasonNumber int) error {
+	if m.UnmarkSeasonFn != nil {
+		return m.UnmarkSeasonFn(ctx, userID, ref, seasonNumber)
+	}
+	return nil
+}
+
+func (m *ProgressServiceMock) MarkShow(ctx context.Context, userID uuid.UUID, ref int) error {
+	if m.MarkShowFn != nil {
+		return m.MarkShowFn(ctx, userID, ref)
+	}
+	return nil
+}
+
+func (m *ProgressServiceMock) UnmarkShow(ctx context.Context, userID uuid.UUID, ref int) error {
+	if m.UnmarkShowFn != nil {
+		return m.UnmarkShowFn(ctx, userID, ref)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package mocks
+
+import (
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ repository.ReviewLikeRepository = (*ReviewLikeRepository)(nil)
+
+type ReviewLikeRepository struct {
+	OneFn        func(ctx context.Context, filters ...*model.ReviewLikeF) (*model.ReviewLike, error)
+	AllFn        func(ctx context.Context, filters ...*model.ReviewLikeF) ([]*model.ReviewLike, error)
+	ExistsFn     func(ctx context.Context, filters ...*model.ReviewLikeF) (bool, error)
+	CountFn      func(ctx context.Context, filters ...*model.ReviewLikeF) (int, error)
+	InsertFn     func(ctx context.Context, entity *model.ReviewLike) (*model.ReviewLike, error)
+	InsertBulkFn func(ctx context.Context, entities []*model.ReviewLike) ([]*model.ReviewLike, error)
+	UpdateFn     func(ctx context.Context, id uuid.UUID, updater *model.ReviewLikeU) (*model.ReviewLike, error)
+	UpdateExecFn func(ctx context.Context, updater *model.ReviewLikeU, filters ...*model.ReviewLikeF) (int, error)
+	DeleteFn     func(ctx context.Context, id uuid.UUID) error
+	DeleteExecFn func(ctx context.Context, filters ...*model.ReviewLikeF) (int, error)
+}
+
+func NewReviewLikeRepository() *ReviewLikeRepository {
+	return &ReviewLikeRepository{}
+}
+
+func (l *ReviewLikeRepository) One(ctx context.Context, filters ...*model.ReviewLikeF) (*model.ReviewLike, error) {
+	if l.OneFn != nil {
+		return l.OneFn(ctx, filters...)
+	}
+	return &model.ReviewLike{}, nil
+}
+
+func (l *ReviewLikeRepository) All(ctx context.Context, filters ...*model.ReviewLikeF) ([]*model.ReviewLike, error) {
+	if l.AllFn != nil {
+		return l.AllFn(ctx, filters...)
+	}
+	return []*model.ReviewLike{}, nil
+}
+
+func (l *ReviewLikeRepository) Exists(ctx context.Context, filters ...*model.ReviewLikeF) (bool, error) {
+	if l.ExistsFn != nil {
+		return l.ExistsFn(ctx, filters...)
+	}
+	return false, nil
+}
+
+func (l *ReviewLikeRepository) Count(ctx context.Context, filters ...*model.ReviewLikeF) (int, error) {
+	if l.CountFn != nil {
+		return l.CountFn(ctx, filters...)
+	}
+	return 0, nil
+}
+
+func (l *ReviewLikeRepository) Insert(ctx context.Context, entity *model.ReviewLike) (*model.ReviewLike, error) {
+	if l.InsertFn != nil {
+		return l.InsertFn(ctx, entity)
+	}
+	return &model.ReviewLike{}, nil
+}
+
+func (l *ReviewLikeRepository) InsertBulk(ctx context.Context, entities []*model.ReviewLike) ([]*model.ReviewLike, error) {
+	if l.InsertBulkFn != nil {
+		return l.InsertBulkFn(ctx, entities)
+	}
+	return []*model.ReviewLike{}, nil
+}
+
+func (l *ReviewLikeRepository) Update(ctx context.Context, id uuid.UUID, updater *model.ReviewLikeU) (*model.ReviewLike, error) {
+	if l.UpdateFn != nil {
+		return l.UpdateFn(ctx, id, updater)
+	}
+	return &model.ReviewLike{}, nil
+}
+
+func (l *ReviewLikeRepository) UpdateExec(ctx context.Context, updater *model.ReviewLikeU, filters ...*model.ReviewLikeF) (int, error) {
+	if l.UpdateExecFn != nil {
+		return l.UpdateExecFn(ctx, updater, filters...)
+	}
+	return 0, nil
+}
+
+func (l *ReviewLikeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if l.DeleteFn != nil {
+		return l.DeleteFn(ctx, id)
+	}
+	return nil
+}
+
+func (l *ReviewLikeRepository) DeleteExec(ctx context.Context, filters ...*model.ReviewLikeF) (int, error) {
+	if l.DeleteExecFn != nil {
+		return l.DeleteExecFn(ctx, filters...)
+	}
+	return 0, nil
+}
@@ -10,17 +10,19 @@ import (
 var _ repository.ReviewRepository = (*ReviewRepository)(nil)
 
 type ReviewRepository struct {
-	OneFn         func(ctx context.Context, filters ...*model.ReviewF) (*model.Review, error)
-	AllFn         func(ctx context.Context, filters ...*model.ReviewF) ([]*model.Review, error)
-	ExistsFn      func(ctx context.Context, filters ...*model.ReviewF) (bool, error)
-	CountFn       func(ctx context.Context, filters ...*model.ReviewF) (int, error)
-	InsertFn      func(ctx context.Context, entity *model.Review) (*model.Review, error)
-	InsertBulkFn  func(ctx context.Context, entities []*model.Review) ([]*model.Review, error)
-	UpdateFn      func(ctx context.Context, id uuid.UUID, updater *model.ReviewU) (*model.Review, error)
-	UpdateExecFn  func(ctx context.Context, updater *model.ReviewU, filters ...*model.ReviewF) (int, error)
-	DeleteFn      func(ctx context.Context, id uuid.UUID) error
-	DeleteExecFn  func(ctx context.Context, filters ...*model.ReviewF) (int, error)
-	AllWithUserFn func(ctx context.Context, reviewFs ...*model.ReviewF) ([]*model.DetailedReview, error)
+	OneFn              func(ctx context.Context, filters ...*model.ReviewF) (*model.Review, error)
+	AllFn              func(ctx context.Context, filters ...*model.ReviewF) ([]*model.Review, error)
+	ExistsFn           func(ctx context.Context, filters ...*model.ReviewF) (bool, error)
+	CountFn            func(ctx context.Context, filters ...*model.ReviewF) (int, error)
+	InsertFn           func(ctx context.Context, entity *model.Review) (*model.Review, error)
+	InsertBulkFn       func(ctx context.Context, entities []*model.Review) ([]*model.Review, error)
+	UpdateFn           func(ctx context.Context, id uuid.UUID, updater *model.ReviewU) (*model.Review, error)
+	UpdateExecFn       func(ctx context.Context, updater *model.ReviewU, filters ...*model.ReviewF) (int, error)
+	DeleteFn           func(ctx context.Context, id uuid.UUID) error
+	DeleteExecFn       func(ctx context.Context, filters ...*model.ReviewF) (int, error)
+	AllDetailedFn      func(ctx context.Context, viewerID uuid.UUID, sort model.ReviewSort, after *model.RankedCursor, limit int, reviewFs ...*model.ReviewF) ([]*model.DetailedReview, error)
+	SummaryFn          func(ctx context.Context, reviewFs ...*model.ReviewF) (*model.ReviewSummary, error)
+	DeleteTombstonesFn func(ctx context.Context) (int, error)
 }
 
 func NewReviewRepository() *ReviewRepository {
@@ -97,9 +99,23 @@ func (r *ReviewRepository) DeleteExec(ctx context.Context, filters ...*model.Rev
 	return 0, nil
 }
 
-func (r *ReviewRepository) AllWithUser(ctx context.Context, reviewFs ...*model.ReviewF) ([]*model.DetailedReview, error) {
-	if r.AllWithUserFn != nil {
-		return r.AllWithUserFn(ctx, reviewFs...)
+func (r *ReviewRepository) AllDetailed(ctx context.Context, viewerID uuid.UUID, sort model.ReviewSort, after *model.RankedCursor, limit int, reviewFs ...*model.ReviewF) ([]*model.DetailedReview, error) {
+	if r.AllDetailedFn != nil {
+		return r.AllDetailedFn(ctx, viewerID, sort, after, limit, reviewFs...)
 	}
 	return []*model.DetailedReview{}, nil
 }
+
+func (r *ReviewRepository) Summary(ctx context.Context, reviewFs ...*model.ReviewF) (*model.ReviewSummary, error) {
+	if r.SummaryFn != nil {
+		return r.SummaryFn(ctx, reviewFs...)
+	}
+	return &model.ReviewSummary{}, nil
+}
+
+func (r *ReviewRepository) DeleteTombstones(ctx context.Context) (int, error) {
+	if r.DeleteTombstonesFn != nil {
+		return r.DeleteTombstonesFn(ctx)
+	}
+	return 0, nil
+}
@@ -10,10 +10,16 @@ import (
 var _ service.ReviewService = (*ReviewServiceMock)(nil)
 
 type ReviewServiceMock struct {
-	CreateReviewFn  func(ctx context.Context, input *service.CreateReviewInput) (*model.Review, error)
-	UpdateReviewFn  func(ctx context.Context, userID, reviewID uuid.UUID, reviewU *model.ReviewU) (*model.Review, error)
-	DeleteReviewFn  func(ctx context.Context, userID, reviewID uuid.UUID) error
-	GetAllReviewsFn func(ctx context.Context, ref int, mediaType model.MediaType) ([]*model.DetailedReview, error)
+	CreateReviewFn   func(ctx context.Context, input *service.CreateReviewInput) (*model.Review, error)
+	UpdateReviewFn   func(ctx context.Context, userID, reviewID uuid.UUID, reviewU *model.ReviewU) (*model.Review, error)
+	DeleteReviewFn   func(ctx context.Context, userID, reviewID uuid.UUID) error
+	GetAllReviewsFn  func(ctx context.Context, input *service.GetReviewsInput) (*service.MediaReviews, error)
+	LikeReviewFn     func(ctx context.Context, like *model.ReviewLike) (*model.ReviewLike, error)
+	UnlikeReviewFn   func(ctx context.Context, userID, reviewID uuid.UUID) error
+	GetUserReviewsFn func(ctx context.Context, viewerID, userID uuid.UUID, sort model.ReviewSort, cursor string, limit int) (*model.Page[*model.DetailedReview], error)
+
+	GetReviewRevisionsFn func(ctx context.Context, viewerID, reviewID uuid.UUID) ([]*model.Revision, error)
+	PurgeTombstonesFn    func(ctx context.Context) (int, error)
 }
 
 func NewReviewService() *ReviewServiceMock {
@@ -41,9 +47,44 @@ func (m *ReviewServiceMock) DeleteReview(ctx context.Context, userID, reviewID u
 	return nil
 }
 
-func (m *ReviewServiceMock) GetAllReviews(ctx context.Context, ref int, mediaType model.MediaType) ([]*model.DetailedReview, error) {
+func (m *ReviewServiceMock) GetAllReviews(ctx context.Context, input *service.GetReviewsInput) (*service.MediaReviews, error) {
 	if m.GetAllReviewsFn != nil {
-		return m.GetAllReviewsFn(ctx, ref, mediaType)
+		return m.GetAllReviewsFn(ctx, input)
+	}
+	return &service.MediaReviews{Reviews: &model.Page[*model.DetailedReview]{}, Summary: &model.ReviewSummary{}}, nil
+}
+
+func (m *ReviewServiceMock) GetUserReviews(ctx context.Context, viewerID, userID uuid.UUID, sort model.ReviewSort, cursor string, limit int) (*model.Page[*model.DetailedReview], error) {
+	if m.GetUserReviewsFn != nil {
+		return m.GetUserReviewsFn(ctx, viewerID, userID, sort, cursor, limit)
+	}
+	return &model.Page[*model.DetailedReview]{}, nil
+}
+
+func (m *ReviewServiceMock) LikeReview(ctx context.Context, like *model.ReviewLike) (*model.ReviewLike, error) {
+	if m.LikeReviewFn != nil {
+		return m.LikeReviewFn(ctx, like)
+	}
+	return &model.ReviewLike{}, nil
+}
+
+func (m *ReviewServiceMock) UnlikeReview(ctx context.Context, userID, reviewID uuid.UUID) error {
+	if m.UnlikeReviewFn != nil {
+		return m.UnlikeReviewFn(ctx, userID, reviewID)
+	}
+	return nil
+}
+
+func (m *ReviewServiceMock) GetReviewRevisions(ctx context.Context, viewerID, reviewID uuid.UUID) ([]*model.Revision, error) {
+	if m.GetReviewRevisionsFn != nil {
+		return m.GetReviewRevisionsFn(ctx, viewerID, reviewID)
+	}
+	return []*model.Revision{}, nil
+}
+
+func (m *ReviewServiceMock) PurgeTombstones(ctx context.Context) (int, error) {
+	if m.PurgeTombstonesFn != nil {
+		return m.PurgeTombstonesFn(ctx)
 	}
-	return []*model.DetailedReview{}, nil
+	return 0, nil
 }
@@ -0,0 +1,97 @@
+package mocks
+
+import (
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ repository.RevisionRepository = (*RevisionRepository)(nil)
+
+type RevisionRepository struct {
+	OneFn        func(ctx context.Context, filters ...*model.RevisionF) (*model.Revision, error)
+	AllFn        func(ctx context.Context, filters ...*model.RevisionF) ([]*model.Revision, error)
+	ExistsFn     func(ctx context.Context, filters ...*model.RevisionF) (bool, error)
+	CountFn      func(ctx context.Context, filters ...*model.RevisionF) (int, error)
+	InsertFn     func(ctx context.Context, entity *model.Revision) (*model.Revision, error)
+	InsertBulkFn func(ctx context.Context, entities []*model.Revision) ([]*model.Revision, error)
+	UpdateFn     func(ctx context.Context, id uuid.UUID, updater *model.RevisionU) (*model.Revision, error)
+	UpdateExecFn func(ctx context.Context, updater *model.RevisionU, filters ...*model.RevisionF) (int, error)
+	DeleteFn     func(ctx context.Context, id uuid.UUID) error
+	DeleteExecFn func(ctx context.Context, filters ...*model.RevisionF) (int, error)
+}
+
+func NewRevisionRepository() *RevisionRepository {
+	return &RevisionRepository{}
+}
+
+func (r *RevisionRepository) One(ctx context.Context, filters ...*model.RevisionF) (*model.Revision, error) {
+	if r.OneFn != nil {
+		return r.OneFn(ctx, filters...)
+	}
+	return &model.Revision{}, nil
+}
+
+func (r *RevisionRepository) All(ctx context.Context, filters ...*model.RevisionF) ([]*model.Revision, error) {
+	if r.AllFn != nil {
+		return r.AllFn(ctx, filters...)
+	}
+	return []*model.Revision{}, nil
+}
+
+func (r *RevisionRepository) Exists(ctx context.Context, filters ...*model.RevisionF) (bool, error) {
+	if r.ExistsFn != nil {
+		return r.ExistsFn(ctx, filters...)
+	}
+	return false, nil
+}
+
+func (r *RevisionRepository) Count(ctx context.Context, filters ...*model.RevisionF) (int, error) {
+	if r.CountFn != nil {
+		return r.CountFn(ctx, filters...)
+	}
+	return 0, nil
+}
+
+func (r *RevisionRepository) Insert(ctx context.Context, entity *model.Revision) (*model.Revision, error) {
+	if r.InsertFn != nil {
+		return r.InsertFn(ctx, entity)
+	}
+	return &model.Revision{}, nil
+}
+
+func (r *RevisionRepository) InsertBulk(ctx context.Context, entities []*model.Revision) ([]*model.Revision, error) {
+	if r.InsertBulkFn != nil {
+		return r.InsertBulkFn(ctx, entities)
+	}
+	return []*model.Revision{}, nil
+}
+
+func (r *RevisionRepository) Update(ctx context.Context, id uuid.UUID, updater *model.RevisionU) (*model.Revision, error) {
+	if r.UpdateFn != nil {
+		return r.UpdateFn(ctx, id, updater)
+	}
+	return &model.Revision{}, nil
+}
+
+func (r *RevisionRepository) UpdateExec(ctx context.Context, updater *model.RevisionU, filters ...*model.RevisionF) (int, error) {
+	if r.UpdateExecFn != nil {
+		return r.UpdateExecFn(ctx, updater, filters...)
+	}
+	return 0, nil
+}
+
+func (r *RevisionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if r.DeleteFn != nil {
+		return r.DeleteFn(ctx, id)
+	}
+	return nil
+}
+
+func (r *RevisionRepository) DeleteExec(ctx context.Context, filters ...*model.RevisionF) (int, error) {
+	if r.DeleteExecFn != nil {
+		return r.DeleteExecFn(ctx, filters...)
+	}
+	return 0, nil
+}
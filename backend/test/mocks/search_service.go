@@ -0,0 +1,24 @@
+package mocks
+
+import (
+	"cine/service"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ service.SearchService = (*SearchServiceMock)(nil)
+
+type SearchServiceMock struct {
+	SearchFn func(ctx context.Context, viewerID uuid.UUID, query string) (*service.SearchOutput, error)
+}
+
+func NewSearchService() *SearchServiceMock {
+	return &SearchServiceMock{}
+}
+
+func (m *SearchServiceMock) Search(ctx context.Context, viewerID uuid.UUID, query string) (*service.SearchOutput, error) {
+	if m.SearchFn != nil {
+		return m.SearchFn(ctx, viewerID, query)
+	}
+	return &service.SearchOutput{}, nil
+}
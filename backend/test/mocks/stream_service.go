@@ -0,0 +1,34 @@
+package mocks
+
+import (
+	"cine/entity/model"
+	"cine/pkg/pubsub"
+	"cine/service"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ service.StreamService = (*StreamServiceMock)(nil)
+
+type StreamServiceMock struct {
+	SubscribeNotificationsFn func(ctx context.Context, userID uuid.UUID) pubsub.Subscription
+	SubscribeCommentsFn      func(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType) (pubsub.Subscription, error)
+}
+
+func NewStreamService() *StreamServiceMock {
+	return &StreamServiceMock{}
+}
+
+func (m *StreamServiceMock) SubscribeNotifications(ctx context.Context, userID uuid.UUID) pubsub.Subscription {
+	if m.SubscribeNotificationsFn != nil {
+		return m.SubscribeNotificationsFn(ctx, userID)
+	}
+	return pubsub.NewMemoryHub().Subscribe()
+}
+
+func (m *StreamServiceMock) SubscribeComments(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType) (pubsub.Subscription, error) {
+	if m.SubscribeCommentsFn != nil {
+		return m.SubscribeCommentsFn(ctx, userID, ref, mediaType)
+	}
+	return pubsub.NewMemoryHub().Subscribe(), nil
+}
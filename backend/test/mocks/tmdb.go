@@ -2,133 +2,294 @@ package mocks
 
 import (
 	"cine/pkg/tmdb"
+	"context"
 )
 
 var _ tmdb.API = (*APIMock)(nil)
 
 type APIMock struct {
-	SearchMoviesFn         func(query string, filter ...tmdb.SearchMovieFilter) ([]tmdb.Movie, error)
-	SearchShowsFn          func(query string, filter ...tmdb.SearchShowFilter) ([]tmdb.Show, error)
-	GetMovieFn             func(ref int) (*tmdb.DetailedMovie, error)
-	GetMovieCreditsFn      func(ref int) (*tmdb.MovieCredits, error)
-	ListNowPlayingMoviesFn func() ([]tmdb.Movie, error)
-	ListPopularMoviesFn    func() ([]tmdb.Movie, error)
-	ListTopRatedMoviesFn   func() ([]tmdb.Movie, error)
-	ListUpcomingMoviesFn   func() ([]tmdb.Movie, error)
-	GetShowFn              func(ref int) (*tmdb.DetailedShow, error)
-	GetShowCreditsFn       func(ref int) (*tmdb.ShowCredits, error)
-	GetShowSeasonDetailsFn func(ref int, seasonNumber int) (*tmdb.DetailedSeason, error)
-	ListAiringTodayShowsFn func() ([]tmdb.Show, error)
-	ListPopularShowsFn     func() ([]tmdb.Show, error)
-	ListTopRatedShowsFn    func() ([]tmdb.Show, error)
-	ListOnTheAirShowsFn    func() ([]tmdb.Show, error)
+	SearchMoviesFn            func(ctx context.Context, query string, filter ...tmdb.SearchMovieFilter) (*tmdb.Page[tmdb.Movie], error)
+	SearchShowsFn             func(ctx context.Context, query string, filter ...tmdb.SearchShowFilter) (*tmdb.Page[tmdb.Show], error)
+	GetMovieFn                func(ctx context.Context, ref int) (*tmdb.DetailedMovie, error)
+	GetMovieCreditsFn         func(ctx context.Context, ref int) (*tmdb.MovieCredits, error)
+	ListNowPlayingMoviesFn    func(ctx context.Context, page int) (*tmdb.Page[tmdb.Movie], error)
+	ListPopularMoviesFn       func(ctx context.Context, page int) (*tmdb.Page[tmdb.Movie], error)
+	ListTopRatedMoviesFn      func(ctx context.Context, page int) (*tmdb.Page[tmdb.Movie], error)
+	ListUpcomingMoviesFn      func(ctx context.Context, page int) (*tmdb.Page[tmdb.Movie], error)
+	GetShowFn                 func(ctx context.Context, ref int) (*tmdb.DetailedShow, error)
+	GetShowCreditsFn          func(ctx context.Context, ref int) (*tmdb.ShowCredits, error)
+	GetShowSeasonDetailsFn    func(ctx context.Context, ref int, seasonNumber int) (*tmdb.DetailedSeason, error)
+	ListAiringTodayShowsFn    func(ctx context.Context, page int) (*tmdb.Page[tmdb.Show], error)
+	ListPopularShowsFn        func(ctx context.Context, page int) (*tmdb.Page[tmdb.Show], error)
+	ListTopRatedShowsFn       func(ctx context.Context, page int) (*tmdb.Page[tmdb.Show], error)
+	ListOnTheAirShowsFn       func(ctx context.Context, page int) (*tmdb.Page[tmdb.Show], error)
+	DiscoverMoviesFn          func(ctx context.Context, filter tmdb.DiscoverFilter) (*tmdb.Page[tmdb.Movie], error)
+	DiscoverShowsFn           func(ctx context.Context, filter tmdb.DiscoverFilter) (*tmdb.Page[tmdb.Show], error)
+	SearchPeopleFn            func(ctx context.Context, query string, filter ...tmdb.SearchPeopleFilter) (*tmdb.Page[tmdb.Person], error)
+	GetPersonFn               func(ctx context.Context, ref int) (*tmdb.DetailedPerson, error)
+	GetPersonMovieCreditsFn   func(ctx context.Context, ref int) (*tmdb.PersonMovieCredits, error)
+	GetPersonShowCreditsFn    func(ctx context.Context, ref int) (*tmdb.PersonShowCredits, error)
+	GetMovieRecommendationsFn func(ctx context.Context, ref int, page int) (*tmdb.Page[tmdb.Movie], error)
+	GetSimilarMoviesFn        func(ctx context.Context, ref int, page int) (*tmdb.Page[tmdb.Movie], error)
+	GetShowRecommendationsFn  func(ctx context.Context, ref int, page int) (*tmdb.Page[tmdb.Show], error)
+	GetSimilarShowsFn         func(ctx context.Context, ref int, page int) (*tmdb.Page[tmdb.Show], error)
+	GetTrendingFn             func(ctx context.Context, trendingType tmdb.TrendingType, window tmdb.TrendingWindow, page int) (*tmdb.Page[tmdb.MultiResult], error)
+	SearchMultiFn             func(ctx context.Context, query string, filter ...tmdb.SearchMultiFilter) (*tmdb.Page[tmdb.MultiResult], error)
+	GetMovieWatchProvidersFn  func(ctx context.Context, ref int) (*tmdb.WatchProviders, error)
+	GetShowWatchProvidersFn   func(ctx context.Context, ref int) (*tmdb.WatchProviders, error)
+	GetMovieBundleFn          func(ctx context.Context, ref int, appends ...tmdb.Append) (*tmdb.MovieBundle, error)
+	GetMovieVideosFn          func(ctx context.Context, ref int) (*tmdb.Videos, error)
+	GetMovieImagesFn          func(ctx context.Context, ref int) (*tmdb.Images, error)
+	GetShowBundleFn           func(ctx context.Context, ref int, appends ...tmdb.Append) (*tmdb.ShowBundle, error)
+	GetShowVideosFn           func(ctx context.Context, ref int) (*tmdb.Videos, error)
+	GetShowImagesFn           func(ctx context.Context, ref int) (*tmdb.Images, error)
 }
 
 func NewTMDB() *APIMock {
 	return &APIMock{}
 }
 
-func (m *APIMock) SearchMovies(query string, filter ...tmdb.SearchMovieFilter) ([]tmdb.Movie, error) {
+func (m *APIMock) SearchMovies(ctx context.Context, query string, filter ...tmdb.SearchMovieFilter) (*tmdb.Page[tmdb.Movie], error) {
 	if m.SearchMoviesFn != nil {
-		return m.SearchMoviesFn(query, filter...)
+		return m.SearchMoviesFn(ctx, query, filter...)
 	}
-	return []tmdb.Movie{}, nil
+	return &tmdb.Page[tmdb.Movie]{}, nil
 }
 
-func (m *APIMock) SearchShows(query string, filter ...tmdb.SearchShowFilter) ([]tmdb.Show, error) {
+func (m *APIMock) SearchShows(ctx context.Context, query string, filter ...tmdb.SearchShowFilter) (*tmdb.Page[tmdb.Show], error) {
 	if m.SearchShowsFn != nil {
-		return m.SearchShowsFn(query, filter...)
+		return m.SearchShowsFn(ctx, query, filter...)
 	}
-	return []tmdb.Show{}, nil
+	return &tmdb.Page[tmdb.Show]{}, nil
 }
 
-func (m *APIMock) GetMovie(ref int) (*tmdb.DetailedMovie, error) {
+func (m *APIMock) GetMovie(ctx context.Context, ref int) (*tmdb.DetailedMovie, error) {
 	if m.GetMovieFn != nil {
-		return m.GetMovieFn(ref)
+		return m.GetMovieFn(ctx, ref)
 	}
 	return &tmdb.DetailedMovie{}, nil
 }
 
-func (m *APIMock) GetMovieCredits(ref int) (*tmdb.MovieCredits, error) {
+func (m *APIMock) GetMovieCredits(ctx context.Context, ref int) (*tmdb.MovieCredits, error) {
 	if m.GetMovieCreditsFn != nil {
-		return m.GetMovieCreditsFn(ref)
+		return m.GetMovieCreditsFn(ctx, ref)
 	}
 	return &tmdb.MovieCredits{}, nil
 }
 
-func (m *APIMock) ListNowPlayingMovies() ([]tmdb.Movie, error) {
+func (m *APIMock) ListNowPlayingMovies(ctx context.Context, page int) (*tmdb.Page[tmdb.Movie], error) {
 	if m.ListNowPlayingMoviesFn != nil {
-		return m.ListNowPlayingMoviesFn()
+		return m.ListNowPlayingMoviesFn(ctx, page)
 	}
-	return []tmdb.Movie{}, nil
+	return &tmdb.Page[tmdb.Movie]{}, nil
 }
 
-func (m *APIMock) ListPopularMovies() ([]tmdb.Movie, error) {
+func (m *APIMock) ListPopularMovies(ctx context.Context, page int) (*tmdb.Page[tmdb.Movie], error) {
 	if m.ListPopularMoviesFn != nil {
-		return m.ListPopularMoviesFn()
+		return m.ListPopularMoviesFn(ctx, page)
 	}
-	return []tmdb.Movie{}, nil
+	return &tmdb.Page[tmdb.Movie]{}, nil
 }
 
-func (m *APIMock) ListTopRatedMovies() ([]tmdb.Movie, error) {
+func (m *APIMock) ListTopRatedMovies(ctx context.Context, page int) (*tmdb.Page[tmdb.Movie], error) {
 	if m.ListTopRatedMoviesFn != nil {
-		return m.ListTopRatedMoviesFn()
+		return m.ListTopRatedMoviesFn(ctx, page)
 	}
-	return []tmdb.Movie{}, nil
+	return &tmdb.Page[tmdb.Movie]{}, nil
 }
 
-func (m *APIMock) ListUpcomingMovies() ([]tmdb.Movie, error) {
+func (m *APIMock) ListUpcomingMovies(ctx context.Context, page int) (*tmdb.Page[tmdb.Movie], error) {
 	if m.ListUpcomingMoviesFn != nil {
-		return m.ListUpcomingMoviesFn()
+		return m.ListUpcomingMoviesFn(ctx, page)
 	}
-	return []tmdb.Movie{}, nil
+	return &tmdb.Page[tmdb.Movie]{}, nil
 }
 
-func (m *APIMock) GetShow(ref int) (*tmdb.DetailedShow, error) {
+func (m *APIMock) GetShow(ctx context.Context, ref int) (*tmdb.DetailedShow, error) {
 	if m.GetShowFn != nil {
-		return m.GetShowFn(ref)
+		return m.GetShowFn(ctx, ref)
 	}
 	return &tmdb.DetailedShow{}, nil
 }
 
-func (m *APIMock) GetShowCredits(ref int) (*tmdb.ShowCredits, error) {
+func (m *APIMock) GetShowCredits(ctx context.Context, ref int) (*tmdb.ShowCredits, error) {
 	if m.GetShowCreditsFn != nil {
-		return m.GetShowCreditsFn(ref)
+		return m.GetShowCreditsFn(ctx, ref)
 	}
 	return &tmdb.ShowCredits{}, nil
 }
 
-func (m *APIMock) GetShowSeasonDetails(ref int, seasonNumber int) (*tmdb.DetailedSeason, error) {
+func (m *APIMock) GetShowSeasonDetails(ctx context.Context, ref int, seasonNumber int) (*tmdb.DetailedSeason, error) {
 	if m.GetShowSeasonDetailsFn != nil {
-		return m.GetShowSeasonDetailsFn(ref, seasonNumber)
+		return m.GetShowSeasonDetailsFn(ctx, ref, seasonNumber)
 	}
 	return &tmdb.DetailedSeason{}, nil
 }
 
-func (m *APIMock) ListAiringTodayShows() ([]tmdb.Show, error) {
+func (m *APIMock) ListAiringTodayShows(ctx context.Context, page int) (*tmdb.Page[tmdb.Show], error) {
 	if m.ListAiringTodayShowsFn != nil {
-		return m.ListAiringTodayShowsFn()
+		return m.ListAiringTodayShowsFn(ctx, page)
 	}
-	return []tmdb.Show{}, nil
+	return &tmdb.Page[tmdb.Show]{}, nil
 }
 
-func (m *APIMock) ListPopularShows() ([]tmdb.Show, error) {
+func (m *APIMock) ListPopularShows(ctx context.Context, page int) (*tmdb.Page[tmdb.Show], error) {
 	if m.ListPopularShowsFn != nil {
-		return m.ListPopularShowsFn()
+		return m.ListPopularShowsFn(ctx, page)
 	}
-	return []tmdb.Show{}, nil
+	return &tmdb.Page[tmdb.Show]{}, nil
 }
 
-func (m *APIMock) ListTopRatedShows() ([]tmdb.Show, error) {
+func (m *APIMock) ListTopRatedShows(ctx context.Context, page int) (*tmdb.Page[tmdb.Show], error) {
 	if m.ListTopRatedShowsFn != nil {
-		return m.ListTopRatedShowsFn()
+		return m.ListTopRatedShowsFn(ctx, page)
 	}
-	return []tmdb.Show{}, nil
+	return &tmdb.Page[tmdb.Show]{}, nil
 }
 
-func (m *APIMock) ListOnTheAirShows() ([]tmdb.Show, error) {
+func (m *APIMock) ListOnTheAirShows(ctx context.Context, page int) (*tmdb.Page[tmdb.Show], error) {
 	if m.ListOnTheAirShowsFn != nil {
-		return m.ListOnTheAirShowsFn()
+		return m.ListOnTheAirShowsFn(ctx, page)
 	}
-	return []tmdb.Show{}, nil
+	return &tmdb.Page[tmdb.Show]{}, nil
+}
+
+func (m *APIMock) DiscoverMovies(ctx context.Context, filter tmdb.DiscoverFilter) (*tmdb.Page[tmdb.Movie], error) {
+	if m.DiscoverMoviesFn != nil {
+		return m.DiscoverMoviesFn(ctx, filter)
+	}
+	return &tmdb.Page[tmdb.Movie]{}, nil
+}
+
+func (m *APIMock) DiscoverShows(ctx context.Context, filter tmdb.DiscoverFilter) (*tmdb.Page[tmdb.Show], error) {
+	if m.DiscoverShowsFn != nil {
+		return m.DiscoverShowsFn(ctx, filter)
+	}
+	return &tmdb.Page[tmdb.Show]{}, nil
+}
+
+func (m *APIMock) SearchPeople(ctx context.Context, query string, filter ...tmdb.SearchPeopleFilter) (*tmdb.Page[tmdb.Person], error) {
+	if m.SearchPeopleFn != nil {
+		return m.SearchPeopleFn(ctx, query, filter...)
+	}
+	return &tmdb.Page[tmdb.Person]{}, nil
+}
+
+func (m *APIMock) GetPerson(ctx context.Context, ref int) (*tmdb.DetailedPerson, error) {
+	if m.GetPersonFn != nil {
+		return m.GetPersonFn(ctx, ref)
+	}
+	return &tmdb.DetailedPerson{}, nil
+}
+
+func (m *APIMock) GetPersonMovieCredits(ctx context.Context, ref int) (*tmdb.PersonMovieCredits, error) {
+	if m.GetPersonMovieCreditsFn != nil {
+		return m.GetPersonMovieCreditsFn(ctx, ref)
+	}
+	return &tmdb.PersonMovieCredits{}, nil
+}
+
+func (m *APIMock) GetPersonShowCredits(ctx context.Context, ref int) (*tmdb.PersonShowCredits, error) {
+	if m.GetPersonShowCreditsFn != nil {
+		return m.GetPersonShowCreditsFn(ctx, ref)
+	}
+	return &tmdb.PersonShowCredits{}, nil
+}
+
+func (m *APIMock) GetMovieRecommendations(ctx context.Context, ref int, page int) (*tmdb.Page[tmdb.Movie], error) {
+	if m.GetMovieRecommendationsFn != nil {
+		return m.GetMovieRecommendationsFn(ctx, ref, page)
+	}
+	return &tmdb.Page[tmdb.Movie]{}, nil
+}
+
+func (m *APIMock) GetSimilarMovies(ctx context.Context, ref int, page int) (*tmdb.Page[tmdb.Movie], error) {
+	if m.GetSimilarMoviesFn != nil {
+		return m.GetSimilarMoviesFn(ctx, ref, page)
+	}
+	return &tmdb.Page[tmdb.Movie]{}, nil
+}
+
+func (m *APIMock) GetShowRecommendations(ctx context.Context, ref int, page int) (*tmdb.Page[tmdb.Show], error) {
+	if m.GetShowRecommendationsFn != nil {
+		return m.GetShowRecommendationsFn(ctx, ref, page)
+	}
+	return &tmdb.Page[tmdb.Show]{}, nil
+}
+
+func (m *APIMock) GetSimilarShows(ctx context.Context, ref int, page int) (*tmdb.Page[tmdb.Show], error) {
+	if m.GetSimilarShowsFn != nil {
+		return m.GetSimilarShowsFn(ctx, ref, page)
+	}
+	return &tmdb.Page[tmdb.Show]{}, nil
+}
+
+func (m *APIMock) GetTrending(ctx context.Context, trendingType tmdb.TrendingType, window tmdb.TrendingWindow, page int) (*tmdb.Page[tmdb.MultiResult], error) {
+	if m.GetTrendingFn != nil {
+		return m.GetTrendingFn(ctx, trendingType, window, page)
+	}
+	return &tmdb.Page[tmdb.MultiResult]{}, nil
+}
+
+func (m *APIMock) SearchMulti(ctx context.Context, query string, filter ...tmdb.SearchMultiFilter) (*tmdb.Page[tmdb.MultiResult], error) {
+	if m.SearchMultiFn != nil {
+		return m.SearchMultiFn(ctx, query, filter...)
+	}
+	return &tmdb.Page[tmdb.MultiResult]{}, nil
+}
+
+func (m *APIMock) GetMovieWatchProviders(ctx context.Context, ref int) (*tmdb.WatchProviders, error) {
+	if m.GetMovieWatchProvidersFn != nil {
+		return m.GetMovieWatchProvidersFn(ctx, ref)
+	}
+	return &tmdb.WatchProviders{}, nil
+}
+
+func (m *APIMock) GetShowWatchProviders(ctx context.Context, ref int) (*tmdb.WatchProviders, error) {
+	if m.GetShowWatchProvidersFn != nil {
+		return m.GetShowWatchProvidersFn(ctx, ref)
+	}
+	return &tmdb.WatchProviders{}, nil
+}
+
+func (m *APIMock) GetMovieBundle(ctx context.Context, ref int, appends ...tmdb.Append) (*tmdb.MovieBundle, error) {
+	if m.GetMovieBundleFn != nil {
+		return m.GetMovieBundleFn(ctx, ref, appends...)
+	}
+	return &tmdb.MovieBundle{DetailedMovie: &tmdb.DetailedMovie{}}, nil
+}
+
+func (m *APIMock) GetMovieVideos(ctx context.Context, ref int) (*tmdb.Videos, error) {
+	if m.GetMovieVideosFn != nil {
+		return m.GetMovieVideosFn(ctx, ref)
+	}
+	return &tmdb.Videos{}, nil
+}
+
+func (m *APIMock) GetMovieImages(ctx context.Context, ref int) (*tmdb.Images, error) {
+	if m.GetMovieImagesFn != nil {
+		return m.GetMovieImagesFn(ctx, ref)
+	}
+	return &tmdb.Images{}, nil
+}
+
+func (m *APIMock) GetShowBundle(ctx context.Context, ref int, appends ...tmdb.Append) (*tmdb.ShowBundle, error) {
+	if m.GetShowBundleFn != nil {
+		return m.GetShowBundleFn(ctx, ref, appends...)
+	}
+	return &tmdb.ShowBundle{DetailedShow: &tmdb.DetailedShow{}}, nil
+}
+
+func (m *APIMock) GetShowVideos(ctx context.Context, ref int) (*tmdb.Videos, error) {
+	if m.GetShowVideosFn != nil {
+		return m.GetShowVideosFn(ctx, ref)
+	}
+	return &tmdb.Videos{}, nil
+}
+
+func (m *APIMock) GetShowImages(ctx context.Context, ref int) (*tmdb.Images, error) {
+	if m.GetShowImagesFn != nil {
+		return m.GetShowImagesFn(ctx, ref)
+	}
+	return &tmdb.Images{}, nil
 }
@@ -27,6 +27,19 @@ type UserRepository struct {
 	UnfollowUserFn func(ctx context.Context, user *model.User, followedID uuid.UUID) error
 	OneFollowerFn  func(ctx context.Context, user *model.User, followerID uuid.UUID) (*model.User, error)
 	AllFollowersFn func(ctx context.Context, user *model.User) ([]*model.User, error)
+	SearchFn       func(ctx context.Context, viewerID uuid.UUID, query string, limit int) ([]*model.User, error)
+
+	AllFollowedDetailedFn  func(ctx context.Context, id, viewerID uuid.UUID, after *model.Cursor, limit int) ([]*model.DetailedFollow, error)
+	AllFollowersDetailedFn func(ctx context.Context, id, viewerID uuid.UUID, after *model.Cursor, limit int) ([]*model.DetailedFollow, error)
+
+	BlockedFn     func(ctx context.Context, userID, otherID uuid.UUID) (bool, error)
+	BlockUserFn   func(ctx context.Context, user *model.User, blockedID uuid.UUID) error
+	UnblockUserFn func(ctx context.Context, user *model.User, blockedID uuid.UUID) error
+	AllBlockedFn  func(ctx context.Context, user *model.User) ([]*model.User, error)
+	MuteUserFn    func(ctx context.Context, user *model.User, mutedID uuid.UUID) error
+	UnmuteUserFn  func(ctx context.Context, user *model.User, mutedID uuid.UUID) error
+	AllMutedFn    func(ctx context.Context, user *model.User) ([]*model.User, error)
+	CanViewFn     func(ctx context.Context, viewerID, userID uuid.UUID) (bool, error)
 }
 
 func NewUserRepository() *UserRepository {
@@ -151,3 +164,80 @@ func (u *UserRepository) AllFollowers(ctx context.Context, user *model.User) ([]
 	}
 	return []*model.User{}, nil
 }
+
+func (u *UserRepository) AllFollowedDetailed(ctx context.Context, id, viewerID uuid.UUID, after *model.Cursor, limit int) ([]*model.DetailedFollow, error) {
+	if u.AllFollowedDetailedFn != nil {
+		return u.AllFollowedDetailedFn(ctx, id, viewerID, after, limit)
+	}
+	return []*model.DetailedFollow{}, nil
+}
+
+func (u *UserRepository) AllFollowersDetailed(ctx context.Context, id, viewerID uuid.UUID, after *model.Cursor, limit int) ([]*model.DetailedFollow, error) {
+	if u.AllFollowersDetailedFn != nil {
+		return u.AllFollowersDetailedFn(ctx, id, viewerID, after, limit)
+	}
+	return []*model.DetailedFollow{}, nil
+}
+
+func (u *UserRepository) Blocked(ctx context.Context, userID, otherID uuid.UUID) (bool, error) {
+	if u.BlockedFn != nil {
+		return u.BlockedFn(ctx, userID, otherID)
+	}
+	return false, nil
+}
+
+func (u *UserRepository) BlockUser(ctx context.Context, user *model.User, blockedID uuid.UUID) error {
+	if u.BlockUserFn != nil {
+		return u.BlockUserFn(ctx, user, blockedID)
+	}
+	return nil
+}
+
+func (u *UserRepository) UnblockUser(ctx context.Context, user *model.User, blockedID uuid.UUID) error {
+	if u.UnblockUserFn != nil {
+		return u.UnblockUserFn(ctx, user, blockedID)
+	}
+	return nil
+}
+
+func (u *UserRepository) AllBlocked(ctx context.Context, user *model.User) ([]*model.User, error) {
+	if u.AllBlockedFn != nil {
+		return u.AllBlockedFn(ctx, user)
+	}
+	return []*model.User{}, nil
+}
+
+func (u *UserRepository) MuteUser(ctx context.Context, user *model.User, mutedID uuid.UUID) error {
+	if u.MuteUserFn != nil {
+		return u.MuteUserFn(ctx, user, mutedID)
+	}
+	return nil
+}
+
+func (u *UserRepository) UnmuteUser(ctx context.Context, user *model.User, mutedID uuid.UUID) error {
+	if u.UnmuteUserFn != nil {
+		return u.UnmuteUserFn(ctx, user, mutedID)
+	}
+	return nil
+}
+
+func (u *UserRepository) AllMuted(ctx context.Context, user *model.User) ([]*model.User, error) {
+	if u.AllMutedFn != nil {
+		return u.AllMutedFn(ctx, user)
+	}
+	return []*model.User{}, nil
+}
+
+func (u *UserRepository) CanView(ctx context.Context, viewerID, userID uuid.UUID) (bool, error) {
+	if u.CanViewFn != nil {
+		return u.CanViewFn(ctx, viewerID, userID)
+	}
+	return true, nil
+}
+
+func (u *UserRepository) Search(ctx context.Context, viewerID uuid.UUID, query string, limit int) ([]*model.User, error) {
+	if u.SearchFn != nil {
+		return u.SearchFn(ctx, viewerID, query, limit)
+	}
+	return []*model.User{}, nil
+}
@@ -14,8 +14,21 @@ type UserServiceMock struct {
 	GetDetailedUserFn func(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*model.DetailedUser, error)
 	UpdateUserFn      func(ctx context.Context, id uuid.UUID, userU *model.UserU) (*model.User, error)
 	DeleteUserFn      func(ctx context.Context, id uuid.UUID) error
-	FollowUserFn      func(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) error
+	FollowUserFn      func(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) (*model.FollowRequest, error)
 	UnfollowUserFn    func(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) error
+	GetFollowersFn    func(ctx context.Context, viewerID, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedFollow], error)
+	GetFollowingFn    func(ctx context.Context, viewerID, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedFollow], error)
+	BlockUserFn       func(ctx context.Context, userID uuid.UUID, blockedID uuid.UUID) error
+	UnblockUserFn     func(ctx context.Context, userID uuid.UUID, blockedID uuid.UUID) error
+	GetBlockedFn      func(ctx context.Context, userID uuid.UUID) ([]*model.User, error)
+	MuteUserFn        func(ctx context.Context, userID uuid.UUID, mutedID uuid.UUID) error
+	UnmuteUserFn      func(ctx context.Context, userID uuid.UUID, mutedID uuid.UUID) error
+	GetMutedFn        func(ctx context.Context, userID uuid.UUID) ([]*model.User, error)
+
+	GetFollowRequestsFn    func(ctx context.Context, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedFollowRequest], error)
+	ApproveFollowRequestFn func(ctx context.Context, userID uuid.UUID, requestID uuid.UUID) error
+	DenyFollowRequestFn    func(ctx context.Context, userID uuid.UUID, requestID uuid.UUID) error
+	CancelFollowRequestFn  func(ctx context.Context, requesterID uuid.UUID, userID uuid.UUID) error
 }
 
 func NewUserService() *UserServiceMock {
@@ -50,11 +63,11 @@ func (m *UserServiceMock) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (m *UserServiceMock) FollowUser(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) error {
+func (m *UserServiceMock) FollowUser(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) (*model.FollowRequest, error) {
 	if m.FollowUserFn != nil {
 		return m.FollowUserFn(ctx, followerID, followeeID)
 	}
-	return nil
+	return nil, nil
 }
 
 func (m *UserServiceMock) UnfollowUser(ctx context.Context, followerID uuid.UUID, followeeID uuid.UUID) error {
@@ -63,3 +76,87 @@ func (m *UserServiceMock) UnfollowUser(ctx context.Context, followerID uuid.UUID
 	}
 	return nil
 }
+
+func (m *UserServiceMock) GetFollowers(ctx context.Context, viewerID, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedFollow], error) {
+	if m.GetFollowersFn != nil {
+		return m.GetFollowersFn(ctx, viewerID, userID, cursor, limit)
+	}
+	return &model.Page[*model.DetailedFollow]{Items: []*model.DetailedFollow{}}, nil
+}
+
+func (m *UserServiceMock) GetFollowing(ctx context.Context, viewerID, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedFollow], error) {
+	if m.GetFollowingFn != nil {
+		return m.GetFollowingFn(ctx, viewerID, userID, cursor, limit)
+	}
+	return &model.Page[*model.DetailedFollow]{Items: []*model.DetailedFollow{}}, nil
+}
+
+func (m *UserServiceMock) BlockUser(ctx context.Context, userID uuid.UUID, blockedID uuid.UUID) error {
+	if m.BlockUserFn != nil {
+		return m.BlockUserFn(ctx, userID, blockedID)
+	}
+	return nil
+}
+
+func (m *UserServiceMock) UnblockUser(ctx context.Context, userID uuid.UUID, blockedID uuid.UUID) error {
+	if m.UnblockUserFn != nil {
+		return m.UnblockUserFn(ctx, userID, blockedID)
+	}
+	return nil
+}
+
+func (m *UserServiceMock) MuteUser(ctx context.Context, userID uuid.UUID, mutedID uuid.UUID) error {
+	if m.MuteUserFn != nil {
+		return m.MuteUserFn(ctx, userID, mutedID)
+	}
+	return nil
+}
+
+func (m *UserServiceMock) UnmuteUser(ctx context.Context, userID uuid.UUID, mutedID uuid.UUID) error {
+	if m.UnmuteUserFn != nil {
+		return m.UnmuteUserFn(ctx, userID, mutedID)
+	}
+	return nil
+}
+
+func (m *UserServiceMock) GetBlocked(ctx context.Context, userID uuid.UUID) ([]*model.User, error) {
+	if m.GetBlockedFn != nil {
+		return m.GetBlockedFn(ctx, userID)
+	}
+	return []*model.User{}, nil
+}
+
+func (m *UserServiceMock) GetMuted(ctx context.Context, userID uuid.UUID) ([]*model.User, error) {
+	if m.GetMutedFn != nil {
+		return m.GetMutedFn(ctx, userID)
+	}
+	return []*model.User{}, nil
+}
+
+func (m *UserServiceMock) GetFollowRequests(ctx context.Context, userID uuid.UUID, cursor string, limit int) (*model.Page[*model.DetailedFollowRequest], error) {
+	if m.GetFollowRequestsFn != nil {
+		return m.GetFollowRequestsFn(ctx, userID, cursor, limit)
+	}
+	return &model.Page[*model.DetailedFollowRequest]{}, nil
+}
+
+func (m *UserServiceMock) ApproveFollowRequest(ctx context.Context, userID uuid.UUID, requestID uuid.UUID) error {
+	if m.ApproveFollowRequestFn != nil {
+		return m.ApproveFollowRequestFn(ctx, userID, requestID)
+	}
+	return nil
+}
+
+func (m *UserServiceMock) DenyFollowRequest(ctx context.Context, userID uuid.UUID, requestID uuid.UUID) error {
+	if m.DenyFollowRequestFn != nil {
+		return m.DenyFollowRequestFn(ctx, userID, requestID)
+	}
+	return nil
+}
+
+func (m *UserServiceMock) CancelFollowRequest(ctx context.Context, requesterID uuid.UUID, userID uuid.UUID) error {
+	if m.CancelFollowRequestFn != nil {
+		return m.CancelFollowRequestFn(ctx, requesterID, userID)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package mocks
+
+import (
+	"cine/entity/model"
+	"cine/repository"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ repository.WatchItemRepository = (*WatchItemRepository)(nil)
+
+type WatchItemRepository struct {
+	OneFn          func(ctx context.Context, filters ...*model.WatchItemF) (*model.WatchItem, error)
+	AllFn          func(ctx context.Context, filters ...*model.WatchItemF) ([]*model.WatchItem, error)
+	ExistsFn       func(ctx context.Context, filters ...*model.WatchItemF) (bool, error)
+	CountFn        func(ctx context.Context, filters ...*model.WatchItemF) (int, error)
+	InsertFn       func(ctx context.Context, entity *model.WatchItem) (*model.WatchItem, error)
+	InsertBulkFn   func(ctx context.Context, entities []*model.WatchItem) ([]*model.WatchItem, error)
+	UpdateFn       func(ctx context.Context, id uuid.UUID, updater *model.WatchItemU) (*model.WatchItem, error)
+	UpdateExecFn   func(ctx context.Context, updater *model.WatchItemU, filters ...*model.WatchItemF) (int, error)
+	DeleteFn       func(ctx context.Context, id uuid.UUID) error
+	DeleteExecFn   func(ctx context.Context, filters ...*model.WatchItemF) (int, error)
+	AllWithMediaFn func(ctx context.Context, filters ...*model.WatchItemF) ([]*model.DetailedWatchItem, error)
+	OneByRefFn     func(ctx context.Context, userID uuid.UUID, mediaType model.MediaType, ref int) (*model.WatchItem, error)
+}
+
+func NewWatchItemRepository() *WatchItemRepository {
+	return &WatchItemRepository{}
+}
+
+func (e *WatchItemRepository) One(ctx context.Context, filters ...*model.WatchItemF) (*model.WatchItem, error) {
+	if e.OneFn != nil {
+		return e.OneFn(ctx, filters...)
+	}
+	return &model.WatchItem{}, nil
+}
+
+func (e *WatchItemRepository) All(ctx context.Context, filters ...*model.WatchItemF) ([]*model.WatchItem, error) {
+	if e.AllFn != nil {
+		return e.AllFn(ctx, filters...)
+	}
+	return []*model.WatchItem{}, nil
+}
+
+func (e *WatchItemRepository) Exists(ctx context.Context, filters ...*model.WatchItemF) (bool, error) {
+	if e.ExistsFn != nil {
+		return e.ExistsFn(ctx, filters...)
+	}
+	return false, nil
+}
+
+func (e *WatchItemRepository) Count(ctx context.Context, filters ...*model.WatchItemF) (int, error) {
+	if e.CountFn != nil {
+		return e.CountFn(ctx, filters...)
+	}
+	return 0, nil
+}
+
+func (e *WatchItemRepository) Insert(ctx context.Context, entity *model.WatchItem) (*model.WatchItem, error) {
+	if e.InsertFn != nil {
+		return e.InsertFn(ctx, entity)
+	}
+	return &model.WatchItem{}, nil
+}
+
+func (e *WatchItemRepository) InsertBulk(ctx context.Context, entities []*model.WatchItem) ([]*model.WatchItem, error) {
+	if e.InsertBulkFn != nil {
+		return e.InsertBulkFn(ctx, entities)
+	}
+	return []*model.WatchItem{}, nil
+}
+
+func (e *WatchItemRepository) Update(ctx context.Context, id uuid.UUID, updater *model.WatchItemU) (*model.WatchItem, error) {
+	if e.UpdateFn != nil {
+		return e.UpdateFn(ctx, id, updater)
+	}
+	return &model.WatchItem{}, nil
+}
+
+func (e *WatchItemRepository) UpdateExec(ctx context.Context, updater *model.WatchItemU, filters ...*model.WatchItemF) (int, error) {
+	if e.UpdateExecFn != nil {
+		return e.UpdateExecFn(ctx, updater, filters...)
+	}
+	return 0, nil
+}
+
+func (e *WatchItemRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if e.DeleteFn != nil {
+		return e.DeleteFn(ctx, id)
+	}
+	return nil
+}
+
+func (e *WatchItemRepository) DeleteExec(ctx context.Context, filters ...*model.WatchItemF) (int, error) {
+	if e.DeleteExecFn != nil {
+		return e.DeleteExecFn(ctx, filters...)
+	}
+	return 0, nil
+}
+
+func (e *WatchItemRepository) AllWithMedia(ctx context.Context, filters ...*model.WatchItemF) ([]*model.DetailedWatchItem, error) {
+	if e.AllWithMediaFn != nil {
+		return e.AllWithMediaFn(ctx, filters...)
+	}
+	return []*model.DetailedWatchItem{}, nil
+}
+
+func (e *WatchItemRepository) OneByRef(ctx context.Context, userID uuid.UUID, mediaType model.MediaType, ref int) (*model.WatchItem, error) {
+	if e.OneByRefFn != nil {
+		return e.OneByRefFn(ctx, userID, mediaType, ref)
+	}
+	return &model.WatchItem{}, nil
+}
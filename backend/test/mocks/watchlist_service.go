@@ -0,0 +1,57 @@
+package mocks
+
+import (
+	"cine/entity/model"
+	"cine/service"
+	"context"
+	"github.com/google/uuid"
+)
+
+var _ service.WatchlistService = (*WatchlistServiceMock)(nil)
+
+type WatchlistServiceMock struct {
+	GetItemsFn       func(ctx context.Context, userID uuid.UUID, status model.WatchStatus) ([]*model.DetailedWatchItem, error)
+	AddToWatchlistFn func(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType, priority *int) (*model.WatchItem, error)
+	MarkWatchedFn    func(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType, priority *int) (*model.WatchItem, error)
+	UpdatePriorityFn func(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType, priority int) (*model.WatchItem, error)
+	RemoveItemFn     func(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType) error
+}
+
+func NewWatchlistService() *WatchlistServiceMock {
+	return &WatchlistServiceMock{}
+}
+
+func (m *WatchlistServiceMock) GetItems(ctx context.Context, userID uuid.UUID, status model.WatchStatus) ([]*model.DetailedWatchItem, error) {
+	if m.GetItemsFn != nil {
+		return m.GetItemsFn(ctx, userID, status)
+	}
+	return []*model.DetailedWatchItem{}, nil
+}
+
+func (m *WatchlistServiceMock) AddToWatchlist(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType, priority *int) (*model.WatchItem, error) {
+	if m.AddToWatchlistFn != nil {
+		return m.AddToWatchlistFn(ctx, userID, ref, mediaType, priority)
+	}
+	return &model.WatchItem{}, nil
+}
+
+func (m *WatchlistServiceMock) MarkWatched(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType, priority *int) (*model.WatchItem, error) {
+	if m.MarkWatchedFn != nil {
+		return m.MarkWatchedFn(ctx, userID, ref, mediaType, priority)
+	}
+	return &model.WatchItem{}, nil
+}
+
+func (m *WatchlistServiceMock) UpdatePriority(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType, priority int) (*model.WatchItem, error) {
+	if m.UpdatePriorityFn != nil {
+		return m.UpdatePriorityFn(ctx, userID, ref, mediaType, priority)
+	}
+	return &model.WatchItem{}, nil
+}
+
+func (m *WatchlistServiceMock) RemoveItem(ctx context.Context, userID uuid.UUID, ref int, mediaType model.MediaType) error {
+	if m.RemoveItemFn != nil {
+		return m.RemoveItemFn(ctx, userID, ref, mediaType)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package unit
+
+import (
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/service"
+	"cine/test/mocks"
+	"context"
+	"github.com/google/uuid"
+	testify "github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestActivityService_GetFeed(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	as := service.NewActivityService(store, mocks.NopLogger{})
+
+	viewerID := uuid.New()
+	newActivities := func(n int) []*model.DetailedActivity {
+		activities := make([]*model.DetailedActivity, 0, n)
+		for i := 0; i < n; i++ {
+			activities = append(activities, &model.DetailedActivity{
+				Activity: &model.Activity{ID: uuid.New(), CreatedAt: time.Now().Add(-time.Duration(i) * time.Minute)},
+			})
+		}
+		return activities
+	}
+
+	t.Run("a full page has a cursor to the next", func(t *testing.T) {
+		var gotLimit int
+		var gotF *model.ActivityF
+		store.Activity.AllDetailedFn = func(ctx context.Context, _ uuid.UUID, after *model.Cursor, limit int, filters ...*model.ActivityF) ([]*model.DetailedActivity, error) {
+			gotLimit, gotF = limit, filters[0]
+			return newActivities(limit), nil
+		}
+
+		page, err := as.GetFeed(ctx, viewerID, "", 2)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(3, gotLimit, "one extra activity should be fetched")
+		assert.Equal(viewerID, *gotF.FollowedBy, "feed should be of followed users")
+		assert.Len(page.Items, 2, "page should be cut to the limit")
+		assert.NotNil(page.NextCursor, "next cursor should be set")
+
+		cursor, err := model.ParseCursor(*page.NextCursor)
+		assert.Nil(err, "next cursor should parse")
+		assert.Equal(page.Items[1].Activity.ID, cursor.ID, "cursor should point at the last item")
+		assert.True(page.Items[1].Activity.CreatedAt.Equal(cursor.CreatedAt), "cursor should keep the time")
+	})
+
+	t.Run("the last page has no cursor", func(t *testing.T) {
+		store.Activity.AllDetailedFn = func(ctx context.Context, _ uuid.UUID, after *model.Cursor, limit int, filters ...*model.ActivityF) ([]*model.DetailedActivity, error) {
+			return newActivities(1), nil
+		}
+
+		page, err := as.GetFeed(ctx, viewerID, "", 2)
+		assert.Nil(err, "error should be nil")
+		assert.Len(page.Items, 1, "every activity should be returned")
+		assert.Nil(page.NextCursor, "next cursor should be nil")
+	})
+
+	t.Run("spoilers are redacted for viewers hiding them", func(t *testing.T) {
+		watchedID := uuid.New()
+		store.User.OneFn = func(ctx context.Context, filters ...*model.UserF) (*model.User, error) {
+			return &model.User{ID: *filters[0].ID, HideSpoilers: true}, nil
+		}
+		store.WatchItem.AllFn = func(ctx context.Context, filters ...*model.WatchItemF) ([]*model.WatchItem, error) {
+			return []*model.WatchItem{{MediaID: watchedID}}, nil
+		}
+		store.Activity.AllDetailedFn = func(ctx context.Context, _ uuid.UUID, after *model.Cursor, limit int, filters ...*model.ActivityF) ([]*model.DetailedActivity, error) {
+			return []*model.DetailedActivity{
+				{Activity: &model.Activity{ID: uuid.New()}, Review: &model.Review{UserID: uuid.New(), MediaID: uuid.New(), Content: "a twist", Spoiler: true}},
+				{Activity: &model.Activity{ID: uuid.New()}, Comment: &model.Comment{UserID: uuid.New(), MediaID: uuid.New(), Content: "the butler ||did it||"}},
+				{Activity: &model.Activity{ID: uuid.New()}, Comment: &model.Comment{UserID: uuid.New(), MediaID: watchedID, Content: "the butler ||did it||"}},
+			}, nil
+		}
+
+		page, err := as.GetFeed(ctx, viewerID, "", 3)
+		assert.Nil(err, "error should be nil")
+		assert.Empty(page.Items[0].Review.Content, "a review flagged as a spoiler should be hidden entirely")
+		assert.Equal("the butler [spoiler]", page.Items[1].Comment.Content, "inline spoilers should be hidden")
+		assert.Equal("the butler ||did it||", page.Items[2].Comment.Content, "spoilers of watched media should be kept")
+
+		store.User.OneFn = nil
+		store.WatchItem.AllFn = nil
+	})
+
+	t.Run("a malformed cursor is a bad request", func(t *testing.T) {
+		_, err := as.GetFeed(ctx, viewerID, "not a cursor", 2)
+		e, ok := fault.As(err)
+		assert.True(ok, "error should be a fault")
+		assert.Equal(fault.CodeBadRequest, e.Code, "error should be a bad request")
+	})
+}
@@ -0,0 +1,168 @@
+package unit
+
+import (
+	"cine/datastore"
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/pkg/pubsub"
+	"cine/service"
+	"cine/test/mocks"
+	"context"
+	"github.com/google/uuid"
+	testify "github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCommentService_DeleteComment(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	cs := service.NewCommentService(store, mocks.NopLogger{}, mocks.NewMediaService(), mocks.NewNotificationService(), pubsub.NewMemoryHub())
+
+	authorID := uuid.New()
+	store.Comment.OneFn = func(ctx context.Context, filters ...*model.CommentF) (*model.Comment, error) {
+		return &model.Comment{ID: *filters[0].ID, UserID: authorID, Content: "first"}, nil
+	}
+
+	t.Run("leaves a tombstone", func(t *testing.T) {
+		var update *model.CommentU
+		store.Comment.UpdateFn = func(ctx context.Context, id uuid.UUID, updater *model.CommentU) (*model.Comment, error) {
+			update = updater
+			return &model.Comment{ID: id, Content: *updater.Content, DeletedAt: updater.DeletedAt}, nil
+		}
+		store.Comment.DeleteFn = func(ctx context.Context, id uuid.UUID) error {
+			assert.Fail("the comment should not be hard deleted")
+			return nil
+		}
+
+		err := cs.DeleteComment(ctx, authorID, uuid.New())
+		assert.Nil(err, "error should be nil")
+		assert.Equal(model.CommentTombstone, *update.Content)
+		assert.NotNil(update.DeletedAt, "the comment should be marked as deleted")
+
+		store.Comment.UpdateFn = nil
+		store.Comment.DeleteFn = nil
+	})
+
+	t.Run("already deleted", func(t *testing.T) {
+		store.Comment.OneFn = func(ctx context.Context, filters ...*model.CommentF) (*model.Comment, error) {
+			deletedAt := time.Now()
+			return &model.Comment{ID: *filters[0].ID, UserID: authorID, DeletedAt: &deletedAt}, nil
+		}
+
+		err := cs.DeleteComment(ctx, authorID, uuid.New())
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+	})
+}
+
+func TestCommentService_PurgeComment(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	cs := service.NewCommentService(store, mocks.NopLogger{}, mocks.NewMediaService(), mocks.NewNotificationService(), pubsub.NewMemoryHub())
+
+	t.Run("moderator", func(t *testing.T) {
+		store.User.OneFn = func(ctx context.Context, filters ...*model.UserF) (*model.User, error) {
+			return &model.User{ID: *filters[0].ID, Moderator: true}, nil
+		}
+		purged := false
+		store.Comment.DeleteFn = func(ctx context.Context, id uuid.UUID) error {
+			purged = true
+			return nil
+		}
+
+		err := cs.PurgeComment(ctx, uuid.New(), uuid.New())
+		assert.Nil(err, "error should be nil")
+		assert.True(purged, "the comment should be hard deleted")
+
+		store.User.OneFn = nil
+		store.Comment.DeleteFn = nil
+	})
+
+	t.Run("not a moderator", func(t *testing.T) {
+		err := cs.PurgeComment(ctx, uuid.New(), uuid.New())
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeForbidden, e.Code, "error code should be forbidden")
+	})
+
+	t.Run("tombstones are purged up the thread", func(t *testing.T) {
+		rounds := []int{3, 1, 0}
+		store.Comment.DeleteTombstonesFn = func(ctx context.Context) (int, error) {
+			affected := rounds[0]
+			rounds = rounds[1:]
+			return affected, nil
+		}
+
+		purged, err := cs.PurgeTombstones(ctx)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(4, purged, "tombstones left without replies should be purged until none are left")
+
+		store.Comment.DeleteTombstonesFn = nil
+	})
+}
+
+func TestCommentService_ReviewThreads(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	cs := service.NewCommentService(store, mocks.NopLogger{}, mocks.NewMediaService(), mocks.NewNotificationService(), pubsub.NewMemoryHub())
+
+	reviewID := uuid.New()
+	store.Comment.OneFn = func(ctx context.Context, filters ...*model.CommentF) (*model.Comment, error) {
+		return &model.Comment{ID: *filters[0].ID, UserID: uuid.New(), ReviewID: &reviewID}, nil
+	}
+	// the review is by a private account the viewer doesn't follow
+	store.Review.ExistsFn = func(ctx context.Context, filters ...*model.ReviewF) (bool, error) {
+		assert.Equal(reviewID, *filters[0].ID)
+		assert.NotNil(filters[0].VisibleTo, "the review should be checked against the viewer")
+		return false, nil
+	}
+
+	t.Run("replies", func(t *testing.T) {
+		_, err := cs.GetCommentReplies(ctx, uuid.New(), uuid.New())
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+	})
+
+	t.Run("reply", func(t *testing.T) {
+		replyingToID := uuid.New()
+		_, err := cs.CreateComment(ctx, 1, model.MediaTypeMovie, &model.Comment{UserID: uuid.New(), ReplyingToID: &replyingToID, Content: "reply"})
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+	})
+
+	t.Run("like", func(t *testing.T) {
+		store.User.ExistsFn = func(ctx context.Context, filters ...*model.UserF) (bool, error) {
+			return true, nil
+		}
+
+		_, err := cs.LikeComment(ctx, &model.Like{UserID: uuid.New(), CommentID: uuid.New()})
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+
+		store.User.ExistsFn = nil
+	})
+}
+
+func TestCommentService_GetCommentRevisions(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	cs := service.NewCommentService(store, mocks.NopLogger{}, mocks.NewMediaService(), mocks.NewNotificationService(), pubsub.NewMemoryHub())
+
+	t.Run("comment hidden from the viewer", func(t *testing.T) {
+		viewerID := uuid.New()
+		store.Comment.OneFn = func(ctx context.Context, filters ...*model.CommentF) (*model.Comment, error) {
+			assert.Equal(viewerID, *filters[0].VisibleTo, "the comment should be checked against the viewer")
+			return nil, datastore.ErrNotFound
+		}
+
+		_, err := cs.GetCommentRevisions(ctx, uuid.New(), viewerID)
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+
+		store.Comment.OneFn = nil
+	})
+}
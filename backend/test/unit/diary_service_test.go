@@ -0,0 +1,124 @@
+package unit
+
+import (
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/service"
+	"cine/test/mocks"
+	"context"
+	"github.com/google/uuid"
+	testify "github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDiaryService_CreateEntry(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	media := mocks.NewMediaService()
+	ds := service.NewDiaryService(store, mocks.NopLogger{}, media)
+
+	userID := uuid.New()
+	newInput := func(reviewID *uuid.UUID, watchedOn time.Time) *service.CreateDiaryEntryInput {
+		return &service.CreateDiaryEntryInput{
+			UserID:    userID,
+			Ref:       1,
+			MediaType: model.MediaTypeMovie,
+			Entry:     &model.DiaryEntry{UserID: userID, ReviewID: reviewID, WatchedOn: watchedOn},
+		}
+	}
+
+	t.Run("future dates are rejected", func(t *testing.T) {
+		_, err := ds.CreateEntry(ctx, newInput(nil, time.Now().AddDate(0, 0, 7)))
+		e, ok := fault.As(err)
+		assert.True(ok, "error should be a fault")
+		assert.Equal(fault.CodeBadRequest, e.Code, "error should be a bad request")
+	})
+
+	t.Run("linked review must be for the same media", func(t *testing.T) {
+		reviewID := uuid.New()
+		store.Review.OneFn = func(ctx context.Context, filters ...*model.ReviewF) (*model.Review, error) {
+			return &model.Review{ID: reviewID, UserID: userID, MediaID: uuid.New()}, nil
+		}
+
+		_, err := ds.CreateEntry(ctx, newInput(&reviewID, time.Now()))
+		e, ok := fault.As(err)
+		assert.True(ok, "error should be a fault")
+		assert.Equal(fault.CodeBadRequest, e.Code, "error should be a bad request")
+	})
+
+	t.Run("linked review must be the user's own", func(t *testing.T) {
+		reviewID := uuid.New()
+		store.Review.OneFn = func(ctx context.Context, filters ...*model.ReviewF) (*model.Review, error) {
+			return &model.Review{ID: reviewID, UserID: uuid.New()}, nil
+		}
+
+		_, err := ds.CreateEntry(ctx, newInput(&reviewID, time.Now()))
+		e, ok := fault.As(err)
+		assert.True(ok, "error should be a fault")
+		assert.Equal(fault.CodeForbidden, e.Code, "error should be forbidden")
+	})
+
+	t.Run("an unavailable tmdb is not an internal error", func(t *testing.T) {
+		media.GetMediaFn = func(ctx context.Context, ref int, mediaType model.MediaType) (*model.Media, error) {
+			return nil, fault.Unavailable("the movie database is unavailable")
+		}
+		defer func() { media.GetMediaFn = nil }()
+
+		_, err := ds.CreateEntry(ctx, newInput(nil, time.Now()))
+		e, ok := fault.As(err)
+		assert.True(ok, "error should be a fault")
+		assert.Equal(fault.CodeUnavailable, e.Code, "error should be unavailable")
+	})
+}
+
+func TestDiaryService_GetDiary(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	ds := service.NewDiaryService(store, mocks.NopLogger{}, mocks.NewMediaService())
+
+	store.User.ExistsFn = func(ctx context.Context, filters ...*model.UserF) (bool, error) {
+		return filters[0].HiddenFrom == nil, nil
+	}
+
+	var got *model.DiaryEntryF
+	store.DiaryEntry.AllWithMediaFn = func(ctx context.Context, filters ...*model.DiaryEntryF) ([]*model.DetailedDiaryEntry, error) {
+		got = filters[0]
+		return []*model.DetailedDiaryEntry{}, nil
+	}
+
+	t.Run("a year spans january to january", func(t *testing.T) {
+		_, err := ds.GetDiary(ctx, uuid.New(), uuid.New(), 2024, nil)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), *got.WatchedFrom)
+		assert.Equal(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), *got.WatchedBefore)
+	})
+
+	t.Run("december rolls over into the next year", func(t *testing.T) {
+		month := 12
+		_, err := ds.GetDiary(ctx, uuid.New(), uuid.New(), 2024, &month)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC), *got.WatchedFrom)
+		assert.Equal(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), *got.WatchedBefore)
+	})
+
+	t.Run("private account", func(t *testing.T) {
+		store.User.CanViewFn = func(ctx context.Context, viewerID, userID uuid.UUID) (bool, error) { return false, nil }
+
+		_, err := ds.GetDiary(ctx, uuid.New(), uuid.New(), 2024, nil)
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeForbidden, e.Code, "error code should be forbidden")
+
+		store.User.CanViewFn = nil
+	})
+
+	t.Run("blocked or muted user", func(t *testing.T) {
+		store.User.ExistsFn = func(ctx context.Context, filters ...*model.UserF) (bool, error) { return true, nil }
+
+		_, err := ds.GetDiary(ctx, uuid.New(), uuid.New(), 2024, nil)
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+	})
+}
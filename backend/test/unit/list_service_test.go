@@ -0,0 +1,103 @@
+package unit
+
+import (
+	"cine/entity/model"
+	"cine/service"
+	"cine/test/mocks"
+	"context"
+	"github.com/google/uuid"
+	testify "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestListService_CreateList(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	ls := service.NewListService(store, mocks.NopLogger{}, mocks.NewMediaService(), mocks.NewNotificationService())
+
+	store.User.ExistsFn = func(ctx context.Context, filters ...*model.UserF) (bool, error) { return true, nil }
+	store.Activity.InsertFn = func(ctx context.Context, entity *model.Activity) (*model.Activity, error) {
+		assert.Fail("a private list should not be in feeds")
+		return entity, nil
+	}
+
+	_, err := ls.CreateList(ctx, uuid.New(), "favorites")
+	assert.Nil(err, "error should be nil")
+}
+
+func TestListService_UpdateList(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	ls := service.NewListService(store, mocks.NopLogger{}, mocks.NewMediaService(), mocks.NewNotificationService())
+
+	store.List.ExistsFn = func(ctx context.Context, filters ...*model.ListF) (bool, error) { return true, nil }
+	store.List.UpdateFn = func(ctx context.Context, id uuid.UUID, updater *model.ListU) (*model.List, error) {
+		return &model.List{ID: id, Public: updater.Public != nil && *updater.Public}, nil
+	}
+
+	var recorded []*model.Activity
+	store.Activity.InsertFn = func(ctx context.Context, entity *model.Activity) (*model.Activity, error) {
+		recorded = append(recorded, entity)
+		return entity, nil
+	}
+	var deleted []*model.ActivityF
+	store.Activity.DeleteExecFn = func(ctx context.Context, filters ...*model.ActivityF) (int, error) {
+		deleted = append(deleted, filters[0])
+		return 1, nil
+	}
+
+	t.Run("public lists are in feeds", func(t *testing.T) {
+		recorded, deleted = nil, nil
+		public := true
+
+		_, err := ls.UpdateList(ctx, uuid.New(), uuid.New(), &model.ListU{Public: &public})
+		assert.Nil(err, "error should be nil")
+		assert.Len(recorded, 1, "the update should be recorded")
+		assert.Equal(model.ActivityListUpdated, recorded[0].Kind)
+		assert.Empty(deleted, "no activity should be removed")
+	})
+
+	t.Run("private lists take their activity with them", func(t *testing.T) {
+		recorded, deleted = nil, nil
+		public := false
+		listID := uuid.New()
+
+		_, err := ls.UpdateList(ctx, uuid.New(), listID, &model.ListU{Public: &public})
+		assert.Nil(err, "error should be nil")
+		assert.Empty(recorded, "the update should not be recorded")
+		assert.Len(deleted, 1, "the list's activity should be removed")
+		assert.Equal(listID, *deleted[0].ListID)
+	})
+}
+
+func TestListService_GetDetailedList(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	media := mocks.NewMediaService()
+	ls := service.NewListService(store, mocks.NopLogger{}, media, mocks.NewNotificationService())
+
+	memberID := uuid.New()
+	store.List.OneFn = func(ctx context.Context, filters ...*model.ListF) (*model.List, error) {
+		return &model.List{ID: *filters[0].ID}, nil
+	}
+	store.List.AllMembersFn = func(ctx context.Context, list *model.List) ([]*model.User, error) {
+		return []*model.User{{ID: memberID}}, nil
+	}
+	store.List.AllMediaFn = func(ctx context.Context, list *model.List) ([]*model.Media, error) {
+		return []*model.Media{{ID: uuid.New(), MediaType: model.MediaTypeMovie}}, nil
+	}
+	media.FilterStreamableFn = func(ctx context.Context, userID uuid.UUID, medias []*model.Media) ([]*model.Media, error) {
+		return []*model.Media{}, nil
+	}
+
+	t.Run("nothing streamable is still a list", func(t *testing.T) {
+		list, err := ls.GetDetailedList(ctx, memberID, uuid.New(), true)
+		assert.Nil(err, "error should be nil")
+		assert.NotNil(list.Movies, "movies should be empty, not null")
+		assert.Empty(list.Movies)
+		assert.NotNil(list.Shows, "shows should be empty, not null")
+	})
+}
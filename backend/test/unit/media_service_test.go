@@ -0,0 +1,210 @@
+package unit
+
+import (
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/pkg/tmdb"
+	"cine/service"
+	"cine/test/mocks"
+	"context"
+	"github.com/google/uuid"
+	testify "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMediaService_GetPersonFilmography(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	api := mocks.NewTMDB()
+	ms := service.NewMediaService(mocks.NopLogger{}, api, mocks.NewStore())
+
+	t.Run("merges and sorts credits", func(t *testing.T) {
+		api.GetPersonMovieCreditsFn = func(ctx context.Context, ref int) (*tmdb.PersonMovieCredits, error) {
+			return &tmdb.PersonMovieCredits{
+				Cast: []tmdb.PersonMovieCast{
+					{ID: 1, Title: "Old Movie", ReleaseDate: "1999-05-01", Character: "Hero"},
+					{ID: 2, Title: "Announced", ReleaseDate: ""},
+				},
+				Crew: []tmdb.PersonMovieCrew{
+					{ID: 1, Title: "Old Movie", ReleaseDate: "1999-05-01", Job: "Director"},
+				},
+			}, nil
+		}
+		api.GetPersonShowCreditsFn = func(ctx context.Context, ref int) (*tmdb.PersonShowCredits, error) {
+			return &tmdb.PersonShowCredits{
+				Cast: []tmdb.PersonShowCast{
+					{ID: 1, Name: "New Show", FirstAirDate: "2020-01-01", Character: "Villain", EpisodeCount: 8},
+				},
+			}, nil
+		}
+
+		credits, err := ms.GetPersonFilmography(ctx, 1)
+		assert.Nil(err, "error should be nil")
+		assert.Len(credits, 3, "movie 1 cast and crew should be merged")
+
+		assert.Equal("Announced", credits[0].Title, "undated credits should come first")
+		assert.Equal("New Show", credits[1].Title, "newer credits should come before older ones")
+		assert.Equal(model.MediaTypeShow, credits[1].MediaType, "show credit should keep its media type")
+		assert.Equal(8, credits[1].EpisodeCount, "episode count should be kept")
+		assert.Equal([]string{"Hero"}, credits[2].Characters, "characters should be merged")
+		assert.Equal([]string{"Director"}, credits[2].Jobs, "jobs should be merged")
+	})
+
+	t.Run("person not found", func(t *testing.T) {
+		api.GetPersonShowCreditsFn = func(ctx context.Context, ref int) (*tmdb.PersonShowCredits, error) {
+			return nil, tmdb.ErrorNotFound("person show credits")
+		}
+
+		_, err := ms.GetPersonFilmography(ctx, 1)
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+	})
+}
+
+func TestMediaService_GetMovieRecommendations(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	api := mocks.NewTMDB()
+	store := mocks.NewStore()
+	ms := service.NewMediaService(mocks.NopLogger{}, api, store)
+
+	api.GetMovieRecommendationsFn = func(ctx context.Context, ref int, page int) (*tmdb.Page[tmdb.Movie], error) {
+		return &tmdb.Page[tmdb.Movie]{Page: page, Results: []tmdb.Movie{{ID: 1}, {ID: 2}}, TotalPages: 4, TotalResults: 80}, nil
+	}
+	api.GetSimilarMoviesFn = func(ctx context.Context, ref int, page int) (*tmdb.Page[tmdb.Movie], error) {
+		return &tmdb.Page[tmdb.Movie]{Results: []tmdb.Movie{{ID: 3}}}, nil
+	}
+
+	t.Run("flags titles in lists with one lookup", func(t *testing.T) {
+		lookups := 0
+		store.List.HasMediaFn = func(ctx context.Context, userID uuid.UUID, mediaType model.MediaType, refs []int) (map[int]bool, error) {
+			lookups++
+			assert.Equal([]int{1, 2, 3}, refs, "recommended and similar refs should be looked up together")
+			assert.Equal(model.MediaTypeMovie, mediaType, "media type should be movie")
+			return map[int]bool{2: true, 3: true}, nil
+		}
+
+		recommendations, err := ms.GetMovieRecommendations(ctx, uuid.New(), 10, 1)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(1, lookups, "lists should be checked once")
+		assert.False(recommendations.Recommended.Results[0].InList, "movie 1 should not be in a list")
+		assert.True(recommendations.Recommended.Results[1].InList, "movie 2 should be in a list")
+		assert.True(recommendations.Similar.Results[0].InList, "movie 3 should be in a list")
+	})
+
+	t.Run("keeps the paging of each group", func(t *testing.T) {
+		recommendations, err := ms.GetMovieRecommendations(ctx, uuid.New(), 10, 2)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(2, recommendations.Recommended.Page)
+		assert.Equal(4, recommendations.Recommended.TotalPages)
+		assert.Equal(80, recommendations.Recommended.TotalResults)
+	})
+
+	t.Run("movie not found", func(t *testing.T) {
+		api.GetSimilarMoviesFn = func(ctx context.Context, ref int, page int) (*tmdb.Page[tmdb.Movie], error) {
+			return nil, tmdb.ErrorNotFound("similar movies")
+		}
+
+		_, err := ms.GetMovieRecommendations(ctx, uuid.New(), 10, 1)
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+	})
+}
+
+func TestMediaService_GetDetailedMovie(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	api := mocks.NewTMDB()
+	store := mocks.NewStore()
+	ms := service.NewMediaService(mocks.NopLogger{}, api, store)
+
+	region := "GB"
+	store.User.OneFn = func(ctx context.Context, filters ...*model.UserF) (*model.User, error) {
+		return &model.User{Region: &region}, nil
+	}
+	api.GetMovieFn = func(ctx context.Context, ref int) (*tmdb.DetailedMovie, error) {
+		return &tmdb.DetailedMovie{ID: ref, Title: "A Movie"}, nil
+	}
+
+	t.Run("adds providers for the user's region", func(t *testing.T) {
+		api.GetMovieWatchProvidersFn = func(ctx context.Context, ref int) (*tmdb.WatchProviders, error) {
+			return &tmdb.WatchProviders{ID: ref, Results: map[string]tmdb.RegionProviders{
+				"US": {Flatrate: []tmdb.WatchProvider{{ID: 8, Name: "Netflix"}}},
+				"GB": {Rent: []tmdb.WatchProvider{{ID: 2, Name: "Apple TV"}}},
+			}}, nil
+		}
+
+		movie, err := ms.GetDetailedMovie(ctx, uuid.New(), 10)
+		assert.Nil(err, "error should be nil")
+		assert.Equal("A Movie", movie.Title, "movie details should be kept")
+		assert.Equal("GB", movie.WatchRegion, "watch region should be the user's")
+		assert.Equal(2, movie.WatchProviders.Rent[0].ID, "providers should be for the user's region")
+		assert.Empty(movie.WatchProviders.Flatrate, "other regions should not leak in")
+	})
+
+	t.Run("providers failing does not fail the movie", func(t *testing.T) {
+		api.GetMovieWatchProvidersFn = func(ctx context.Context, ref int) (*tmdb.WatchProviders, error) {
+			return nil, tmdb.ErrorUnavailable("watch providers")
+		}
+
+		movie, err := ms.GetDetailedMovie(ctx, uuid.New(), 10)
+		assert.Nil(err, "error should be nil")
+		assert.Nil(movie.WatchProviders, "providers should be nil")
+	})
+	t.Run("flags the viewer's watch status", func(t *testing.T) {
+		store.WatchItem.OneByRefFn = func(ctx context.Context, userID uuid.UUID, mediaType model.MediaType, ref int) (*model.WatchItem, error) {
+			return &model.WatchItem{Status: model.WatchStatusWatchlist, Priority: 2}, nil
+		}
+
+		movie, err := ms.GetDetailedMovie(ctx, uuid.New(), 10)
+		assert.Nil(err, "error should be nil")
+		assert.True(movie.ViewerStatus.InWatchlist, "movie should be on the watchlist")
+		assert.False(movie.ViewerStatus.Watched, "movie should not be watched")
+		assert.Equal(2, *movie.ViewerStatus.Priority, "priority should be the item's")
+	})
+}
+
+func TestMediaService_FilterStreamable(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	api := mocks.NewTMDB()
+	store := mocks.NewStore()
+	ms := service.NewMediaService(mocks.NopLogger{}, api, store)
+
+	medias := []*model.Media{
+		{ID: uuid.New(), Ref: 1, MediaType: model.MediaTypeMovie},
+		{ID: uuid.New(), Ref: 2, MediaType: model.MediaTypeShow},
+		{ID: uuid.New(), Ref: 3, MediaType: model.MediaTypeMovie},
+	}
+	netflix := []tmdb.WatchProvider{{ID: 8}}
+	api.GetMovieWatchProvidersFn = func(ctx context.Context, ref int) (*tmdb.WatchProviders, error) {
+		if ref == 1 {
+			return &tmdb.WatchProviders{Results: map[string]tmdb.RegionProviders{"US": {Flatrate: netflix}}}, nil
+		}
+		// only rentable, which doesn't count as streamable
+		return &tmdb.WatchProviders{Results: map[string]tmdb.RegionProviders{"US": {Rent: netflix}}}, nil
+	}
+	api.GetShowWatchProvidersFn = func(ctx context.Context, ref int) (*tmdb.WatchProviders, error) {
+		return &tmdb.WatchProviders{Results: map[string]tmdb.RegionProviders{"US": {Flatrate: netflix}}}, nil
+	}
+
+	t.Run("keeps media on the user's services", func(t *testing.T) {
+		store.User.OneFn = func(ctx context.Context, filters ...*model.UserF) (*model.User, error) {
+			return &model.User{Services: []int{8}}, nil
+		}
+
+		streamable, err := ms.FilterStreamable(ctx, uuid.New(), medias)
+		assert.Nil(err, "error should be nil")
+		assert.Equal([]*model.Media{medias[0], medias[1]}, streamable, "only flatrate offers should be kept, in order")
+	})
+
+	t.Run("no services keeps nothing", func(t *testing.T) {
+		store.User.OneFn = func(ctx context.Context, filters ...*model.UserF) (*model.User, error) {
+			return &model.User{}, nil
+		}
+
+		streamable, err := ms.FilterStreamable(ctx, uuid.New(), medias)
+		assert.Nil(err, "error should be nil")
+		assert.Empty(streamable, "nothing should be streamable")
+	})
+}
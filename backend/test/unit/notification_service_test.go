@@ -0,0 +1,125 @@
+package unit
+
+import (
+	"cine/entity/model"
+	"cine/pkg/pubsub"
+	"cine/service"
+	"cine/test/mocks"
+	"context"
+	"github.com/google/uuid"
+	testify "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNotificationService_Notify(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	hub := pubsub.NewMemoryHub()
+	ns := service.NewNotificationService(store, mocks.NopLogger{}, hub)
+
+	inserted := 0
+	store.Notification.InsertFn = func(ctx context.Context, entity *model.Notification) (*model.Notification, error) {
+		inserted++
+		return entity, nil
+	}
+
+	t.Run("notifies the recipient", func(t *testing.T) {
+		inserted = 0
+		store.User.OneFn = func(ctx context.Context, filters ...*model.UserF) (*model.User, error) {
+			return &model.User{ID: *filters[0].ID}, nil
+		}
+
+		ns.Notify(ctx, &model.Notification{UserID: uuid.New(), ActorID: uuid.New(), Kind: model.NotificationUserFollowed})
+		assert.Equal(1, inserted, "notification should be inserted")
+	})
+
+	t.Run("pushes to the recipient's streams", func(t *testing.T) {
+		userID := uuid.New()
+		store.User.OneFn = func(ctx context.Context, filters ...*model.UserF) (*model.User, error) {
+			return &model.User{ID: *filters[0].ID}, nil
+		}
+		sub := hub.Subscribe("notifications:" + userID.String())
+		defer sub.Close()
+
+		ns.Notify(ctx, &model.Notification{UserID: userID, ActorID: uuid.New(), Kind: model.NotificationUserFollowed})
+
+		select {
+		case event := <-sub.Events():
+			assert.Equal(service.EventNotification, event.Type)
+			assert.Contains(string(event.Data), userID.String(), "event should carry the notification")
+		default:
+			assert.Fail("notification should be published")
+		}
+	})
+
+	t.Run("skips the actor's own actions", func(t *testing.T) {
+		inserted = 0
+		userID := uuid.New()
+
+		ns.Notify(ctx, &model.Notification{UserID: userID, ActorID: userID, Kind: model.NotificationCommentLiked})
+		assert.Equal(0, inserted, "liking your own comment should not notify")
+	})
+
+	t.Run("skips muted kinds", func(t *testing.T) {
+		inserted = 0
+		store.User.OneFn = func(ctx context.Context, filters ...*model.UserF) (*model.User, error) {
+			return &model.User{MutedNotifications: []model.NotificationKind{model.NotificationCommentLiked}}, nil
+		}
+
+		ns.Notify(ctx, &model.Notification{UserID: uuid.New(), ActorID: uuid.New(), Kind: model.NotificationCommentLiked})
+		assert.Equal(0, inserted, "muted kinds should not notify")
+
+		ns.Notify(ctx, &model.Notification{UserID: uuid.New(), ActorID: uuid.New(), Kind: model.NotificationCommentReplied})
+		assert.Equal(1, inserted, "other kinds should still notify")
+	})
+
+	t.Run("skips actors hidden from the recipient", func(t *testing.T) {
+		inserted = 0
+		recipientID, actorID := uuid.New(), uuid.New()
+		store.User.OneFn = func(ctx context.Context, filters ...*model.UserF) (*model.User, error) {
+			return &model.User{ID: *filters[0].ID}, nil
+		}
+		store.User.ExistsFn = func(ctx context.Context, filters ...*model.UserF) (bool, error) {
+			assert.Equal(actorID, *filters[0].ID, "the actor should be checked")
+			assert.Equal(recipientID, *filters[0].HiddenFrom, "against the recipient")
+			return true, nil
+		}
+
+		ns.Notify(ctx, &model.Notification{UserID: recipientID, ActorID: actorID, Kind: model.NotificationReviewLiked})
+		assert.Equal(0, inserted, "a muted or blocked actor should not notify")
+
+		store.User.ExistsFn = nil
+	})
+}
+
+func TestNotificationService_MarkRead(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	ns := service.NewNotificationService(store, mocks.NopLogger{}, pubsub.NewMemoryHub())
+
+	var got *model.NotificationF
+	store.Notification.UpdateExecFn = func(ctx context.Context, updater *model.NotificationU, filters ...*model.NotificationF) (int, error) {
+		got = filters[0]
+		return 2, nil
+	}
+
+	userID := uuid.New()
+
+	t.Run("no ids marks everything unread", func(t *testing.T) {
+		marked, err := ns.MarkRead(ctx, userID, nil)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(2, marked, "marked count should be passed through")
+		assert.Equal(userID, *got.UserID, "only the user's notifications should be marked")
+		assert.Nil(got.IDIn, "no id filter should be set")
+	})
+
+	t.Run("ids narrow down what is marked", func(t *testing.T) {
+		ids := []uuid.UUID{uuid.New(), uuid.New()}
+		_, err := ns.MarkRead(ctx, userID, ids)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(userID, *got.UserID, "only the user's notifications should be marked")
+		assert.Equal(ids, *got.IDIn, "only the given ids should be marked")
+	})
+}
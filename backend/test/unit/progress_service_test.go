@@ -0,0 +1,106 @@
+package unit
+
+import (
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/pkg/tmdb"
+	"cine/service"
+	"cine/test/mocks"
+	"context"
+	"github.com/google/uuid"
+	testify "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// newProgressShow stands in for a show with specials, a finished first season,
+// and a second season whose last episode hasn't aired yet.
+func newProgressShow(api *mocks.APIMock) {
+	api.GetShowFn = func(ctx context.Context, ref int) (*tmdb.DetailedShow, error) {
+		return &tmdb.DetailedShow{ID: ref, Seasons: []tmdb.Season{{SeasonNumber: 0}, {SeasonNumber: 1}, {SeasonNumber: 2}}}, nil
+	}
+	api.GetShowSeasonDetailsFn = func(ctx context.Context, ref int, seasonNumber int) (*tmdb.DetailedSeason, error) {
+		switch seasonNumber {
+		case 0:
+			return &tmdb.DetailedSeason{Episodes: []tmdb.Episode{{SeasonNumber: 0, EpisodeNumber: 1, AirDate: "2020-01-01"}}}, nil
+		case 1:
+			return &tmdb.DetailedSeason{Episodes: []tmdb.Episode{
+				{SeasonNumber: 1, EpisodeNumber: 1, AirDate: "2020-01-01"},
+				{SeasonNumber: 1, EpisodeNumber: 2, AirDate: "2020-01-08"},
+			}}, nil
+		default:
+			return &tmdb.DetailedSeason{Episodes: []tmdb.Episode{
+				{SeasonNumber: 2, EpisodeNumber: 1, AirDate: "2021-01-01"},
+				{SeasonNumber: 2, EpisodeNumber: 2, AirDate: "2099-01-01"},
+			}}, nil
+		}
+	}
+}
+
+func TestProgressService_GetShowProgress(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	api := mocks.NewTMDB()
+	store := mocks.NewStore()
+	ps := service.NewProgressService(store, mocks.NopLogger{}, api, mocks.NewMediaService())
+	newProgressShow(api)
+
+	t.Run("counts aired episodes and picks the next one", func(t *testing.T) {
+		store.EpisodeProgress.AllFn = func(ctx context.Context, filters ...*model.EpisodeProgressF) ([]*model.EpisodeProgress, error) {
+			return []*model.EpisodeProgress{{SeasonNumber: 1, EpisodeNumber: 2}}, nil
+		}
+
+		progress, err := ps.GetShowProgress(ctx, uuid.New(), 1)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(3, progress.AiredCount, "specials and unaired episodes should not count")
+		assert.Equal(1, progress.WatchedCount, "one episode should be watched")
+		assert.Equal(33.3, progress.PercentComplete, "percent should be rounded to one decimal")
+		assert.Len(progress.Seasons, 2, "specials should be left out")
+		assert.Equal([]int{2}, progress.Seasons[0].WatchedEpisodes, "watched episodes should be listed per season")
+		assert.Equal(2, progress.NextEpisode.SeasonNumber, "next episode should follow the last watched one")
+		assert.Equal(1, progress.NextEpisode.EpisodeNumber, "next episode should follow the last watched one")
+	})
+
+	t.Run("caught up has no next episode", func(t *testing.T) {
+		store.EpisodeProgress.AllFn = func(ctx context.Context, filters ...*model.EpisodeProgressF) ([]*model.EpisodeProgress, error) {
+			return []*model.EpisodeProgress{{SeasonNumber: 1, EpisodeNumber: 1}, {SeasonNumber: 1, EpisodeNumber: 2}, {SeasonNumber: 2, EpisodeNumber: 1}}, nil
+		}
+
+		progress, err := ps.GetShowProgress(ctx, uuid.New(), 1)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(100.0, progress.PercentComplete, "every aired episode should be watched")
+		assert.Nil(progress.NextEpisode, "there should be no next episode until the next one airs")
+	})
+}
+
+func TestProgressService_MarkShow(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	api := mocks.NewTMDB()
+	store := mocks.NewStore()
+	ps := service.NewProgressService(store, mocks.NopLogger{}, api, mocks.NewMediaService())
+	newProgressShow(api)
+
+	t.Run("only inserts episodes not yet watched", func(t *testing.T) {
+		store.EpisodeProgress.AllFn = func(ctx context.Context, filters ...*model.EpisodeProgressF) ([]*model.EpisodeProgress, error) {
+			return []*model.EpisodeProgress{{SeasonNumber: 1, EpisodeNumber: 1}}, nil
+		}
+		var inserted []*model.EpisodeProgress
+		store.EpisodeProgress.InsertBulkFn = func(ctx context.Context, entities []*model.EpisodeProgress) ([]*model.EpisodeProgress, error) {
+			inserted = entities
+			return entities, nil
+		}
+
+		err := ps.MarkShow(ctx, uuid.New(), 1)
+		assert.Nil(err, "error should be nil")
+		assert.Len(inserted, 2, "only the unwatched aired episodes should be inserted")
+		assert.Equal(1, inserted[0].SeasonNumber, "s1e2 should be inserted")
+		assert.Equal(2, inserted[0].EpisodeNumber, "s1e2 should be inserted")
+		assert.Equal(2, inserted[1].SeasonNumber, "s2e1 should be inserted")
+	})
+
+	t.Run("unaired episode cannot be marked", func(t *testing.T) {
+		err := ps.MarkEpisode(ctx, uuid.New(), 1, 2, 2)
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+	})
+}
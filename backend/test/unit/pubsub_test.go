@@ -0,0 +1,63 @@
+package unit
+
+import (
+	"cine/pkg/pubsub"
+	"context"
+	testify "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMemoryHub(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+
+	t.Run("delivers events to subscribers of the topic", func(t *testing.T) {
+		hub := pubsub.NewMemoryHub()
+		first := hub.Subscribe("a", "b")
+		second := hub.Subscribe("b")
+		defer first.Close()
+		defer second.Close()
+
+		event, err := pubsub.NewEvent("b", "kind", map[string]int{"n": 1})
+		assert.Nil(err)
+		assert.Nil(hub.Publish(ctx, event))
+
+		assert.Equal(`{"n":1}`, string((<-first.Events()).Data))
+		assert.Equal(`{"n":1}`, string((<-second.Events()).Data))
+
+		assert.Nil(hub.Publish(ctx, &pubsub.Event{Topic: "a"}))
+		assert.Equal("a", (<-first.Events()).Topic)
+		assert.Len(second.Events(), 0, "subscribers should only get their topics")
+	})
+
+	t.Run("drops events for subscribers that fall behind", func(t *testing.T) {
+		hub := pubsub.NewMemoryHub()
+		sub := hub.Subscribe("a")
+		defer sub.Close()
+
+		for range 100 {
+			assert.Nil(hub.Publish(ctx, &pubsub.Event{Topic: "a"}))
+		}
+		assert.Less(len(sub.Events()), 100, "publishing should not block on a full subscriber")
+	})
+
+	t.Run("closing ends subscriptions", func(t *testing.T) {
+		hub := pubsub.NewMemoryHub()
+		sub := hub.Subscribe("a")
+		sub.Close()
+		sub.Close()
+
+		_, ok := <-sub.Events()
+		assert.False(ok, "events should be closed with the subscription")
+
+		open := hub.Subscribe("a")
+		assert.Nil(hub.Close())
+		_, ok = <-open.Events()
+		assert.False(ok, "events should be closed with the hub")
+		open.Close()
+
+		assert.ErrorIs(hub.Publish(ctx, &pubsub.Event{Topic: "a"}), pubsub.ErrClosed)
+		_, ok = <-hub.Subscribe("a").Events()
+		assert.False(ok, "subscribing to a closed hub should end right away")
+	})
+}
@@ -0,0 +1,321 @@
+package unit
+
+import (
+	"cine/datastore"
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/service"
+	"cine/test/mocks"
+	"context"
+	"github.com/google/uuid"
+	testify "github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestReviewService_GetAllReviews(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	rs := service.NewReviewService(store, mocks.NopLogger{}, mocks.NewMediaService(), mocks.NewNotificationService())
+
+	newInput := func(sort model.ReviewSort, cursor string) *service.GetReviewsInput {
+		return &service.GetReviewsInput{
+			ViewerID:  uuid.New(),
+			Ref:       1,
+			MediaType: model.MediaTypeMovie,
+			Sort:      sort,
+			Cursor:    cursor,
+			Limit:     2,
+		}
+	}
+
+	t.Run("pages through reviews by rating", func(t *testing.T) {
+		now := time.Now()
+		store.Review.AllDetailedFn = func(ctx context.Context, viewerID uuid.UUID, sort model.ReviewSort, after *model.RankedCursor, limit int, reviewFs ...*model.ReviewF) ([]*model.DetailedReview, error) {
+			assert.Equal(model.ReviewSortHighest, sort)
+			assert.Equal(3, limit, "one more than the page should be fetched")
+			reviews := make([]*model.DetailedReview, 0, limit)
+			for i := range limit {
+				reviews = append(reviews, &model.DetailedReview{Review: &model.Review{ID: uuid.New(), Rating: 10 - i, CreatedAt: now}})
+			}
+			return reviews, nil
+		}
+		store.Review.SummaryFn = func(ctx context.Context, reviewFs ...*model.ReviewF) (*model.ReviewSummary, error) {
+			assert.Nil(reviewFs[0].VisibleTo, "the summary should count every review")
+			return &model.ReviewSummary{Average: 9.5, Count: 2, Histogram: [10]int{8: 1, 9: 1}}, nil
+		}
+
+		reviews, err := rs.GetAllReviews(ctx, newInput(model.ReviewSortHighest, ""))
+		assert.Nil(err, "error should be nil")
+		assert.Len(reviews.Reviews.Items, 2)
+		assert.Equal(2, reviews.Summary.Count)
+		assert.NotNil(reviews.Reviews.NextCursor, "a next cursor should be returned when there are more reviews")
+
+		after, err := model.ParseRankedCursor(*reviews.Reviews.NextCursor)
+		assert.Nil(err)
+		assert.Equal(9, after.Rank, "the cursor should carry the rating of the last review")
+		assert.Equal(reviews.Reviews.Items[1].Review.ID, after.ID, "the cursor should point at the last review of the page")
+
+		store.Review.AllDetailedFn = nil
+		store.Review.SummaryFn = nil
+	})
+
+	t.Run("redacts spoilers for viewers who haven't watched", func(t *testing.T) {
+		store.User.OneFn = func(ctx context.Context, filters ...*model.UserF) (*model.User, error) {
+			return &model.User{ID: *filters[0].ID, HideSpoilers: true}, nil
+		}
+		store.Review.AllDetailedFn = func(ctx context.Context, viewerID uuid.UUID, sort model.ReviewSort, after *model.RankedCursor, limit int, reviewFs ...*model.ReviewF) ([]*model.DetailedReview, error) {
+			return []*model.DetailedReview{
+				{Review: &model.Review{ID: uuid.New(), UserID: uuid.New(), Content: "a twist", Spoiler: true}},
+				{Review: &model.Review{ID: uuid.New(), UserID: uuid.New(), Content: "the butler ||did it||"}},
+				{Review: &model.Review{ID: uuid.New(), UserID: uuid.New(), Content: "great"}},
+			}, nil
+		}
+
+		input := newInput(model.ReviewSortNewest, "")
+		input.Limit = 3
+		reviews, err := rs.GetAllReviews(ctx, input)
+		assert.Nil(err, "error should be nil")
+		items := reviews.Reviews.Items
+		assert.True(items[0].Redacted)
+		assert.Empty(items[0].Review.Content, "a review flagged as a spoiler should be hidden entirely")
+		assert.True(items[1].Redacted)
+		assert.Equal("the butler [spoiler]", items[1].Review.Content, "only inline spoilers should be hidden")
+		assert.False(items[2].Redacted)
+		assert.Equal("great", items[2].Review.Content)
+
+		store.User.OneFn = nil
+		store.Review.AllDetailedFn = nil
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := rs.GetAllReviews(ctx, newInput(model.ReviewSortNewest, "not a cursor"))
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeBadRequest, e.Code, "error code should be bad request")
+	})
+}
+
+func TestReviewService_GetUserReviews(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	rs := service.NewReviewService(store, mocks.NopLogger{}, mocks.NewMediaService(), mocks.NewNotificationService())
+
+	store.User.ExistsFn = func(ctx context.Context, filters ...*model.UserF) (bool, error) {
+		return true, nil
+	}
+
+	t.Run("success", func(t *testing.T) {
+		userID := uuid.New()
+		store.Review.AllDetailedFn = func(ctx context.Context, viewerID uuid.UUID, sort model.ReviewSort, after *model.RankedCursor, limit int, reviewFs ...*model.ReviewF) ([]*model.DetailedReview, error) {
+			assert.Equal(userID, *reviewFs[0].UserID, "only the user's reviews should be fetched")
+			return []*model.DetailedReview{{Review: &model.Review{ID: uuid.New(), UserID: userID}}}, nil
+		}
+
+		page, err := rs.GetUserReviews(ctx, uuid.New(), userID, model.ReviewSortNewest, "", 2)
+		assert.Nil(err, "error should be nil")
+		assert.Len(page.Items, 1)
+		assert.Nil(page.NextCursor, "there should be no next page")
+
+		store.Review.AllDetailedFn = nil
+	})
+
+	t.Run("private account", func(t *testing.T) {
+		store.User.CanViewFn = func(ctx context.Context, viewerID, userID uuid.UUID) (bool, error) {
+			return false, nil
+		}
+
+		_, err := rs.GetUserReviews(ctx, uuid.New(), uuid.New(), model.ReviewSortNewest, "", 2)
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeForbidden, e.Code, "error code should be forbidden")
+
+		store.User.CanViewFn = nil
+	})
+}
+
+func TestReviewService_LikeReview(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	notifications := mocks.NewNotificationService()
+	rs := service.NewReviewService(store, mocks.NopLogger{}, mocks.NewMediaService(), notifications)
+
+	authorID := uuid.New()
+	store.Review.OneFn = func(ctx context.Context, filters ...*model.ReviewF) (*model.Review, error) {
+		return &model.Review{ID: *filters[0].ID, UserID: authorID}, nil
+	}
+
+	t.Run("success", func(t *testing.T) {
+		var notified *model.Notification
+		notifications.NotifyFn = func(ctx context.Context, notification *model.Notification) {
+			notified = notification
+		}
+
+		like, err := rs.LikeReview(ctx, &model.ReviewLike{UserID: uuid.New(), ReviewID: uuid.New()})
+		assert.Nil(err, "error should be nil")
+		assert.NotNil(like)
+		assert.NotNil(notified, "the author should be notified")
+		assert.Equal(authorID, notified.UserID)
+		assert.Equal(model.NotificationReviewLiked, notified.Kind)
+
+		notifications.NotifyFn = nil
+	})
+
+	t.Run("already liked", func(t *testing.T) {
+		store.ReviewLike.InsertFn = func(ctx context.Context, entity *model.ReviewLike) (*model.ReviewLike, error) {
+			return nil, datastore.ErrConstraint
+		}
+
+		_, err := rs.LikeReview(ctx, &model.ReviewLike{UserID: uuid.New(), ReviewID: uuid.New()})
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeConflict, e.Code, "error code should be conflict")
+
+		store.ReviewLike.InsertFn = nil
+	})
+
+	t.Run("review not visible", func(t *testing.T) {
+		store.Review.OneFn = func(ctx context.Context, filters ...*model.ReviewF) (*model.Review, error) {
+			assert.NotNil(filters[0].VisibleTo, "only reviews the user can see should be liked")
+			return nil, datastore.ErrNotFound
+		}
+
+		_, err := rs.LikeReview(ctx, &model.ReviewLike{UserID: uuid.New(), ReviewID: uuid.New()})
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+	})
+
+	t.Run("unlike without a like", func(t *testing.T) {
+		err := rs.UnlikeReview(ctx, uuid.New(), uuid.New())
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+	})
+}
+
+func TestReviewService_UpdateReview(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	rs := service.NewReviewService(store, mocks.NopLogger{}, mocks.NewMediaService(), mocks.NewNotificationService())
+
+	authorID := uuid.New()
+	store.Review.OneFn = func(ctx context.Context, filters ...*model.ReviewF) (*model.Review, error) {
+		return &model.Review{ID: *filters[0].ID, UserID: authorID, Content: "before", Rating: 6}, nil
+	}
+
+	t.Run("keeps the replaced version", func(t *testing.T) {
+		var revision *model.Revision
+		store.Revision.InsertFn = func(ctx context.Context, entity *model.Revision) (*model.Revision, error) {
+			revision = entity
+			return entity, nil
+		}
+
+		content := "after"
+		_, err := rs.UpdateReview(ctx, authorID, uuid.New(), &model.ReviewU{Content: &content})
+		assert.Nil(err, "error should be nil")
+		assert.NotNil(revision, "a revision should be recorded")
+		assert.Equal("before", revision.Content)
+		assert.Equal(6, *revision.Rating)
+
+		store.Revision.InsertFn = nil
+	})
+
+	t.Run("nothing is updated when the revision fails", func(t *testing.T) {
+		store.Revision.InsertFn = func(ctx context.Context, entity *model.Revision) (*model.Revision, error) {
+			return nil, datastore.ErrInternal
+		}
+		store.Review.UpdateFn = func(ctx context.Context, id uuid.UUID, updater *model.ReviewU) (*model.Review, error) {
+			assert.Fail("the review should not be updated")
+			return nil, nil
+		}
+
+		content := "after"
+		_, err := rs.UpdateReview(ctx, authorID, uuid.New(), &model.ReviewU{Content: &content})
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeInternal, e.Code, "error code should be internal")
+
+		store.Revision.InsertFn = nil
+		store.Review.UpdateFn = nil
+	})
+
+	t.Run("revisions are redacted for viewers hiding spoilers", func(t *testing.T) {
+		store.User.OneFn = func(ctx context.Context, filters ...*model.UserF) (*model.User, error) {
+			return &model.User{ID: *filters[0].ID, HideSpoilers: true}, nil
+		}
+		store.Revision.AllFn = func(ctx context.Context, filters ...*model.RevisionF) ([]*model.Revision, error) {
+			assert.NotNil(filters[0].ReviewID, "only the review's revisions should be fetched")
+			return []*model.Revision{{Content: "the butler ||did it||"}, {Content: "a twist", Spoiler: true}}, nil
+		}
+
+		revisions, err := rs.GetReviewRevisions(ctx, uuid.New(), uuid.New())
+		assert.Nil(err, "error should be nil")
+		assert.Equal("the butler [spoiler]", revisions[0].Content)
+		assert.Empty(revisions[1].Content)
+
+		store.User.OneFn = nil
+		store.Revision.AllFn = nil
+	})
+}
+
+func TestReviewService_DeleteReview(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	rs := service.NewReviewService(store, mocks.NopLogger{}, mocks.NewMediaService(), mocks.NewNotificationService())
+
+	authorID := uuid.New()
+	store.Review.OneFn = func(ctx context.Context, filters ...*model.ReviewF) (*model.Review, error) {
+		return &model.Review{ID: *filters[0].ID, UserID: authorID, Content: "first", Rating: 8}, nil
+	}
+
+	t.Run("without a thread", func(t *testing.T) {
+		deleted := false
+		store.Review.DeleteFn = func(ctx context.Context, id uuid.UUID) error {
+			deleted = true
+			return nil
+		}
+
+		err := rs.DeleteReview(ctx, authorID, uuid.New())
+		assert.Nil(err, "error should be nil")
+		assert.True(deleted, "the review should be deleted")
+
+		store.Review.DeleteFn = nil
+	})
+
+	t.Run("leaves a tombstone for its thread", func(t *testing.T) {
+		store.Comment.ExistsFn = func(ctx context.Context, filters ...*model.CommentF) (bool, error) {
+			assert.NotNil(filters[0].ReviewID, "the review's thread should be checked")
+			return true, nil
+		}
+		var update *model.ReviewU
+		store.Review.UpdateFn = func(ctx context.Context, id uuid.UUID, updater *model.ReviewU) (*model.Review, error) {
+			update = updater
+			return &model.Review{ID: id}, nil
+		}
+		store.Review.DeleteFn = func(ctx context.Context, id uuid.UUID) error {
+			assert.Fail("the review should not be hard deleted")
+			return nil
+		}
+
+		err := rs.DeleteReview(ctx, authorID, uuid.New())
+		assert.Nil(err, "error should be nil")
+		assert.Equal(model.ReviewTombstone, *update.Content)
+		assert.Equal(0, *update.Rating)
+		assert.NotNil(update.DeletedAt, "the review should be marked as deleted")
+
+		store.Comment.ExistsFn = nil
+		store.Review.UpdateFn = nil
+		store.Review.DeleteFn = nil
+	})
+
+	t.Run("already deleted", func(t *testing.T) {
+		store.Review.OneFn = func(ctx context.Context, filters ...*model.ReviewF) (*model.Review, error) {
+			deletedAt := time.Now()
+			return &model.Review{ID: *filters[0].ID, UserID: authorID, DeletedAt: &deletedAt}, nil
+		}
+
+		err := rs.DeleteReview(ctx, authorID, uuid.New())
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+	})
+}
@@ -0,0 +1,76 @@
+package unit
+
+import (
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/pkg/tmdb"
+	"cine/service"
+	"cine/test/mocks"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	testify "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSearchService_Search(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	api := mocks.NewTMDB()
+	store := mocks.NewStore()
+	ss := service.NewSearchService(store, mocks.NopLogger{}, api)
+	viewerID := uuid.New()
+
+	api.SearchMultiFn = func(ctx context.Context, query string, filter ...tmdb.SearchMultiFilter) (*tmdb.Page[tmdb.MultiResult], error) {
+		return &tmdb.Page[tmdb.MultiResult]{Results: []tmdb.MultiResult{
+			{Kind: tmdb.KindMovie, Movie: &tmdb.Movie{ID: 1}},
+			{Kind: tmdb.KindShow, Show: &tmdb.Show{ID: 2}},
+			{Kind: tmdb.KindPerson, Person: &tmdb.Person{ID: 3}},
+		}}, nil
+	}
+	store.User.SearchFn = func(ctx context.Context, searcherID uuid.UUID, query string, limit int) ([]*model.User, error) {
+		assert.Equal(viewerID, searcherID, "users should be searched for the viewer")
+		return []*model.User{{Username: query}}, nil
+	}
+	store.List.SearchPublicFn = func(ctx context.Context, searcherID uuid.UUID, query string, limit int) ([]*model.List, error) {
+		assert.Equal(viewerID, searcherID, "lists should be searched for the viewer")
+		return []*model.List{{Title: query}}, nil
+	}
+
+	t.Run("groups results by kind", func(t *testing.T) {
+		results, err := ss.Search(ctx, viewerID, "cine")
+		assert.Nil(err, "error should be nil")
+		assert.Len(results.Movies, 1, "should have one movie")
+		assert.Len(results.Shows, 1, "should have one show")
+		assert.Len(results.People, 1, "should have one person")
+		assert.Len(results.Users, 1, "should have one user")
+		assert.Len(results.Lists, 1, "should have one list")
+		assert.Empty(results.Warnings, "should have no warnings")
+	})
+
+	t.Run("one failing backend only adds a warning", func(t *testing.T) {
+		api.SearchMultiFn = func(ctx context.Context, query string, filter ...tmdb.SearchMultiFilter) (*tmdb.Page[tmdb.MultiResult], error) {
+			return nil, tmdb.ErrorUnavailable("breaker open")
+		}
+
+		results, err := ss.Search(ctx, viewerID, "cine")
+		assert.Nil(err, "error should be nil")
+		assert.Empty(results.Movies, "movies should be empty")
+		assert.Len(results.Users, 1, "users should still be returned")
+		assert.Len(results.Lists, 1, "lists should still be returned")
+		assert.Len(results.Warnings, 1, "should have one warning")
+	})
+
+	t.Run("every backend failing is an error", func(t *testing.T) {
+		store.User.SearchFn = func(ctx context.Context, viewerID uuid.UUID, query string, limit int) ([]*model.User, error) {
+			return nil, errors.New("connection refused")
+		}
+		store.List.SearchPublicFn = func(ctx context.Context, viewerID uuid.UUID, query string, limit int) ([]*model.List, error) {
+			return nil, errors.New("connection refused")
+		}
+
+		_, err := ss.Search(ctx, viewerID, "cine")
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeInternal, e.Code, "error code should be internal")
+	})
+}
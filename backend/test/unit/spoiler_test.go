@@ -0,0 +1,27 @@
+package unit
+
+import (
+	"cine/pkg/spoiler"
+	testify "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSpoiler(t *testing.T) {
+	assert := testify.New(t)
+
+	t.Run("redacts every inline spoiler", func(t *testing.T) {
+		text := "the butler ||did it|| and ||the twin|| helped"
+		assert.True(spoiler.Has(text))
+		assert.Equal("the butler [spoiler] and [spoiler] helped", spoiler.Redact(text))
+	})
+
+	t.Run("leaves unclosed markers alone", func(t *testing.T) {
+		text := "a || b"
+		assert.False(spoiler.Has(text), "an unclosed marker isn't a spoiler")
+		assert.Equal(text, spoiler.Redact(text))
+	})
+
+	t.Run("redacts closed spoilers before an unclosed marker", func(t *testing.T) {
+		assert.Equal("[spoiler] and ||", spoiler.Redact("||twist|| and ||"))
+	})
+}
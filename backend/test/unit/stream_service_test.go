@@ -0,0 +1,94 @@
+package unit
+
+import (
+	"cine/entity/model"
+	"cine/pkg/pubsub"
+	"cine/service"
+	"cine/test/mocks"
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	testify "github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestStreamService_SubscribeComments(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	hub := pubsub.NewMemoryHub()
+	ss := service.NewStreamService(store, hub, mocks.NopLogger{}, mocks.NewMediaService())
+
+	viewerID, hiddenID, privateID := uuid.New(), uuid.New(), uuid.New()
+	lookups := 0
+	store.User.AllFn = func(ctx context.Context, filters ...*model.UserF) ([]*model.User, error) {
+		lookups++
+		assert.Equal(viewerID, *filters[0].HiddenFrom, "the users hidden from the viewer should be loaded")
+		return []*model.User{{ID: hiddenID}}, nil
+	}
+	store.User.OneFn = func(ctx context.Context, filters ...*model.UserF) (*model.User, error) {
+		return &model.User{ID: *filters[0].ID, HideSpoilers: true}, nil
+	}
+	follows := 0
+	store.User.CanViewFn = func(ctx context.Context, viewerID, userID uuid.UUID) (bool, error) {
+		follows++
+		return userID != privateID, nil
+	}
+	store.Comment.ExistsFn = func(ctx context.Context, filters ...*model.CommentF) (bool, error) {
+		assert.Fail("comments should not be looked up for each subscriber")
+		return false, nil
+	}
+
+	sub, err := ss.SubscribeComments(ctx, viewerID, 1, model.MediaTypeMovie)
+	assert.Nil(err, "error should be nil")
+	defer sub.Close()
+
+	publish := func(kind string, data *service.CommentEvent) {
+		event, err := pubsub.NewEvent("comments:"+data.Comment.MediaID.String(), kind, data)
+		assert.Nil(err)
+		assert.Nil(hub.Publish(ctx, event))
+	}
+	receive := func() *model.Comment {
+		select {
+		case event := <-sub.Events():
+			var comment model.Comment
+			assert.Nil(json.Unmarshal(event.Data, &comment))
+			return &comment
+		case <-time.After(time.Second):
+			assert.Fail("an event should be received")
+			return nil
+		}
+	}
+
+	deletedAt := time.Now()
+	followedID := uuid.New()
+	publish(service.EventCommentCreated, &service.CommentEvent{Comment: &model.Comment{ID: uuid.New(), UserID: hiddenID, Content: "hidden"}})
+	publish(service.EventCommentCreated, &service.CommentEvent{
+		Comment:             &model.Comment{ID: uuid.New(), UserID: uuid.New(), Content: "in a private thread"},
+		ReviewAuthorID:      &privateID,
+		ReviewAuthorPrivate: true,
+	})
+	publish(service.EventCommentCreated, &service.CommentEvent{
+		Comment:             &model.Comment{ID: uuid.New(), UserID: uuid.New(), Content: "the butler ||did it||"},
+		ReviewAuthorID:      &followedID,
+		ReviewAuthorPrivate: true,
+	})
+	publish(service.EventCommentCreated, &service.CommentEvent{
+		Comment:             &model.Comment{ID: uuid.New(), UserID: uuid.New(), Content: "followed again"},
+		ReviewAuthorID:      &followedID,
+		ReviewAuthorPrivate: true,
+	})
+	publish(service.EventCommentDeleted, &service.CommentEvent{Comment: &model.Comment{ID: uuid.New(), UserID: hiddenID, Content: model.CommentTombstone, DeletedAt: &deletedAt}})
+
+	comment := receive()
+	assert.Equal("the butler [spoiler]", comment.Content, "comments the viewer can't see should be dropped, and spoilers redacted")
+	assert.Equal("followed again", receive().Content)
+
+	tombstone := receive()
+	assert.Equal(model.CommentTombstone, tombstone.Content, "tombstones should be passed on whoever wrote them")
+	assert.Equal(uuid.Nil, tombstone.UserID, "tombstones should not say who wrote them")
+
+	assert.Equal(1, lookups, "the viewer should be loaded once for every event")
+	assert.Equal(2, follows, "each private review author should be looked up once")
+}
@@ -0,0 +1,216 @@
+package unit
+
+import (
+	"cine/pkg/logger"
+	"cine/pkg/tmdb"
+	"cine/test/mocks"
+	"context"
+	testify "github.com/stretchr/testify/assert"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCachedAPI_GetMovie(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+
+	t.Run("caches hits", func(t *testing.T) {
+		api := mocks.NewTMDB()
+		calls := 0
+		api.GetMovieFn = func(ctx context.Context, ref int) (*tmdb.DetailedMovie, error) {
+			calls++
+			return &tmdb.DetailedMovie{ID: ref}, nil
+		}
+		cache := tmdb.NewCache(api, 10)
+
+		for i := 0; i < 3; i++ {
+			movie, err := cache.GetMovie(ctx, 1)
+			assert.Nil(err, "error should be nil")
+			assert.Equal(1, movie.ID, "movie id should match ref")
+		}
+
+		assert.Equal(1, calls, "api should only be called once")
+		stats := cache.Stats()
+		assert.Equal(uint64(2), stats.Hits, "should have two hits")
+		assert.Equal(uint64(1), stats.Misses, "should have one miss")
+	})
+
+	t.Run("caches not found", func(t *testing.T) {
+		api := mocks.NewTMDB()
+		calls := 0
+		api.GetMovieFn = func(ctx context.Context, ref int) (*tmdb.DetailedMovie, error) {
+			calls++
+			return nil, tmdb.ErrorNotFound("movie")
+		}
+		cache := tmdb.NewCache(api, 10)
+
+		_, err := cache.GetMovie(ctx, 1)
+		assert.True(tmdb.IsNotFound(err), "error should be not found")
+		_, err = cache.GetMovie(ctx, 1)
+		assert.True(tmdb.IsNotFound(err), "error should be not found")
+
+		assert.Equal(1, calls, "api should only be called once")
+	})
+
+	t.Run("does not cache internal errors", func(t *testing.T) {
+		api := mocks.NewTMDB()
+		calls := 0
+		api.GetMovieFn = func(ctx context.Context, ref int) (*tmdb.DetailedMovie, error) {
+			calls++
+			return nil, tmdb.ErrorInternal("movie")
+		}
+		cache := tmdb.NewCache(api, 10)
+
+		_, _ = cache.GetMovie(ctx, 1)
+		_, _ = cache.GetMovie(ctx, 1)
+
+		assert.Equal(2, calls, "api should be called every time")
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		api := mocks.NewTMDB()
+		cache := tmdb.NewCache(api, 2)
+
+		_, _ = cache.GetMovie(ctx, 1)
+		_, _ = cache.GetMovie(ctx, 2)
+		_, _ = cache.GetMovie(ctx, 1)
+		_, _ = cache.GetMovie(ctx, 3)
+
+		stats := cache.Stats()
+		assert.Equal(uint64(1), stats.Evictions, "should have evicted one entry")
+		assert.Equal(2, stats.Entries, "should be at capacity")
+
+		_, _ = cache.GetMovie(ctx, 1)
+		assert.Equal(uint64(2), cache.Stats().Hits, "movie 1 should still be cached")
+	})
+
+	t.Run("coalesces concurrent requests", func(t *testing.T) {
+		api := mocks.NewTMDB()
+		var calls atomic.Int32
+		release := make(chan struct{})
+		api.GetMovieFn = func(ctx context.Context, ref int) (*tmdb.DetailedMovie, error) {
+			calls.Add(1)
+			<-release
+			return &tmdb.DetailedMovie{ID: ref}, nil
+		}
+		cache := tmdb.NewCache(api, 10)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _ = cache.GetMovie(ctx, 1)
+			}()
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(int32(1), calls.Load(), "api should only be called once")
+	})
+
+	t.Run("a caller going away doesn't fail the others", func(t *testing.T) {
+		api := mocks.NewTMDB()
+		started, release := make(chan struct{}), make(chan struct{})
+		api.GetMovieFn = func(ctx context.Context, ref int) (*tmdb.DetailedMovie, error) {
+			close(started)
+			select {
+			case <-release:
+				return &tmdb.DetailedMovie{ID: ref}, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		cache := tmdb.NewCache(api, 10)
+
+		leaderCtx, cancel := context.WithCancel(ctx)
+		leaderErr := make(chan error)
+		go func() {
+			_, err := cache.GetMovie(leaderCtx, 1)
+			leaderErr <- err
+		}()
+		<-started
+
+		waiter := make(chan *tmdb.DetailedMovie)
+		go func() {
+			movie, _ := cache.GetMovie(ctx, 1)
+			waiter <- movie
+		}()
+		time.Sleep(50 * time.Millisecond)
+
+		cancel()
+		assert.ErrorIs(<-leaderErr, context.Canceled, "the leader should get its own cancellation")
+		close(release)
+		movie := <-waiter
+		assert.NotNil(movie, "the waiter should still get the movie")
+
+		_, err := cache.GetMovie(ctx, 1)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(uint64(1), cache.Stats().Hits, "the movie should have been cached")
+	})
+}
+
+func TestNewCachedAPI(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+
+	raw := mocks.NewTMDB()
+	calls := 0
+	raw.GetMovieFn = func(ctx context.Context, ref int) (*tmdb.DetailedMovie, error) {
+		calls++
+		return &tmdb.DetailedMovie{ID: ref}, nil
+	}
+
+	var api tmdb.API
+	var cache tmdb.CachedAPI
+	app := fxtest.New(t,
+		fx.NopLogger,
+		fx.Provide(
+			func() tmdb.API { return raw },
+			func() logger.Logger { return mocks.NopLogger{} },
+			tmdb.NewCachedAPI,
+		),
+		fx.Decorate(tmdb.Cached),
+		fx.Populate(&api, &cache),
+	)
+	app.RequireStart()
+	defer app.RequireStop()
+
+	_, _ = api.GetMovie(ctx, 1)
+	_, _ = api.GetMovie(ctx, 1)
+
+	assert.Equal(1, calls, "dependents of API should get the cached version")
+	stats := cache.Stats()
+	assert.Equal(uint64(1), stats.Hits, "stats should count what went through API")
+	assert.Equal(uint64(1), stats.Misses, "stats should count what went through API")
+	assert.Equal(1, stats.Entries, "should have one entry")
+}
+
+func TestCachedAPI_GetMovieBundle(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+
+	api := mocks.NewTMDB()
+	calls := 0
+	api.GetMovieBundleFn = func(ctx context.Context, ref int, appends ...tmdb.Append) (*tmdb.MovieBundle, error) {
+		calls++
+		return &tmdb.MovieBundle{DetailedMovie: &tmdb.DetailedMovie{ID: ref}}, nil
+	}
+	cache := tmdb.NewCache(api, 10)
+
+	_, err := cache.GetMovieBundle(ctx, 1, tmdb.AppendVideos, tmdb.AppendCredits)
+	assert.Nil(err, "error should be nil")
+	_, err = cache.GetMovieBundle(ctx, 1, tmdb.AppendCredits, tmdb.AppendVideos, tmdb.AppendVideos)
+	assert.Nil(err, "error should be nil")
+	assert.Equal(1, calls, "the same appends in any order should share an entry")
+
+	_, err = cache.GetMovieBundle(ctx, 1, tmdb.AppendImages)
+	assert.Nil(err, "error should be nil")
+	assert.Equal(2, calls, "different appends should not share an entry")
+}
@@ -0,0 +1,264 @@
+package unit
+
+import (
+	"cine/config"
+	"cine/pkg/tmdb"
+	"cine/test/mocks"
+	"context"
+	testify "github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const creditsBody = `{"id": 1, "cast": [], "crew": []}`
+
+func newTMDBStandIn(handler func(w http.ResponseWriter, attempt int32)) (*httptest.Server, *atomic.Int32) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler(w, attempts.Add(1))
+	}))
+	return server, &attempts
+}
+
+func TestTheMovieDatabaseAPI_Resilience(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+
+	t.Run("retries after 429 with retry-after", func(t *testing.T) {
+		server, attempts := newTMDBStandIn(func(w http.ResponseWriter, attempt int32) {
+			if attempt == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = w.Write([]byte(creditsBody))
+		})
+		defer server.Close()
+
+		api := tmdb.NewTheMovieDatabaseAPI(&config.Config{TMDBURL: server.URL, TMDBRateLimit: 100, TMDBMaxRetries: 2}, mocks.NopLogger{})
+
+		credits, err := api.GetMovieCredits(ctx, 1)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(1, credits.ID, "credits should be parsed")
+		assert.Equal(int32(2), attempts.Load(), "request should be retried once")
+	})
+
+	t.Run("5xx after retries is unavailable", func(t *testing.T) {
+		server, attempts := newTMDBStandIn(func(w http.ResponseWriter, attempt int32) {
+			w.WriteHeader(http.StatusBadGateway)
+		})
+		defer server.Close()
+
+		api := tmdb.NewTheMovieDatabaseAPI(&config.Config{TMDBURL: server.URL, TMDBRateLimit: 100, TMDBMaxRetries: 2}, mocks.NopLogger{})
+
+		_, err := api.GetMovieCredits(ctx, 1)
+		assert.True(tmdb.IsUnavailable(err), "error should be unavailable")
+		assert.Equal(int32(3), attempts.Load(), "request should be attempted 1 + 2 times")
+	})
+
+	t.Run("404 is not retried", func(t *testing.T) {
+		server, attempts := newTMDBStandIn(func(w http.ResponseWriter, attempt int32) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+		defer server.Close()
+
+		api := tmdb.NewTheMovieDatabaseAPI(&config.Config{TMDBURL: server.URL, TMDBRateLimit: 100, TMDBMaxRetries: 2}, mocks.NopLogger{})
+
+		_, err := api.GetMovieCredits(ctx, 1)
+		assert.True(tmdb.IsNotFound(err), "error should be not found")
+		assert.Equal(int32(1), attempts.Load(), "request should not be retried")
+	})
+
+	t.Run("circuit breaker fails fast once open", func(t *testing.T) {
+		server, attempts := newTMDBStandIn(func(w http.ResponseWriter, attempt int32) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		defer server.Close()
+
+		api := tmdb.NewTheMovieDatabaseAPI(&config.Config{TMDBURL: server.URL, TMDBRateLimit: 100, TMDBMaxRetries: 0}, mocks.NopLogger{})
+
+		for i := 0; i < 5; i++ {
+			_, _ = api.GetMovieCredits(ctx, 1)
+		}
+		_, err := api.GetMovieCredits(ctx, 1)
+
+		assert.True(tmdb.IsUnavailable(err), "error should be unavailable")
+		assert.Equal(int32(5), attempts.Load(), "open breaker should not reach the server")
+	})
+
+	t.Run("cancelled context stops the request", func(t *testing.T) {
+		server, _ := newTMDBStandIn(func(w http.ResponseWriter, attempt int32) {
+			time.Sleep(300 * time.Millisecond)
+			_, _ = w.Write([]byte(creditsBody))
+		})
+		defer server.Close()
+
+		api := tmdb.NewTheMovieDatabaseAPI(&config.Config{TMDBURL: server.URL, TMDBRateLimit: 100, TMDBMaxRetries: 2}, mocks.NopLogger{})
+
+		timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := api.GetMovieCredits(timeout, 1)
+		assert.NotNil(err, "error should be not nil")
+		assert.False(tmdb.IsUnavailable(err), "cancellation should not be reported as unavailable")
+		assert.Less(time.Since(start), 250*time.Millisecond, "request should stop when the context is done")
+	})
+}
+
+func TestTheMovieDatabaseAPI_ListPopularMovies(t *testing.T) {
+	assert := testify.New(t)
+
+	var page string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page = r.URL.Query().Get("page")
+		_, _ = w.Write([]byte(`{"page": 2, "results": [{"id": 1}], "total_pages": 7, "total_results": 140}`))
+	}))
+	defer server.Close()
+
+	api := tmdb.NewTheMovieDatabaseAPI(&config.Config{TMDBURL: server.URL, TMDBRateLimit: 100}, mocks.NopLogger{})
+
+	movies, err := api.ListPopularMovies(context.Background(), 2)
+	assert.Nil(err, "error should be nil")
+	assert.Equal("2", page, "page should be sent to tmdb")
+	assert.Equal(2, movies.Page, "page should be parsed")
+	assert.Equal(7, movies.TotalPages, "total pages should be parsed")
+	assert.Equal(140, movies.TotalResults, "total results should be parsed")
+	assert.Len(movies.Results, 1, "results should be parsed")
+}
+
+func TestTheMovieDatabaseAPI_DiscoverShows(t *testing.T) {
+	assert := testify.New(t)
+
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"page": 1, "results": [], "total_pages": 1, "total_results": 0}`))
+	}))
+	defer server.Close()
+
+	api := tmdb.NewTheMovieDatabaseAPI(&config.Config{TMDBURL: server.URL, TMDBRateLimit: 100}, mocks.NopLogger{})
+
+	from, to, sortBy := 1990, 1999, tmdb.DiscoverSortReleaseDate
+	_, err := api.DiscoverShows(context.Background(), tmdb.DiscoverFilter{
+		Genres:         []tmdb.Genre{tmdb.Drama, tmdb.Crime},
+		YearFrom:       &from,
+		YearTo:         &to,
+		SortBy:         &sortBy,
+		Ascending:      true,
+		WatchProviders: []int{8, 337},
+	})
+	assert.Nil(err, "error should be nil")
+	assert.Equal("18,80", query.Get("with_genres"), "genres should be AND'd")
+	assert.Equal("1990-01-01", query.Get("first_air_date.gte"), "year from should use the show date field")
+	assert.Equal("1999-12-31", query.Get("first_air_date.lte"), "year to should use the show date field")
+	assert.Equal("first_air_date.asc", query.Get("sort_by"), "release date should sort by the show date field")
+	assert.Equal("8|337", query.Get("with_watch_providers"), "providers should be OR'd")
+}
+
+func TestTheMovieDatabaseAPI_GetTrending(t *testing.T) {
+	assert := testify.New(t)
+
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		_, _ = w.Write([]byte(`{"page": 1, "total_pages": 1, "total_results": 4, "results": [
+			{"id": 1, "media_type": "movie", "title": "A Movie"},
+			{"id": 2, "media_type": "tv", "name": "A Show"},
+			{"id": 3, "media_type": "person", "name": "A Person"},
+			{"id": 4, "media_type": "collection", "name": "A Collection"}
+		]}`))
+	}))
+	defer server.Close()
+
+	api := tmdb.NewTheMovieDatabaseAPI(&config.Config{TMDBURL: server.URL, TMDBRateLimit: 100}, mocks.NopLogger{})
+
+	trending, err := api.GetTrending(context.Background(), tmdb.TrendingAll, tmdb.TrendingWindowWeek, 1)
+	assert.Nil(err, "error should be nil")
+	assert.Equal("/trending/all/week", path, "type and window should be in the path")
+	assert.Len(trending.Results, 3, "unknown media types should be skipped")
+
+	assert.Equal(tmdb.KindMovie, trending.Results[0].Kind, "first result should be a movie")
+	assert.Equal("A Movie", trending.Results[0].Movie.Title, "movie should be decoded")
+	assert.Equal(tmdb.KindShow, trending.Results[1].Kind, "second result should be a show")
+	assert.Equal("A Show", trending.Results[1].Show.Name, "show should be decoded")
+	assert.Nil(trending.Results[1].Movie, "only the tagged member should be set")
+	assert.Equal(tmdb.KindPerson, trending.Results[2].Kind, "third result should be a person")
+	assert.Equal("A Person", trending.Results[2].Person.Name, "person should be decoded")
+}
+
+func TestTheMovieDatabaseAPI_GetShowWatchProviders(t *testing.T) {
+	assert := testify.New(t)
+
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		_, _ = w.Write([]byte(`{"id": 1399, "results": {
+			"US": {
+				"link": "https://www.themoviedb.org/tv/1399/watch?locale=US",
+				"flatrate": [{"provider_id": 1899, "provider_name": "Max", "logo_path": "/max.jpg", "display_priority": 1}],
+				"buy": [{"provider_id": 2, "provider_name": "Apple TV", "logo_path": null, "display_priority": 4}]
+			}
+		}}`))
+	}))
+	defer server.Close()
+
+	api := tmdb.NewTheMovieDatabaseAPI(&config.Config{TMDBURL: server.URL, TMDBRateLimit: 100}, mocks.NopLogger{})
+
+	providers, err := api.GetShowWatchProviders(context.Background(), 1399)
+	assert.Nil(err, "error should be nil")
+	assert.Equal("/tv/1399/watch/providers", path, "shows should use the tv endpoint")
+
+	us := providers.Region("US")
+	assert.NotNil(us, "US offers should be parsed")
+	assert.Equal("Max", us.Flatrate[0].Name, "flatrate offers should be parsed")
+	assert.Nil(us.Buy[0].LogoPath, "a null logo should be allowed")
+	assert.Empty(us.Rent, "missing offer types should be empty")
+	assert.True(us.Streams([]int{8, 1899}), "title should be streamable on max")
+	assert.False(us.Streams([]int{2}), "buying should not count as streaming")
+	assert.Nil(providers.Region("GB"), "regions without offers should be nil")
+}
+
+func TestTheMovieDatabaseAPI_GetMovieBundle(t *testing.T) {
+	assert := testify.New(t)
+
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{
+			"adult": false, "backdrop_path": null, "budget": 0, "genres": [], "homepage": "", "id": 550,
+			"origin_country": ["US"], "original_language": "en", "original_title": "Fight Club", "overview": "",
+			"popularity": 1, "poster_path": null, "production_companies": [], "production_countries": [],
+			"release_date": "1999-10-15", "revenue": 0, "runtime": 139, "spoken_languages": [], "status": "Released",
+			"tagline": "", "title": "Fight Club", "video": false, "vote_average": 8.4, "vote_count": 1,
+			"videos": {"results": [
+				{"id": "a", "name": "Trailer", "site": "YouTube", "key": "qtRKdVHc-cE", "type": "Trailer", "official": true,
+				 "size": 1080, "iso_639_1": "en", "iso_3166_1": "US", "published_at": "2014-10-02T19:20:22.000Z"}
+			]},
+			"images": {
+				"backdrops": [{"file_path": "/a.jpg", "aspect_ratio": 1.778, "width": 1920, "height": 1080, "iso_639_1": null}],
+				"posters": [{"file_path": "/b.jpg", "aspect_ratio": 0.667, "width": 1000, "height": 1500, "iso_639_1": "en"}]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	api := tmdb.NewTheMovieDatabaseAPI(&config.Config{TMDBURL: server.URL, TMDBRateLimit: 100}, mocks.NopLogger{})
+
+	bundle, err := api.GetMovieBundle(context.Background(), 550, tmdb.AppendVideos, tmdb.AppendImages)
+	assert.Nil(err, "error should be nil")
+	assert.Equal("images,videos", query.Get("append_to_response"), "appends should be sent sorted")
+
+	assert.Equal("Fight Club", bundle.Title, "details should be parsed")
+	assert.Nil(bundle.Credits, "credits were not appended")
+	assert.Equal(550, bundle.Videos.ID, "appended videos should get the ref as id")
+	assert.Equal(tmdb.VideoTypeTrailer, bundle.Videos.Results[0].Type, "video type should be parsed")
+	assert.Equal(tmdb.VideoSiteYouTube, bundle.Videos.Results[0].Site, "video site should be parsed")
+	assert.True(bundle.Videos.Results[0].Official, "official flag should be parsed")
+	assert.Nil(bundle.Images.Backdrops[0].Language, "textless images should have no language")
+	assert.Equal(0.667, bundle.Images.Posters[0].AspectRatio, "aspect ratio should be parsed")
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	testify "github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestUserService_GetUser(t *testing.T) {} // redundant test
@@ -20,7 +21,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 	assert := testify.New(t)
 	ctx := context.Background()
 	store := mocks.NewStore()
-	us := service.NewUserService(store, mocks.NopLogger{})
+	us := service.NewUserService(store, mocks.NopLogger{}, mocks.NewNotificationService())
 
 	t.Run("success", func(t *testing.T) {
 		store.User.ExistsFn = func(ctx context.Context, filters ...*model.UserF) (bool, error) {
@@ -92,10 +93,10 @@ func TestUserService_FollowUser(t *testing.T) {
 	assert := testify.New(t)
 	ctx := context.Background()
 	store := mocks.NewStore()
-	us := service.NewUserService(store, mocks.NopLogger{})
+	us := service.NewUserService(store, mocks.NopLogger{}, mocks.NewNotificationService())
 
 	t.Run("success", func(t *testing.T) {
-		err := us.FollowUser(ctx, uuid.UUID{}, uuid.UUID{})
+		_, err := us.FollowUser(ctx, uuid.UUID{}, uuid.UUID{})
 		assert.Nil(err, "error should be nil")
 	})
 
@@ -104,7 +105,7 @@ func TestUserService_FollowUser(t *testing.T) {
 			return nil, datastore.ErrNotFound
 		}
 
-		err := us.FollowUser(ctx, uuid.UUID{}, uuid.UUID{})
+		_, err := us.FollowUser(ctx, uuid.UUID{}, uuid.UUID{})
 		assert.NotNil(err, "error should be not nil")
 
 		e, _ := fault.As(err)
@@ -118,7 +119,7 @@ func TestUserService_FollowUser(t *testing.T) {
 			return datastore.ErrConstraint
 		}
 
-		err := us.FollowUser(ctx, uuid.UUID{}, uuid.UUID{})
+		_, err := us.FollowUser(ctx, uuid.UUID{}, uuid.UUID{})
 		assert.NotNil(err, "error should be not nil")
 
 		e, _ := fault.As(err)
@@ -130,7 +131,7 @@ func TestUserService_UnfollowUser(t *testing.T) {
 	assert := testify.New(t)
 	ctx := context.Background()
 	store := mocks.NewStore()
-	us := service.NewUserService(store, mocks.NopLogger{})
+	us := service.NewUserService(store, mocks.NopLogger{}, mocks.NewNotificationService())
 
 	t.Run("success", func(t *testing.T) {
 		err := us.UnfollowUser(ctx, uuid.UUID{}, uuid.UUID{})
@@ -163,3 +164,270 @@ func TestUserService_UnfollowUser(t *testing.T) {
 		assert.Equal(e.Code, fault.CodeConflict, "error code should be conflict")
 	})
 }
+
+func TestUserService_GetFollowers(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	us := service.NewUserService(store, mocks.NopLogger{}, mocks.NewNotificationService())
+
+	exists := true
+	store.User.ExistsFn = func(ctx context.Context, filters ...*model.UserF) (bool, error) {
+		return exists, nil
+	}
+
+	t.Run("pages through followers", func(t *testing.T) {
+		now := time.Now()
+		store.User.AllFollowersDetailedFn = func(ctx context.Context, id, viewerID uuid.UUID, after *model.Cursor, limit int) ([]*model.DetailedFollow, error) {
+			assert.Equal(3, limit, "one more than the page should be fetched")
+			follows := make([]*model.DetailedFollow, 0, limit)
+			for i := range limit {
+				follows = append(follows, &model.DetailedFollow{User: &model.User{ID: uuid.New()}, FollowedAt: now.Add(-time.Duration(i) * time.Minute)})
+			}
+			return follows, nil
+		}
+
+		page, err := us.GetFollowers(ctx, uuid.New(), uuid.New(), "", 2)
+		assert.Nil(err, "error should be nil")
+		assert.Len(page.Items, 2)
+		assert.NotNil(page.NextCursor, "a next cursor should be returned when there are more followers")
+
+		after, err := model.ParseCursor(*page.NextCursor)
+		assert.Nil(err)
+		assert.Equal(page.Items[1].User.ID, after.ID, "the cursor should point at the last follower of the page")
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		exists = false
+
+		_, err := us.GetFollowing(ctx, uuid.New(), uuid.New(), "", 2)
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+
+		exists = true
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := us.GetFollowers(ctx, uuid.New(), uuid.New(), "not a cursor", 2)
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeBadRequest, e.Code, "error code should be bad request")
+	})
+
+	t.Run("private account", func(t *testing.T) {
+		store.User.CanViewFn = func(ctx context.Context, viewerID, userID uuid.UUID) (bool, error) {
+			return false, nil
+		}
+
+		_, err := us.GetFollowers(ctx, uuid.New(), uuid.New(), "", 2)
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeForbidden, e.Code, "error code should be forbidden")
+
+		store.User.CanViewFn = nil
+	})
+}
+
+func TestUserService_FollowRequests(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	us := service.NewUserService(store, mocks.NopLogger{}, mocks.NewNotificationService())
+
+	followerID, followeeID := uuid.New(), uuid.New()
+	store.User.OneFn = func(ctx context.Context, filters ...*model.UserF) (*model.User, error) {
+		return &model.User{ID: *filters[0].ID, Private: *filters[0].ID == followeeID}, nil
+	}
+	store.User.OneFollowedFn = func(ctx context.Context, user *model.User, followedID uuid.UUID) (*model.User, error) {
+		return nil, datastore.ErrNotFound
+	}
+
+	t.Run("following a private account requests it", func(t *testing.T) {
+		followed := false
+		store.User.FollowUserFn = func(ctx context.Context, user *model.User, userToFollowID uuid.UUID) error {
+			followed = true
+			return nil
+		}
+		store.FollowRequest.InsertFn = func(ctx context.Context, entity *model.FollowRequest) (*model.FollowRequest, error) {
+			return entity, nil
+		}
+
+		request, err := us.FollowUser(ctx, followerID, followeeID)
+		assert.Nil(err, "error should be nil")
+		assert.NotNil(request, "a follow request should be returned")
+		assert.Equal(followerID, request.RequesterID)
+		assert.Equal(followeeID, request.UserID)
+		assert.False(followed, "follow should not be created before approval")
+
+		store.User.FollowUserFn = nil
+	})
+
+	t.Run("already requested", func(t *testing.T) {
+		store.FollowRequest.InsertFn = func(ctx context.Context, entity *model.FollowRequest) (*model.FollowRequest, error) {
+			return nil, datastore.ErrConstraint
+		}
+
+		_, err := us.FollowUser(ctx, followerID, followeeID)
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeConflict, e.Code, "error code should be conflict")
+
+		store.FollowRequest.InsertFn = nil
+	})
+
+	t.Run("approve", func(t *testing.T) {
+		store.FollowRequest.OneFn = func(ctx context.Context, filters ...*model.FollowRequestF) (*model.FollowRequest, error) {
+			return &model.FollowRequest{ID: *filters[0].ID, RequesterID: followerID, UserID: followeeID}, nil
+		}
+		var follower *model.User
+		store.User.FollowUserFn = func(ctx context.Context, user *model.User, userToFollowID uuid.UUID) error {
+			follower = user
+			return nil
+		}
+		deleted := false
+		store.FollowRequest.DeleteFn = func(ctx context.Context, id uuid.UUID) error {
+			deleted = true
+			return nil
+		}
+
+		err := us.ApproveFollowRequest(ctx, followeeID, uuid.New())
+		assert.Nil(err, "error should be nil")
+		assert.NotNil(follower, "follow should be created")
+		assert.Equal(followerID, follower.ID, "the requester should follow the user")
+		assert.True(deleted, "request should be deleted")
+
+		store.FollowRequest.OneFn = nil
+		store.User.FollowUserFn = nil
+		store.FollowRequest.DeleteFn = nil
+	})
+
+	t.Run("approve a blocked requester", func(t *testing.T) {
+		store.FollowRequest.OneFn = func(ctx context.Context, filters ...*model.FollowRequestF) (*model.FollowRequest, error) {
+			return &model.FollowRequest{ID: *filters[0].ID, RequesterID: followerID, UserID: followeeID}, nil
+		}
+		store.User.BlockedFn = func(ctx context.Context, userID, otherID uuid.UUID) (bool, error) {
+			return true, nil
+		}
+		store.User.FollowUserFn = func(ctx context.Context, user *model.User, userToFollowID uuid.UUID) error {
+			assert.Fail("a blocked pair should not follow each other")
+			return nil
+		}
+
+		err := us.ApproveFollowRequest(ctx, followeeID, uuid.New())
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeForbidden, e.Code, "error code should be forbidden")
+
+		store.FollowRequest.OneFn = nil
+		store.User.BlockedFn = nil
+		store.User.FollowUserFn = nil
+	})
+
+	t.Run("approve request not found", func(t *testing.T) {
+		store.FollowRequest.OneFn = func(ctx context.Context, filters ...*model.FollowRequestF) (*model.FollowRequest, error) {
+			return nil, datastore.ErrNotFound
+		}
+
+		err := us.ApproveFollowRequest(ctx, followeeID, uuid.New())
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+
+		store.FollowRequest.OneFn = nil
+	})
+
+	t.Run("deny request not found", func(t *testing.T) {
+		err := us.DenyFollowRequest(ctx, followeeID, uuid.New())
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+	})
+}
+
+func TestUserService_BlockUser(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	us := service.NewUserService(store, mocks.NopLogger{}, mocks.NewNotificationService())
+
+	exists := true
+	store.User.ExistsFn = func(ctx context.Context, filters ...*model.UserF) (bool, error) {
+		return exists, nil
+	}
+
+	t.Run("success", func(t *testing.T) {
+		userID, blockedID := uuid.New(), uuid.New()
+		var deleted []*model.ActivityF
+		store.Activity.DeleteExecFn = func(ctx context.Context, filters ...*model.ActivityF) (int, error) {
+			deleted = append(deleted, filters[0])
+			return 1, nil
+		}
+
+		var requests []*model.FollowRequestF
+		store.FollowRequest.DeleteExecFn = func(ctx context.Context, filters ...*model.FollowRequestF) (int, error) {
+			requests = append(requests, filters[0])
+			return 1, nil
+		}
+
+		err := us.BlockUser(ctx, userID, blockedID)
+		assert.Nil(err, "error should be nil")
+		assert.Len(deleted, 2, "follow activities should be removed in both directions")
+		assert.Equal(blockedID, *deleted[0].FollowedID)
+		assert.Equal(userID, *deleted[1].FollowedID)
+		assert.Len(requests, 2, "follow requests should be removed in both directions")
+		assert.Equal(userID, *requests[0].RequesterID)
+		assert.Equal(blockedID, *requests[1].RequesterID)
+
+		store.FollowRequest.DeleteExecFn = nil
+	})
+
+	t.Run("removing requests failing fails the block", func(t *testing.T) {
+		store.FollowRequest.DeleteExecFn = func(ctx context.Context, filters ...*model.FollowRequestF) (int, error) {
+			return 0, datastore.ErrInternal
+		}
+
+		err := us.BlockUser(ctx, uuid.New(), uuid.New())
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeInternal, e.Code, "error code should be internal")
+
+		store.FollowRequest.DeleteExecFn = nil
+	})
+
+	t.Run("blocking yourself", func(t *testing.T) {
+		userID := uuid.New()
+
+		err := us.BlockUser(ctx, userID, userID)
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeBadRequest, e.Code, "error code should be bad request")
+	})
+
+	t.Run("user to block not found", func(t *testing.T) {
+		exists = false
+
+		err := us.BlockUser(ctx, uuid.New(), uuid.New())
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeNotFound, e.Code, "error code should be not found")
+
+		exists = true
+	})
+
+	t.Run("already blocking", func(t *testing.T) {
+		store.User.BlockUserFn = func(ctx context.Context, user *model.User, blockedID uuid.UUID) error {
+			return datastore.ErrConstraint
+		}
+
+		err := us.BlockUser(ctx, uuid.New(), uuid.New())
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeConflict, e.Code, "error code should be conflict")
+	})
+
+	t.Run("blocked users can't follow", func(t *testing.T) {
+		store.User.BlockedFn = func(ctx context.Context, userID, otherID uuid.UUID) (bool, error) {
+			return true, nil
+		}
+		followed := false
+		store.User.FollowUserFn = func(ctx context.Context, user *model.User, userToFollowID uuid.UUID) error {
+			followed = true
+			return nil
+		}
+
+		_, err := us.FollowUser(ctx, uuid.New(), uuid.New())
+		e, _ := fault.As(err)
+		assert.Equal(fault.CodeForbidden, e.Code, "error code should be forbidden")
+		assert.False(followed, "follow should not be created")
+	})
+}
@@ -0,0 +1,80 @@
+package unit
+
+import (
+	"cine/datastore"
+	"cine/entity/model"
+	"cine/pkg/fault"
+	"cine/service"
+	"cine/test/mocks"
+	"context"
+	"github.com/google/uuid"
+	testify "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWatchlistService_MarkWatched(t *testing.T) {
+	assert := testify.New(t)
+	ctx := context.Background()
+	store := mocks.NewStore()
+	media := mocks.NewMediaService()
+	ws := service.NewWatchlistService(store, mocks.NopLogger{}, media)
+
+	userID := uuid.New()
+
+	t.Run("moves a watchlist item into the watched set", func(t *testing.T) {
+		itemID := uuid.New()
+		store.WatchItem.OneFn = func(ctx context.Context, filters ...*model.WatchItemF) (*model.WatchItem, error) {
+			return &model.WatchItem{ID: itemID, UserID: userID, Status: model.WatchStatusWatchlist, Priority: 3}, nil
+		}
+		var got *model.WatchItemU
+		store.WatchItem.UpdateFn = func(ctx context.Context, id uuid.UUID, updater *model.WatchItemU) (*model.WatchItem, error) {
+			got = updater
+			return &model.WatchItem{ID: id, Status: *updater.Status}, nil
+		}
+
+		item, err := ws.MarkWatched(ctx, userID, 1, model.MediaTypeMovie, nil)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(model.WatchStatusWatched, item.Status, "item should be watched")
+		assert.NotNil(got.AddedAt, "added_at should be reset when the item moves")
+		assert.Equal(0, *got.Priority, "priority should be reset when the item moves")
+	})
+
+	t.Run("marking a watched item again changes nothing", func(t *testing.T) {
+		store.WatchItem.OneFn = func(ctx context.Context, filters ...*model.WatchItemF) (*model.WatchItem, error) {
+			return &model.WatchItem{UserID: userID, Status: model.WatchStatusWatched}, nil
+		}
+		store.WatchItem.UpdateFn = func(ctx context.Context, id uuid.UUID, updater *model.WatchItemU) (*model.WatchItem, error) {
+			t.Fatal("update should not be called")
+			return nil, nil
+		}
+
+		_, err := ws.MarkWatched(ctx, userID, 1, model.MediaTypeMovie, nil)
+		assert.Nil(err, "error should be nil")
+	})
+
+	t.Run("inserts a new item with the given priority", func(t *testing.T) {
+		store.WatchItem.OneFn = func(ctx context.Context, filters ...*model.WatchItemF) (*model.WatchItem, error) {
+			return nil, datastore.ErrNotFound
+		}
+		store.WatchItem.InsertFn = func(ctx context.Context, entity *model.WatchItem) (*model.WatchItem, error) {
+			return entity, nil
+		}
+
+		priority := 4
+		item, err := ws.MarkWatched(ctx, userID, 1, model.MediaTypeMovie, &priority)
+		assert.Nil(err, "error should be nil")
+		assert.Equal(model.WatchStatusWatched, item.Status, "item should be watched")
+		assert.Equal(4, item.Priority, "priority should be kept")
+	})
+	t.Run("an unavailable tmdb is not an internal error", func(t *testing.T) {
+		media.GetMediaFn = func(ctx context.Context, ref int, mediaType model.MediaType) (*model.Media, error) {
+			return nil, fault.Unavailable("the movie database is unavailable")
+		}
+		defer func() { media.GetMediaFn = nil }()
+
+		_, err := ws.MarkWatched(ctx, userID, 1, model.MediaTypeMovie, nil)
+		e, ok := fault.As(err)
+		assert.True(ok, "error should be a fault")
+		assert.Equal(fault.CodeUnavailable, e.Code, "error should be unavailable")
+	})
+}